@@ -34,7 +34,9 @@ import (
 	"Homework-1/internal/database/postgres"
 	"Homework-1/internal/kafka/consumer"
 	"Homework-1/internal/kafka/producer"
+	"Homework-1/internal/manifest"
 	"Homework-1/internal/metrics"
+	OrderUseCase "Homework-1/internal/order/usecase"
 	"Homework-1/internal/server"
 	file2 "Homework-1/internal/storage/order/file"
 	"Homework-1/internal/storage/pvz/file"
@@ -55,7 +57,7 @@ func bootstrap(ctx context.Context) error {
 	defer cancel()
 
 	var option, ENV string
-	flag.StringVar(&option, "option", "rest", `There are 2 options: 1 - "rest", 2 - "cli"`)
+	flag.StringVar(&option, "option", "rest", `There are 3 options: 1 - "rest", 2 - "cli", 3 - "manifest" (import or export, see README)`)
 	flag.StringVar(&ENV, "env", "prod", `There are 3 env: 1 - "prod", 2 - "local", 3 - "testing"`)
 	flag.Parse()
 
@@ -227,6 +229,50 @@ func bootstrap(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("cli.PVZRun: %w", err)
 		}
+	case option == "manifest":
+		configPath := os.Getenv("CONFIG_PATH")
+
+		cfg, err := config.LoadConfig(configPath)
+		if err != nil {
+			return fmt.Errorf("config.LoadConfig: %w", err)
+		}
+
+		ctxTime, timeCancel := context.WithTimeout(ctx, 10*time.Second)
+		defer timeCancel()
+
+		psqlDB, err := connection.NewDB(ctxTime, cfg.Postgres)
+		if err != nil {
+			return fmt.Errorf("connection.NewDB: %w", err)
+		}
+
+		defer func() {
+			err = psqlDB.Close()
+			if err != nil {
+				log.Printf("[main][bootstrap] psqlDB.Close: %v\n", err)
+			}
+		}()
+
+		// the manifest command reads the box catalog once per run, so it does not need the shared cache
+		rdb := connection.NewInMemoryCache(ctx, cfg.InMemoryCache)
+
+		defer func() {
+			err = rdb.Close()
+			if err != nil {
+				log.Printf("[main][bootstrap] rdb.Close: %v\n", err)
+			}
+		}()
+
+		useCase := OrderUseCase.NewOrderUseCase(
+			postgres.NewDataStore(psqlDB),
+			cache.NewClientRDRepository(rdb),
+			cfg.ReturnPolicy,
+			cfg.Volumetric,
+		)
+
+		err = manifest.NewCommand(useCase, cfg.Manifest, os.Stdout).Run(ctx, flag.Args())
+		if err != nil {
+			return fmt.Errorf("manifest.Run: %w", err)
+		}
 	default:
 		return errors.New("invalid option")
 	}
@@ -72,6 +72,70 @@ func (b *BoxHandler) CreateBox(ctx context.Context, request *box_v1.BoxCreateReq
 	return &abstract.MessageResponse{Message: strconv.FormatInt(id, 10)}, nil
 }
 
+// UpdateBox is
+func (b *BoxHandler) UpdateBox(ctx context.Context, request *box_v1.BoxUpdateRequest) (*abstract.MessageResponse, error) {
+	log.Printf("[box_v1][delivery][UpdateBox]")
+	tracer := otel.Tracer("[box_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[UpdateBox]")
+	defer span.End()
+
+	updateBoxRequest := boxModel.FromUpdateGRPC(request)
+
+	err := reqvalidator.ValidateRequest(updateBoxRequest)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("reqvalidator.ValidateRequest %v", err))
+	}
+
+	err = b.useCase.UpdateBox(ctx, updateBoxRequest)
+	if err != nil {
+		if errors.Is(err, errlst.ErrBoxNotFound) {
+			tracing.EventErrorTracer(span, err, "Box not found")
+
+			return nil, status.Errorf(grpcCodes.NotFound, "Error: %v", err)
+		}
+		if strings.Contains(err.Error(), errlst.ErrBoxAlreadyExists.Error()) {
+			tracing.EventErrorTracer(span, err, "Box already exists")
+
+			return nil, status.Errorf(grpcCodes.AlreadyExists, "Failed to update: %v", err)
+		}
+		tracing.EventErrorTracer(span, err, "Internal server error")
+
+		return nil, status.Errorf(grpcCodes.Internal, "Failed to update: %v", err)
+	}
+
+	span.SetStatus(codes.Ok, "Box updated successfully")
+	return &abstract.MessageResponse{Message: "Successfully Updated Box"}, nil
+}
+
+// ArchiveBox is
+func (b *BoxHandler) ArchiveBox(ctx context.Context, request *box_v1.BoxIDRequest) (*abstract.MessageResponse, error) {
+	log.Printf("[box_v1][delivery][ArchiveBox]")
+	tracer := otel.Tracer("[box_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[ArchiveBox]")
+	defer span.End()
+
+	if request.BoxID <= 0 {
+		tracing.EventErrorTracer(span, errors.New("invalid request id"), "bad request")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, "invalid request id")
+	}
+
+	err := b.useCase.ArchiveBox(ctx, request.BoxID)
+	if err != nil {
+		if errors.Is(err, errlst.ErrBoxNotFound) {
+			tracing.EventErrorTracer(span, err, "Box not found")
+			return nil, status.Errorf(grpcCodes.NotFound, "Error: %v", err)
+		}
+
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, "Failed to archive: %v", err)
+	}
+
+	span.SetStatus(codes.Ok, "Successfully archived box")
+	return &abstract.MessageResponse{Message: "Successfully Archived Box"}, nil
+}
+
 // DeleteBox is
 func (b *BoxHandler) DeleteBox(ctx context.Context, request *box_v1.BoxIDRequest) (*abstract.MessageResponse, error) {
 	log.Printf("[box_v1][delivery][DeleteBoxByID]")
@@ -90,6 +154,10 @@ func (b *BoxHandler) DeleteBox(ctx context.Context, request *box_v1.BoxIDRequest
 			tracing.EventErrorTracer(span, err, "Box not found")
 			return nil, status.Errorf(grpcCodes.NotFound, "Error: %v", err)
 		}
+		if errors.Is(err, errlst.ErrBoxHasLiveOrders) {
+			tracing.EventErrorTracer(span, err, "Box has live orders")
+			return nil, status.Errorf(grpcCodes.FailedPrecondition, "Error: %v", err)
+		}
 
 		tracing.EventErrorTracer(span, err, "Internal server error")
 		return nil, status.Errorf(grpcCodes.Internal, "Failed to delete: %v", err)
@@ -123,6 +191,123 @@ func (b *BoxHandler) ListBoxes(ctx context.Context, request *abstract.Page) (*bo
 	return boxModel.ListToGRPC(listOfBox), nil
 }
 
+// ListDeletedBoxes is
+func (b *BoxHandler) ListDeletedBoxes(ctx context.Context, request *abstract.Page) (*box_v1.BoxListResponse, error) {
+	log.Printf("[box_v1][delivery][ListDeletedBoxes]")
+	tracer := otel.Tracer("[box_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[ListDeletedBoxes]")
+	defer span.End()
+
+	page := abstractModel.PageFromGRPC(request)
+
+	err := reqvalidator.ValidateRequest(page)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("reqvalidator.ValidateRequest %v", err))
+	}
+
+	boxes, err := b.useCase.ListDeletedBoxes(ctx, page)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, "Failed to get list of deleted boxes: %v", err)
+	}
+
+	span.SetStatus(codes.Ok, "Successfully got list of deleted boxes")
+	return boxModel.ListToGRPC(boxes), nil
+}
+
+// RestoreBox is
+func (b *BoxHandler) RestoreBox(ctx context.Context, request *box_v1.BoxIDRequest) (*abstract.MessageResponse, error) {
+	log.Printf("[box_v1][delivery][RestoreBox]")
+	tracer := otel.Tracer("[box_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[RestoreBox]")
+	defer span.End()
+
+	if request.BoxID <= 0 {
+		tracing.EventErrorTracer(span, errors.New("invalid request id"), "bad request")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, "invalid request id")
+	}
+
+	err := b.useCase.RestoreBox(ctx, request.BoxID)
+	if err != nil {
+		if errors.Is(err, errlst.ErrBoxNotFound) {
+			tracing.EventErrorTracer(span, err, "Deleted box not found")
+			return nil, status.Errorf(grpcCodes.NotFound, "Error: %v", err)
+		}
+		if strings.Contains(err.Error(), errlst.ErrBoxAlreadyExists.Error()) {
+			tracing.EventErrorTracer(span, err, "Box name is taken")
+			return nil, status.Errorf(grpcCodes.AlreadyExists, "Failed to restore: %v", err)
+		}
+
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, "Failed to restore: %v", err)
+	}
+
+	span.SetStatus(codes.Ok, "Successfully restored box")
+	return &abstract.MessageResponse{Message: "Successfully Restored Box"}, nil
+}
+
+// PurgeBox is
+func (b *BoxHandler) PurgeBox(ctx context.Context, request *box_v1.BoxIDRequest) (*abstract.MessageResponse, error) {
+	log.Printf("[box_v1][delivery][PurgeBox]")
+	tracer := otel.Tracer("[box_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[PurgeBox]")
+	defer span.End()
+
+	if request.BoxID <= 0 {
+		tracing.EventErrorTracer(span, errors.New("invalid request id"), "bad request")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, "invalid request id")
+	}
+
+	err := b.useCase.PurgeBox(ctx, request.BoxID)
+	if err != nil {
+		if errors.Is(err, errlst.ErrBoxNotFound) {
+			tracing.EventErrorTracer(span, err, "Deleted box not found")
+			return nil, status.Errorf(grpcCodes.NotFound, "Error: %v", err)
+		}
+		if errors.Is(err, errlst.ErrBoxHasDependents) {
+			tracing.EventErrorTracer(span, err, "Box has dependents")
+			return nil, status.Errorf(grpcCodes.FailedPrecondition, "Error: %v", err)
+		}
+
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, "Failed to purge: %v", err)
+	}
+
+	span.SetStatus(codes.Ok, "Successfully purged box")
+	return &abstract.MessageResponse{Message: "Successfully Purged Box"}, nil
+}
+
+// ListBoxVersions is
+func (b *BoxHandler) ListBoxVersions(ctx context.Context, request *box_v1.BoxVersionsRequest) (*box_v1.BoxVersionListResponse, error) {
+	log.Printf("[box_v1][handler][ListBoxVersions]")
+	tracer := otel.Tracer("[box_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[ListBoxVersions]")
+	defer span.End()
+
+	versionsRequest := boxModel.FromVersionsGRPC(request)
+
+	err := reqvalidator.ValidateRequest(versionsRequest)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("reqvalidator.ValidateRequest %v", err))
+	}
+
+	listOfVersions, err := b.useCase.ListBoxVersions(ctx, versionsRequest)
+	if err != nil {
+		if errors.Is(err, errlst.ErrBoxNotFound) {
+			tracing.EventErrorTracer(span, err, "Box not found")
+			return nil, status.Errorf(grpcCodes.NotFound, "Error: %v", err)
+		}
+
+		tracing.EventErrorTracer(span, err, "unable to get list of Box versions")
+		return nil, status.Errorf(grpcCodes.Internal, "Failed to get list of Box versions: %v", err)
+	}
+
+	span.SetStatus(codes.Ok, "Successfully received box versions")
+	return boxModel.VersionListToGRPC(listOfVersions), nil
+}
+
 // GetBoxByID is
 func (b *BoxHandler) GetBoxByID(ctx context.Context, request *box_v1.BoxIDRequest) (*box_v1.BoxAllInfo, error) {
 	log.Printf("[box_v1][delivery][GetBoxByID]")
@@ -2,6 +2,7 @@ package delivery
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"Homework-1/pkg/api/abstract"
 	"Homework-1/pkg/api/box_v1"
 	"Homework-1/pkg/errlst"
+	"Homework-1/pkg/money"
 )
 
 // TestBoxHandler_CreateBox is
@@ -51,10 +53,11 @@ func TestBoxHandler_CreateBox(t *testing.T) {
 				When(
 					minimock.AnyContext,
 					boxModel.Request{
-						Name:    "test",
-						Cost:    12,
-						IsCheck: true,
-						Weight:  12.1,
+						Name:     "test",
+						Cost:     1200,
+						Currency: "RUB",
+						IsCheck:  true,
+						Weight:   12.1,
 					}).Then(1, nil),
 		},
 		{
@@ -74,10 +77,11 @@ func TestBoxHandler_CreateBox(t *testing.T) {
 				When(
 					minimock.AnyContext,
 					boxModel.Request{
-						Name:    "test",
-						Cost:    12,
-						IsCheck: true,
-						Weight:  12.1,
+						Name:     "test",
+						Cost:     1200,
+						Currency: "RUB",
+						IsCheck:  true,
+						Weight:   12.1,
 					}).Then(-1, errlst.ErrBoxAlreadyExists),
 		},
 		{
@@ -95,12 +99,88 @@ func TestBoxHandler_CreateBox(t *testing.T) {
 			useCase: boxMock.NewUseCaseMock(ctrl).CreateBoxMock.When(
 				minimock.AnyContext,
 				boxModel.Request{
-					Name:    "test",
-					Cost:    12,
-					IsCheck: true,
-					Weight:  12.1,
+					Name:     "test",
+					Cost:     1200,
+					Currency: "RUB",
+					IsCheck:  true,
+					Weight:   12.1,
 				}).Then(-1, assert.AnError),
 		},
+		{
+			description: "Successfully Created Box with exact cost",
+			requestBody: box_v1.BoxCreateRequest{
+				Box: &box_v1.Box{
+					Name:       "test",
+					IsCheck:    true,
+					Weight:     12.1,
+					CostAmount: &abstract.Money{Units: 1210},
+				},
+			},
+			wantResp: &abstract.MessageResponse{Message: "2"},
+			wantErr:  nil,
+			useCase: boxMock.NewUseCaseMock(ctrl).CreateBoxMock.When(
+				minimock.AnyContext,
+				boxModel.Request{
+					Name:     "test",
+					Cost:     1210,
+					Currency: "RUB",
+					IsCheck:  true,
+					Weight:   12.1,
+				}).Then(2, nil),
+		},
+		{
+			description: "Successfully Created Box with dimensions",
+			requestBody: box_v1.BoxCreateRequest{
+				Box: &box_v1.Box{
+					Name:       "test",
+					IsCheck:    true,
+					Weight:     12.1,
+					CostAmount: &abstract.Money{Units: 1210},
+					Dimensions: &abstract.Dimensions{Length: 40, Width: 30, Height: 20},
+				},
+			},
+			wantResp: &abstract.MessageResponse{Message: "3"},
+			wantErr:  nil,
+			useCase: boxMock.NewUseCaseMock(ctrl).CreateBoxMock.When(
+				minimock.AnyContext,
+				boxModel.Request{
+					Name:       "test",
+					Cost:       1210,
+					Currency:   "RUB",
+					IsCheck:    true,
+					Weight:     12.1,
+					Dimensions: abstractModel.Dimensions{Length: 40, Width: 30, Height: 20},
+				}).Then(3, nil),
+		},
+		{
+			description: "Negative box dimension",
+			requestBody: box_v1.BoxCreateRequest{
+				Box: &box_v1.Box{
+					Name:       "test",
+					IsCheck:    true,
+					Weight:     12.1,
+					CostAmount: &abstract.Money{Units: 1210},
+					Dimensions: &abstract.Dimensions{Length: 40, Width: -30, Height: 20},
+				},
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ValidateRequest Key: 'Request.Dimensions.Width' Error:Field validation for 'Width' failed on the 'gte' tag"),
+			useCase:  boxMock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Cost in another currency",
+			requestBody: box_v1.BoxCreateRequest{
+				Box: &box_v1.Box{
+					Name:       "test",
+					IsCheck:    true,
+					Weight:     12.1,
+					CostAmount: &abstract.Money{Units: 1210, Currency: "USD"},
+				},
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ValidateRequest Key: 'Request.Currency' Error:Field validation for 'Currency' failed on the 'eq' tag"),
+			useCase:  boxMock.NewUseCaseMock(ctrl),
+		},
 		{
 			description: "Request validation failed",
 			requestBody: box_v1.BoxCreateRequest{
@@ -154,6 +234,15 @@ func TestBoxHandler_DeleteBox(t *testing.T) {
 				When(minimock.AnyContext, 1).
 				Then(errlst.ErrBoxNotFound),
 		},
+		{
+			description: "Box has live orders",
+			requestID:   box_v1.BoxIDRequest{BoxID: 1},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.FailedPrecondition, "Error: Box is still used by live orders"),
+			useCase: boxMock.NewUseCaseMock(ctrl).DeleteBoxByIDMock.
+				When(minimock.AnyContext, 1).
+				Then(errlst.ErrBoxHasLiveOrders),
+		},
 		{
 			description: "Internal server error",
 			requestID:   box_v1.BoxIDRequest{BoxID: 1},
@@ -181,6 +270,286 @@ func TestBoxHandler_DeleteBox(t *testing.T) {
 	}
 }
 
+// TestBoxHandler_ArchiveBox is
+func TestBoxHandler_ArchiveBox(t *testing.T) {
+	t.Parallel()
+	ctrl := minimock.NewController(t)
+	tests := []*struct {
+		description string
+		requestID   box_v1.BoxIDRequest
+		wantResp    *abstract.MessageResponse
+		wantErr     error
+		useCase     box.UseCase
+	}{
+		{
+			description: "Successfully Archived Box",
+			requestID:   box_v1.BoxIDRequest{BoxID: 1},
+			wantResp:    &abstract.MessageResponse{Message: "Successfully Archived Box"},
+			wantErr:     nil,
+			useCase:     boxMock.NewUseCaseMock(ctrl).ArchiveBoxMock.When(minimock.AnyContext, 1).Then(nil),
+		},
+		{
+			description: "Box not found",
+			requestID:   box_v1.BoxIDRequest{BoxID: 1},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.NotFound, "Error: Box not found"),
+			useCase: boxMock.NewUseCaseMock(ctrl).ArchiveBoxMock.
+				When(minimock.AnyContext, 1).
+				Then(errlst.ErrBoxNotFound),
+		},
+		{
+			description: "Internal server error",
+			requestID:   box_v1.BoxIDRequest{BoxID: 1},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.Internal, "Failed to archive: assert.AnError general error for testing"),
+			useCase:     boxMock.NewUseCaseMock(ctrl).ArchiveBoxMock.When(minimock.AnyContext, 1).Then(assert.AnError),
+		},
+		{
+			description: "Unable to parse boxID",
+			requestID:   box_v1.BoxIDRequest{BoxID: -1},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.InvalidArgument, "invalid request id"),
+			useCase:     boxMock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewBoxHandler(tt.useCase).ArchiveBox(context.Background(), &tt.requestID)
+
+			assert.Equal(t, tt.wantResp, response)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+// TestBoxHandler_RestoreBox is
+func TestBoxHandler_RestoreBox(t *testing.T) {
+	t.Parallel()
+	ctrl := minimock.NewController(t)
+	tests := []*struct {
+		description string
+		requestID   box_v1.BoxIDRequest
+		wantResp    *abstract.MessageResponse
+		wantErr     error
+		useCase     box.UseCase
+	}{
+		{
+			description: "Successfully Restored Box",
+			requestID:   box_v1.BoxIDRequest{BoxID: 1},
+			wantResp:    &abstract.MessageResponse{Message: "Successfully Restored Box"},
+			wantErr:     nil,
+			useCase:     boxMock.NewUseCaseMock(ctrl).RestoreBoxMock.When(minimock.AnyContext, 1).Then(nil),
+		},
+		{
+			description: "Deleted box not found",
+			requestID:   box_v1.BoxIDRequest{BoxID: 1},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.NotFound, "Error: Box not found"),
+			useCase: boxMock.NewUseCaseMock(ctrl).RestoreBoxMock.
+				When(minimock.AnyContext, 1).
+				Then(errlst.ErrBoxNotFound),
+		},
+		{
+			description: "Box name is taken",
+			requestID:   box_v1.BoxIDRequest{BoxID: 1},
+			wantResp:    nil,
+			wantErr: status.Errorf(
+				codes.AlreadyExists,
+				"Failed to restore: p.psqlDB.ExecContext: pq: duplicate key value violates unique constraint \"box_name_key\"",
+			),
+			useCase: boxMock.NewUseCaseMock(ctrl).RestoreBoxMock.
+				When(minimock.AnyContext, 1).
+				Then(fmt.Errorf("p.psqlDB.ExecContext: %w", errlst.ErrBoxAlreadyExists)),
+		},
+		{
+			description: "Unable to parse boxID",
+			requestID:   box_v1.BoxIDRequest{BoxID: 0},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.InvalidArgument, "invalid request id"),
+			useCase:     boxMock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewBoxHandler(tt.useCase).RestoreBox(context.Background(), &tt.requestID)
+
+			assert.Equal(t, tt.wantResp, response)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+// TestBoxHandler_PurgeBox is
+func TestBoxHandler_PurgeBox(t *testing.T) {
+	t.Parallel()
+	ctrl := minimock.NewController(t)
+	tests := []*struct {
+		description string
+		requestID   box_v1.BoxIDRequest
+		wantResp    *abstract.MessageResponse
+		wantErr     error
+		useCase     box.UseCase
+	}{
+		{
+			description: "Successfully Purged Box",
+			requestID:   box_v1.BoxIDRequest{BoxID: 1},
+			wantResp:    &abstract.MessageResponse{Message: "Successfully Purged Box"},
+			wantErr:     nil,
+			useCase:     boxMock.NewUseCaseMock(ctrl).PurgeBoxMock.When(minimock.AnyContext, 1).Then(nil),
+		},
+		{
+			description: "Deleted box not found",
+			requestID:   box_v1.BoxIDRequest{BoxID: 1},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.NotFound, "Error: Box not found"),
+			useCase: boxMock.NewUseCaseMock(ctrl).PurgeBoxMock.
+				When(minimock.AnyContext, 1).
+				Then(errlst.ErrBoxNotFound),
+		},
+		{
+			description: "Box has dependents",
+			requestID:   box_v1.BoxIDRequest{BoxID: 1},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.FailedPrecondition, "Error: Box is still referenced by orders or tariffs"),
+			useCase: boxMock.NewUseCaseMock(ctrl).PurgeBoxMock.
+				When(minimock.AnyContext, 1).
+				Then(errlst.ErrBoxHasDependents),
+		},
+		{
+			description: "Internal server error",
+			requestID:   box_v1.BoxIDRequest{BoxID: 1},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.Internal, "Failed to purge: assert.AnError general error for testing"),
+			useCase:     boxMock.NewUseCaseMock(ctrl).PurgeBoxMock.When(minimock.AnyContext, 1).Then(assert.AnError),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewBoxHandler(tt.useCase).PurgeBox(context.Background(), &tt.requestID)
+
+			assert.Equal(t, tt.wantResp, response)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+// TestBoxHandler_UpdateBox is
+func TestBoxHandler_UpdateBox(t *testing.T) {
+	t.Parallel()
+	ctrl := minimock.NewController(t)
+
+	name := "tico"
+	isCheck := false
+	weight := 15.5
+	cost := money.Amount(1250)
+
+	tests := []*struct {
+		description string
+		requestBody *box_v1.BoxUpdateRequest
+		wantResp    *abstract.MessageResponse
+		wantErr     error
+		useCase     box.UseCase
+	}{
+		{
+			description: "Successfully Updated Box",
+			requestBody: &box_v1.BoxUpdateRequest{
+				BoxID:      1,
+				Name:       &name,
+				CostAmount: &abstract.Money{Units: 1250, Currency: "RUB"},
+				IsCheck:    &isCheck,
+				Weight:     &weight,
+			},
+			wantResp: &abstract.MessageResponse{Message: "Successfully Updated Box"},
+			wantErr:  nil,
+			useCase: boxMock.NewUseCaseMock(ctrl).UpdateBoxMock.
+				When(minimock.AnyContext, boxModel.UpdateRequest{
+					ID:       1,
+					Name:     &name,
+					Cost:     &cost,
+					Currency: "RUB",
+					IsCheck:  &isCheck,
+					Weight:   &weight,
+				}).
+				Then(nil),
+		},
+		{
+			description: "Successfully Updated only Box weight",
+			requestBody: &box_v1.BoxUpdateRequest{BoxID: 1, Weight: &weight},
+			wantResp:    &abstract.MessageResponse{Message: "Successfully Updated Box"},
+			wantErr:     nil,
+			useCase: boxMock.NewUseCaseMock(ctrl).UpdateBoxMock.
+				When(minimock.AnyContext, boxModel.UpdateRequest{ID: 1, Weight: &weight}).
+				Then(nil),
+		},
+		{
+			description: "Box not found",
+			requestBody: &box_v1.BoxUpdateRequest{BoxID: 1, Weight: &weight},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.NotFound, "Error: Box not found"),
+			useCase: boxMock.NewUseCaseMock(ctrl).UpdateBoxMock.
+				When(minimock.AnyContext, boxModel.UpdateRequest{ID: 1, Weight: &weight}).
+				Then(errlst.ErrBoxNotFound),
+		},
+		{
+			description: "Box name is taken",
+			requestBody: &box_v1.BoxUpdateRequest{BoxID: 1, Name: &name},
+			wantResp:    nil,
+			wantErr: status.Errorf(
+				codes.AlreadyExists,
+				"Failed to update: pq: duplicate key value violates unique constraint \"box_name_key\"",
+			),
+			useCase: boxMock.NewUseCaseMock(ctrl).UpdateBoxMock.
+				When(minimock.AnyContext, boxModel.UpdateRequest{ID: 1, Name: &name}).
+				Then(errlst.ErrBoxAlreadyExists),
+		},
+		{
+			description: "Internal server error",
+			requestBody: &box_v1.BoxUpdateRequest{BoxID: 1, Weight: &weight},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.Internal, "Failed to update: assert.AnError general error for testing"),
+			useCase: boxMock.NewUseCaseMock(ctrl).UpdateBoxMock.
+				When(minimock.AnyContext, boxModel.UpdateRequest{ID: 1, Weight: &weight}).
+				Then(assert.AnError),
+		},
+		{
+			description: "Request validation failed",
+			requestBody: &box_v1.BoxUpdateRequest{Weight: &weight},
+			wantResp:    nil,
+			wantErr: status.Errorf(
+				codes.InvalidArgument,
+				"reqvalidator.ValidateRequest Key: 'UpdateRequest.ID' Error:Field validation for 'ID' failed on the 'required' tag",
+			),
+			useCase: boxMock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Cost in another currency",
+			requestBody: &box_v1.BoxUpdateRequest{BoxID: 1, CostAmount: &abstract.Money{Units: 1250, Currency: "USD"}},
+			wantResp:    nil,
+			wantErr: status.Errorf(
+				codes.InvalidArgument,
+				"reqvalidator.ValidateRequest Key: 'UpdateRequest.Currency' Error:Field validation for 'Currency' failed on the 'eq' tag",
+			),
+			useCase: boxMock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewBoxHandler(tt.useCase).UpdateBox(context.Background(), tt.requestBody)
+
+			assert.Equal(t, tt.wantResp, response)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
 // TestBoxHandler_ListBox is
 func TestBoxHandler_ListBox(t *testing.T) {
 	t.Parallel()
@@ -205,10 +574,12 @@ func TestBoxHandler_ListBox(t *testing.T) {
 				{
 					ID: 1,
 					Box: &box_v1.Box{
-						Name:    "Sample Box",
-						Cost:    100.0,
-						IsCheck: true,
-						Weight:  10.1,
+						Name:       "Sample Box",
+						Cost:       100.0,
+						IsCheck:    true,
+						Weight:     10.1,
+						CostAmount: &abstract.Money{Units: 10000, Currency: "RUB"},
+						Dimensions: &abstract.Dimensions{},
 					},
 					CreatedAt: timestamppb.New(fixedTime),
 					UpdatedAt: timestamppb.New(fixedTime),
@@ -236,7 +607,7 @@ func TestBoxHandler_ListBox(t *testing.T) {
 							{
 								ID:        1,
 								Name:      "Sample Box",
-								Cost:      100.0,
+								Cost:      10000,
 								IsCheck:   true,
 								Weight:    10.1,
 								CreatedAt: fixedTime,
@@ -308,6 +679,112 @@ func TestBoxHandler_ListBox(t *testing.T) {
 	}
 }
 
+// TestBoxHandler_ListBoxVersions is
+func TestBoxHandler_ListBoxVersions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	fixedTime := time.Date(2022, time.January, 1, 12, 0, 0, 0, time.UTC)
+	mockRequest := boxModel.VersionsRequest{BoxID: 1, Page: abstractModel.Page{CurrentPage: 1, ItemsPerPage: 10}}
+
+	tests := []*struct {
+		description string
+		requestBody box_v1.BoxVersionsRequest
+		wantResp    *box_v1.BoxVersionListResponse
+		wantErr     error
+		useCase     box.UseCase
+	}{
+		{
+			description: "Successfully got box versions",
+			requestBody: box_v1.BoxVersionsRequest{BoxID: 1, Page: &abstract.Page{CurrentPage: 1, ItemsPerPage: 10}},
+			wantResp: &box_v1.BoxVersionListResponse{
+				Versions: []*box_v1.BoxVersion{
+					{
+						BoxID:   1,
+						Version: 2,
+						Box: &box_v1.Box{
+							Name:       "package",
+							Cost:       7.5,
+							IsCheck:    true,
+							Weight:     10,
+							CostAmount: &abstract.Money{Units: 750, Currency: "RUB"},
+							Dimensions: &abstract.Dimensions{Length: 40, Width: 30, Height: 20},
+						},
+						CreatedAt: timestamppb.New(fixedTime),
+					},
+					{
+						BoxID:   1,
+						Version: 1,
+						Box: &box_v1.Box{
+							Name:       "package",
+							Cost:       5,
+							IsCheck:    true,
+							Weight:     10,
+							CostAmount: &abstract.Money{Units: 500, Currency: "RUB"},
+							Dimensions: &abstract.Dimensions{Length: 30, Width: 30, Height: 20},
+						},
+						CreatedAt: timestamppb.New(fixedTime),
+					},
+				},
+				Pagination: &abstract.Pagination{
+					Page:       &abstract.Page{CurrentPage: 1, ItemsPerPage: 2},
+					TotalItems: 2,
+				},
+			},
+			wantErr: nil,
+			useCase: boxMock.NewUseCaseMock(ctrl).ListBoxVersionsMock.When(minimock.AnyContext, mockRequest).
+				Then(abstractModel.PaginatedResponse[boxModel.VersionResponse]{
+					Items: []boxModel.VersionResponse{
+						{
+							BoxID: 1, Version: 2, Name: "package", Cost: 750, IsCheck: true, Weight: 10,
+							Dimensions: abstractModel.Dimensions{Length: 40, Width: 30, Height: 20}, CreatedAt: fixedTime,
+						},
+						{
+							BoxID: 1, Version: 1, Name: "package", Cost: 500, IsCheck: true, Weight: 10,
+							Dimensions: abstractModel.Dimensions{Length: 30, Width: 30, Height: 20}, CreatedAt: fixedTime,
+						},
+					},
+					CurrentPage:  1,
+					ItemsPerPage: 2,
+					TotalItems:   2,
+				}, nil),
+		},
+		{
+			description: "Box not found",
+			requestBody: box_v1.BoxVersionsRequest{BoxID: 1, Page: &abstract.Page{CurrentPage: 1, ItemsPerPage: 10}},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.NotFound, "Error: Box not found"),
+			useCase: boxMock.NewUseCaseMock(ctrl).ListBoxVersionsMock.When(minimock.AnyContext, mockRequest).
+				Then(abstractModel.PaginatedResponse[boxModel.VersionResponse]{}, errlst.ErrBoxNotFound),
+		},
+		{
+			description: "Internal server error",
+			requestBody: box_v1.BoxVersionsRequest{BoxID: 1, Page: &abstract.Page{CurrentPage: 1, ItemsPerPage: 10}},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.Internal, "Failed to get list of Box versions: assert.AnError general error for testing"),
+			useCase: boxMock.NewUseCaseMock(ctrl).ListBoxVersionsMock.When(minimock.AnyContext, mockRequest).
+				Then(abstractModel.PaginatedResponse[boxModel.VersionResponse]{}, assert.AnError),
+		},
+		{
+			description: "Request validation failed",
+			requestBody: box_v1.BoxVersionsRequest{Page: &abstract.Page{CurrentPage: 1, ItemsPerPage: 10}},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.InvalidArgument, "reqvalidator.ValidateRequest Key: 'VersionsRequest.BoxID' Error:Field validation for 'BoxID' failed on the 'required' tag"),
+			useCase:     boxMock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewBoxHandler(tt.useCase).ListBoxVersions(context.Background(), &tt.requestBody)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantResp, response)
+		})
+	}
+}
+
 // TestBoxHandler_GetBoxByID is
 func TestBoxHandler_GetBoxByID(t *testing.T) {
 	t.Parallel()
@@ -327,10 +804,12 @@ func TestBoxHandler_GetBoxByID(t *testing.T) {
 			wantResp: &box_v1.BoxAllInfo{
 				ID: 1,
 				Box: &box_v1.Box{
-					Name:    "test",
-					Cost:    12.1,
-					IsCheck: true,
-					Weight:  10.1,
+					Name:       "test",
+					Cost:       12.1,
+					IsCheck:    true,
+					Weight:     10.1,
+					CostAmount: &abstract.Money{Units: 1210, Currency: "RUB"},
+					Dimensions: &abstract.Dimensions{Length: 40, Width: 30, Height: 20},
 				},
 				CreatedAt: timestamppb.New(fixedTime),
 				UpdatedAt: timestamppb.New(fixedTime),
@@ -340,13 +819,14 @@ func TestBoxHandler_GetBoxByID(t *testing.T) {
 				When(minimock.AnyContext, 1).
 				Then(
 					boxModel.AllResponse{
-						ID:        1,
-						Name:      "test",
-						Cost:      12.1,
-						IsCheck:   true,
-						Weight:    10.1,
-						CreatedAt: fixedTime,
-						UpdatedAt: fixedTime,
+						ID:         1,
+						Name:       "test",
+						Cost:       1210,
+						IsCheck:    true,
+						Weight:     10.1,
+						Dimensions: abstractModel.Dimensions{Length: 40, Width: 30, Height: 20},
+						CreatedAt:  fixedTime,
+						UpdatedAt:  fixedTime,
 					}, nil,
 				),
 		},
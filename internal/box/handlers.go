@@ -10,7 +10,13 @@ import (
 // Handlers is
 type Handlers interface {
 	CreateBox(context.Context, *box_v1.BoxCreateRequest) (*abstract.MessageResponse, error)
+	UpdateBox(context.Context, *box_v1.BoxUpdateRequest) (*abstract.MessageResponse, error)
+	ArchiveBox(context.Context, *box_v1.BoxIDRequest) (*abstract.MessageResponse, error)
 	DeleteBox(context.Context, *box_v1.BoxIDRequest) (*abstract.MessageResponse, error)
 	ListBoxes(context.Context, *abstract.Page) (*box_v1.BoxListResponse, error)
+	ListDeletedBoxes(context.Context, *abstract.Page) (*box_v1.BoxListResponse, error)
+	RestoreBox(context.Context, *box_v1.BoxIDRequest) (*abstract.MessageResponse, error)
+	PurgeBox(context.Context, *box_v1.BoxIDRequest) (*abstract.MessageResponse, error)
+	ListBoxVersions(context.Context, *box_v1.BoxVersionsRequest) (*box_v1.BoxVersionListResponse, error)
 	GetBoxByID(context.Context, *box_v1.BoxIDRequest) (*box_v1.BoxAllInfo, error)
 }
@@ -10,8 +10,23 @@ import (
 // Repository is
 type Repository interface {
 	CreateBox(ctx context.Context, box box.Data) (int64, error)
+	UpdateBox(ctx context.Context, updateBoxData box.UpdateData) error
+	ArchiveBox(ctx context.Context, id int64) error
 	DeleteBoxByID(ctx context.Context, id int64) error
+	LockBox(ctx context.Context, id int64) error
+	ShareLiveBoxes(ctx context.Context, ids []int64) error
+	ListDeletedBoxes(ctx context.Context, boxPagination abstract.PageData) ([]box.AllData, error)
+	CountDeletedBoxes(ctx context.Context) (int64, error)
+	GetDeletedBox(ctx context.Context, boxID int64) (box.AllData, error)
+	RestoreBox(ctx context.Context, boxID int64) error
+	CountBoxDependents(ctx context.Context, boxID int64) (int64, error)
+	PurgeBox(ctx context.Context, boxID int64) error
 	ListBoxes(ctx context.Context, boxPagination abstract.PageData) ([]box.AllData, error)
 	CountBoxes(ctx context.Context) (int64, error)
 	GetBox(ctx context.Context, id int64) (box.AllData, error)
+	CountBoxVersions(ctx context.Context, boxID int64) (int64, error)
+	ListBoxVersions(ctx context.Context, versionsData box.VersionsRequestData) ([]box.VersionData, error)
+	GetBoxes(ctx context.Context, ids []int64) ([]box.AllData, error)
+	CanWrap(ctx context.Context, innerID int64, outerID int64) (bool, error)
+	ListWrapRules(ctx context.Context, innerIDs []int64) ([]box.WrapRuleData, error)
 }
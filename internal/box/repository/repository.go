@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
+	"github.com/lib/pq"
+	"github.com/samber/lo"
+
 	"Homework-1/internal/connection"
 	"Homework-1/internal/model/abstract"
 	"Homework-1/internal/model/box"
@@ -31,7 +35,7 @@ func (b *BoxRepository) BoxData(ctx context.Context, id int64) (box.AllResponse,
 	err := b.psqlDB.Get(
 		ctx,
 		&boxData,
-		"SELECT id, name, cost, is_check, weight, created_at, updated_at FROM box WHERE deleted_at IS NULL AND id = $1",
+		"SELECT id, name, cost, is_check, weight, length, width, height, archived_at, created_at, updated_at FROM box WHERE deleted_at IS NULL AND id = $1",
 		id,
 	)
 
@@ -49,11 +53,17 @@ func (b *BoxRepository) CreateBox(ctx context.Context, box box.Data) (int64, err
 	var id int64
 	row := b.psqlDB.QueryRow(
 		ctx,
-		"INSERT INTO box(name, cost, is_check, weight) VALUES ($1,$2,$3,$4) RETURNING ID;",
+		"WITH inserted AS (INSERT INTO box(name, cost, is_check, weight, length, width, height) VALUES ($1,$2,$3,$4,$5,$6,$7) "+
+			"RETURNING id, name, cost, is_check, weight, length, width, height) "+
+			"INSERT INTO box_version(box_id, version, name, cost, is_check, weight, length, width, height) "+
+			"SELECT id, 1, name, cost, is_check, weight, length, width, height FROM inserted RETURNING box_id;",
 		box.Name,
 		box.Cost,
 		box.IsCheck,
 		box.Weight,
+		box.Length,
+		box.Width,
+		box.Height,
 	)
 
 	err := row.Scan(&id)
@@ -64,6 +74,99 @@ func (b *BoxRepository) CreateBox(ctx context.Context, box box.Data) (int64, err
 	return id, nil
 }
 
+// UpdateBox is, every update is stored as a new box version
+func (b *BoxRepository) UpdateBox(ctx context.Context, updateBoxData box.UpdateData) error {
+	log.Println("[box_v1][repository][UpdateBox]")
+
+	query := "UPDATE box SET"
+	setValues := make([]interface{}, 0)
+
+	num := 1
+
+	if updateBoxData.Name != nil {
+		query += " name = $" + strconv.Itoa(num) + ","
+
+		setValues = append(setValues, *updateBoxData.Name)
+		num++
+	}
+
+	if updateBoxData.Cost != nil {
+		query += " cost = $" + strconv.Itoa(num) + ","
+
+		setValues = append(setValues, *updateBoxData.Cost)
+		num++
+	}
+
+	if updateBoxData.IsCheck != nil {
+		query += " is_check = $" + strconv.Itoa(num) + ","
+
+		setValues = append(setValues, *updateBoxData.IsCheck)
+		num++
+	}
+
+	if updateBoxData.Weight != nil {
+		query += " weight = $" + strconv.Itoa(num) + ","
+
+		setValues = append(setValues, *updateBoxData.Weight)
+		num++
+	}
+	if updateBoxData.Dimensions != nil {
+		query += " length = $" + strconv.Itoa(num) + ", width = $" + strconv.Itoa(num+1) + ", height = $" + strconv.Itoa(num+2) + ","
+
+		setValues = append(setValues, updateBoxData.Dimensions.Length, updateBoxData.Dimensions.Width, updateBoxData.Dimensions.Height)
+		num += 3
+	}
+	query += " updated_at = NOW()"
+	query += " WHERE id = $" + strconv.Itoa(num) + " AND deleted_at IS NULL RETURNING id, name, cost, is_check, weight, length, width, height"
+	query = "WITH updated AS (" + query + ") " +
+		"INSERT INTO box_version(box_id, version, name, cost, is_check, weight, length, width, height) " +
+		"SELECT id, (SELECT COALESCE(MAX(v.version), 0) + 1 FROM box_version v WHERE v.box_id = updated.id), " +
+		"name, cost, is_check, weight, length, width, height FROM updated"
+
+	setValues = append(setValues, updateBoxData.ID)
+
+	result, err := b.psqlDB.Execute(ctx, query, setValues...)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.ExecContext: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	if rows == 0 {
+		return errlst.ErrBoxNotFound
+	}
+
+	return nil
+}
+
+// ArchiveBox is, archiving an archived box keeps its first archived_at
+func (b *BoxRepository) ArchiveBox(ctx context.Context, id int64) error {
+	log.Println("[box_v1][repository][ArchiveBox]")
+
+	result, err := b.psqlDB.Execute(
+		ctx,
+		"UPDATE box SET archived_at = COALESCE(archived_at, NOW()), updated_at = NOW() WHERE id = $1 AND deleted_at IS NULL",
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.ExecContext: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	if rows == 0 {
+		return errlst.ErrBoxNotFound
+	}
+
+	return nil
+}
+
 // DeleteBoxByID is
 func (b *BoxRepository) DeleteBoxByID(ctx context.Context, id int64) error {
 	log.Println("[box_v1][repository][DeleteBoxByID]")
@@ -90,6 +193,183 @@ func (b *BoxRepository) DeleteBoxByID(ctx context.Context, id int64) error {
 	return nil
 }
 
+// LockBox locks the row of the box until the end of the transaction, an order that is received with the box
+// waits for it, so the orders counted under the lock stay the orders of the box. A missing box is left to the caller
+func (b *BoxRepository) LockBox(ctx context.Context, id int64) error {
+	log.Println("[box_v1][repository][LockBox]")
+	var ids []int64
+
+	err := b.psqlDB.Select(ctx, &ids, "SELECT id FROM box WHERE id = $1 FOR UPDATE", id)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.Select: %w", err)
+	}
+
+	return nil
+}
+
+// ShareLiveBoxes locks the boxes against a delete, archive or purge until the end of the transaction, an order is
+// received only under it, so the boxes it is packed in are the live boxes it was checked against
+func (b *BoxRepository) ShareLiveBoxes(ctx context.Context, ids []int64) error {
+	log.Println("[box_v1][repository][ShareLiveBoxes]")
+	var boxAllData []box.AllData
+
+	err := b.psqlDB.Select(
+		ctx,
+		&boxAllData,
+		"SELECT id, archived_at FROM box WHERE id = ANY($1) AND deleted_at IS NULL ORDER BY id FOR SHARE",
+		pq.Array(ids),
+	)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.Select: %w", err)
+	}
+
+	if len(boxAllData) < len(lo.Uniq(ids)) {
+		return errlst.ErrBoxNotFound
+	}
+
+	for _, item := range boxAllData {
+		if item.ArchivedAt != nil {
+			return errlst.ErrBoxArchived
+		}
+	}
+
+	return nil
+}
+
+// ListDeletedBoxes is
+func (b *BoxRepository) ListDeletedBoxes(ctx context.Context, boxPagination abstract.PageData) ([]box.AllData, error) {
+	log.Println("[box_v1][repository][ListDeletedBoxes]")
+	offset := (boxPagination.CurrentPage - 1) * boxPagination.ItemsPerPage
+	var boxAllData []box.AllData
+
+	err := b.psqlDB.Select(
+		ctx,
+		&boxAllData,
+		"SELECT id, name, cost, is_check, weight, length, width, height, archived_at, deleted_at, created_at, updated_at FROM box "+
+			"WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC, id OFFSET $1 LIMIT $2",
+		offset,
+		boxPagination.ItemsPerPage,
+	)
+	if err != nil {
+		return []box.AllData{}, fmt.Errorf("p.psqlDB.Select: %w", err)
+	}
+
+	return boxAllData, nil
+}
+
+// CountDeletedBoxes is
+func (b *BoxRepository) CountDeletedBoxes(ctx context.Context) (int64, error) {
+	log.Println("[box_v1][repository][CountDeletedBoxes]")
+	var totalCount int64
+
+	err := b.psqlDB.Get(
+		ctx,
+		&totalCount,
+		"SELECT COUNT(*) FROM box WHERE deleted_at IS NOT NULL",
+	)
+	if err != nil {
+		return 0, fmt.Errorf("b.psqlDB.Get: %w", err)
+	}
+
+	return totalCount, nil
+}
+
+// GetDeletedBox is, the row is locked until the end of the transaction so nothing can refer to the box while it is purged
+func (b *BoxRepository) GetDeletedBox(ctx context.Context, boxID int64) (box.AllData, error) {
+	log.Println("[box_v1][repository][GetDeletedBox]")
+
+	var boxAllData box.AllData
+
+	err := b.psqlDB.Get(
+		ctx,
+		&boxAllData,
+		"SELECT id, name, cost, is_check, weight, length, width, height, archived_at, deleted_at, created_at, updated_at FROM box "+
+			"WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE",
+		boxID,
+	)
+	if err != nil {
+		return box.AllData{}, fmt.Errorf("p.psqlDB.Get: %w", err)
+	}
+
+	return boxAllData, nil
+}
+
+// RestoreBox is, the name of the box has to be free among the live boxes
+func (b *BoxRepository) RestoreBox(ctx context.Context, boxID int64) error {
+	log.Println("[box_v1][repository][RestoreBox]")
+
+	result, err := b.psqlDB.Execute(
+		ctx,
+		"UPDATE box SET deleted_at = NULL, updated_at = NOW() WHERE id = $1 AND deleted_at IS NOT NULL",
+		boxID,
+	)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.ExecContext: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	if rows == 0 {
+		return errlst.ErrBoxNotFound
+	}
+
+	return nil
+}
+
+// CountBoxDependents is the number of orders, packaging layers and tariff surcharges that refer to the box
+func (b *BoxRepository) CountBoxDependents(ctx context.Context, boxID int64) (int64, error) {
+	log.Println("[box_v1][repository][CountBoxDependents]")
+	var totalCount int64
+
+	err := b.psqlDB.Get(
+		ctx,
+		&totalCount,
+		"SELECT (SELECT COUNT(*) FROM orders WHERE box_id = $1) + "+
+			"(SELECT COUNT(*) FROM order_packaging WHERE box_id = $1) + "+
+			"(SELECT COUNT(*) FROM tariff_box_surcharge WHERE box_id = $1)",
+		boxID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("b.psqlDB.Get: %w", err)
+	}
+
+	return totalCount, nil
+}
+
+// PurgeBox is, a deleted box is removed for good together with its versions and wrap rules
+func (b *BoxRepository) PurgeBox(ctx context.Context, boxID int64) error {
+	log.Println("[box_v1][repository][PurgeBox]")
+
+	_, err := b.psqlDB.Execute(ctx, "DELETE FROM box_wrap_rule WHERE inner_box_id = $1 OR outer_box_id = $1", boxID)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.ExecContext: %w", err)
+	}
+
+	_, err = b.psqlDB.Execute(ctx, "DELETE FROM box_version WHERE box_id = $1", boxID)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.ExecContext: %w", err)
+	}
+
+	result, err := b.psqlDB.Execute(ctx, "DELETE FROM box WHERE id = $1 AND deleted_at IS NOT NULL", boxID)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.ExecContext: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	if rows == 0 {
+		return errlst.ErrBoxNotFound
+	}
+
+	return nil
+}
+
 // CountBoxes is
 func (b *BoxRepository) CountBoxes(ctx context.Context) (int64, error) {
 	log.Println("[box_v1][repository][CountBoxes]")
@@ -116,7 +396,7 @@ func (b *BoxRepository) ListBoxes(ctx context.Context, boxPagination abstract.Pa
 	err := b.psqlDB.Select(
 		ctx,
 		&boxAllData,
-		"SELECT id, name, cost, is_check, weight, created_at, updated_at FROM box WHERE deleted_at IS NULL "+
+		"SELECT id, name, cost, is_check, weight, length, width, height, archived_at, created_at, updated_at FROM box WHERE deleted_at IS NULL "+
 			"ORDER BY created_at DESC OFFSET $1 LIMIT $2",
 		offset,
 		boxPagination.ItemsPerPage,
@@ -137,7 +417,7 @@ func (b *BoxRepository) GetBox(ctx context.Context, boxID int64) (box.AllData, e
 	err := b.psqlDB.Get(
 		ctx,
 		&boxAllData,
-		"Select id, name, cost, is_check, weight, created_at, updated_at FROM box Where id=$1 AND deleted_at IS NULL",
+		"Select id, name, cost, is_check, weight, length, width, height, archived_at, created_at, updated_at FROM box Where id=$1 AND deleted_at IS NULL",
 		boxID,
 	)
 	if err != nil {
@@ -146,3 +426,102 @@ func (b *BoxRepository) GetBox(ctx context.Context, boxID int64) (box.AllData, e
 
 	return boxAllData, nil
 }
+
+// GetBoxes is
+func (b *BoxRepository) GetBoxes(ctx context.Context, ids []int64) ([]box.AllData, error) {
+	log.Println("[box_v1][repository][GetBoxes]")
+
+	var boxAllData []box.AllData
+
+	err := b.psqlDB.Select(
+		ctx,
+		&boxAllData,
+		"SELECT id, name, cost, is_check, weight, length, width, height, archived_at, created_at, updated_at FROM box "+
+			"WHERE id = ANY($1) AND deleted_at IS NULL",
+		pq.Array(ids),
+	)
+	if err != nil {
+		return []box.AllData{}, fmt.Errorf("p.psqlDB.Select: %w", err)
+	}
+
+	return boxAllData, nil
+}
+
+// CanWrap is
+func (b *BoxRepository) CanWrap(ctx context.Context, innerID int64, outerID int64) (bool, error) {
+	log.Println("[box_v1][repository][CanWrap]")
+
+	var canWrap bool
+
+	err := b.psqlDB.Get(
+		ctx,
+		&canWrap,
+		"SELECT EXISTS (SELECT 1 FROM box_wrap_rule WHERE inner_box_id = $1 AND outer_box_id = $2)",
+		innerID,
+		outerID,
+	)
+	if err != nil {
+		return false, fmt.Errorf("b.psqlDB.Get: %w", err)
+	}
+
+	return canWrap, nil
+}
+
+// ListWrapRules is
+func (b *BoxRepository) ListWrapRules(ctx context.Context, innerIDs []int64) ([]box.WrapRuleData, error) {
+	log.Println("[box_v1][repository][ListWrapRules]")
+
+	var wrapRules []box.WrapRuleData
+
+	err := b.psqlDB.Select(
+		ctx,
+		&wrapRules,
+		"SELECT inner_box_id, outer_box_id FROM box_wrap_rule WHERE inner_box_id = ANY($1)",
+		pq.Array(innerIDs),
+	)
+	if err != nil {
+		return []box.WrapRuleData{}, fmt.Errorf("p.psqlDB.Select: %w", err)
+	}
+
+	return wrapRules, nil
+}
+
+// CountBoxVersions is
+func (b *BoxRepository) CountBoxVersions(ctx context.Context, boxID int64) (int64, error) {
+	log.Println("[box_v1][repository][CountBoxVersions]")
+	var totalCount int64
+
+	err := b.psqlDB.Get(
+		ctx,
+		&totalCount,
+		"SELECT COUNT(*) FROM box_version WHERE box_id = $1",
+		boxID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("b.psqlDB.Get: %w", err)
+	}
+
+	return totalCount, nil
+}
+
+// ListBoxVersions is
+func (b *BoxRepository) ListBoxVersions(ctx context.Context, versionsData box.VersionsRequestData) ([]box.VersionData, error) {
+	log.Println("[box_v1][repository][ListBoxVersions]")
+	offset := (versionsData.CurrentPage - 1) * versionsData.ItemsPerPage
+	var versionData []box.VersionData
+
+	err := b.psqlDB.Select(
+		ctx,
+		&versionData,
+		"SELECT box_id, version, name, cost, is_check, weight, length, width, height, created_at FROM box_version WHERE box_id = $1 "+
+			"ORDER BY version DESC OFFSET $2 LIMIT $3",
+		versionsData.BoxID,
+		offset,
+		versionsData.ItemsPerPage,
+	)
+	if err != nil {
+		return []box.VersionData{}, fmt.Errorf("p.psqlDB.Select: %w", err)
+	}
+
+	return versionData, nil
+}
@@ -13,7 +13,13 @@ import (
 // UseCase is
 type UseCase interface {
 	CreateBox(ctx context.Context, request boxModel.Request) (int64, error)
+	UpdateBox(ctx context.Context, request boxModel.UpdateRequest) error
+	ArchiveBox(ctx context.Context, boxID int64) error
 	DeleteBoxByID(ctx context.Context, boxID int64) error
+	ListDeletedBoxes(ctx context.Context, boxPagination abstract.Page) (abstract.PaginatedResponse[boxModel.AllResponse], error)
+	RestoreBox(ctx context.Context, boxID int64) error
+	PurgeBox(ctx context.Context, boxID int64) error
 	ListBoxes(ctx context.Context, boxPagination abstract.Page) (abstract.PaginatedResponse[boxModel.AllResponse], error)
 	GetBox(ctx context.Context, boxID int64) (boxModel.AllResponse, error)
+	ListBoxVersions(ctx context.Context, request boxModel.VersionsRequest) (abstract.PaginatedResponse[boxModel.VersionResponse], error)
 }
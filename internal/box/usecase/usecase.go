@@ -10,6 +10,7 @@ import (
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	"Homework-1/internal/cache"
 	"Homework-1/internal/database"
@@ -48,6 +49,46 @@ func (b *BoxUseCase) CreateBox(ctx context.Context, request box.Request) (int64,
 	return id, nil
 }
 
+// UpdateBox is, the cached box is dropped after the update is committed
+// so that orders are never validated against the old limits
+func (b *BoxUseCase) UpdateBox(ctx context.Context, request box.UpdateRequest) error {
+	log.Println("[box][useCase][UpdateBox]")
+	tracer := otel.Tracer("[box_v1][useCase]")
+	ctx, span := tracer.Start(ctx, "[UpdateBox]")
+	defer span.End()
+
+	if err := b.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		return db.BoxRepo().UpdateBox(ctx, request.ToStorage())
+	}); err != nil {
+		tracing.ErrorTracer(span, err)
+		return err
+	}
+
+	b.invalidateBox(ctx, span, request.ID)
+
+	span.SetStatus(codes.Ok, "Successfully updated box")
+	return nil
+}
+
+// ArchiveBox is, an archived box stays with the orders that already use it
+// but is dropped from the cache right away so that new orders can not use it
+func (b *BoxUseCase) ArchiveBox(ctx context.Context, boxID int64) error {
+	log.Println("[box][useCase][ArchiveBox]")
+	tracer := otel.Tracer("[box_v1][useCase]")
+	ctx, span := tracer.Start(ctx, "[ArchiveBox]")
+	defer span.End()
+
+	if err := b.repo.BoxRepo().ArchiveBox(ctx, boxID); err != nil {
+		tracing.ErrorTracer(span, err)
+		return err
+	}
+
+	b.invalidateBox(ctx, span, boxID)
+
+	span.SetStatus(codes.Ok, "Successfully archived box")
+	return nil
+}
+
 // DeleteBoxByID is
 func (b *BoxUseCase) DeleteBoxByID(ctx context.Context, boxID int64) error {
 	log.Println("[box][useCase][DeleteBoxByID]")
@@ -55,12 +96,28 @@ func (b *BoxUseCase) DeleteBoxByID(ctx context.Context, boxID int64) error {
 	ctx, span := tracer.Start(ctx, "[DeleteBoxByID]")
 	defer span.End()
 
-	err := b.repo.BoxRepo().DeleteBoxByID(ctx, boxID)
-	if err != nil {
+	if err := b.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		if err := db.BoxRepo().LockBox(ctx, boxID); err != nil {
+			return err
+		}
+
+		liveOrders, err := db.OrderRepo().CountLiveOrdersByBox(ctx, boxID)
+		if err != nil {
+			return err
+		}
+
+		if liveOrders > 0 {
+			return errlst.ErrBoxHasLiveOrders
+		}
+
+		return db.BoxRepo().DeleteBoxByID(ctx, boxID)
+	}); err != nil {
 		tracing.ErrorTracer(span, err)
 		return err
 	}
 
+	b.invalidateBox(ctx, span, boxID)
+
 	span.SetStatus(codes.Ok, "Successfully deleted box by ID")
 	return nil
 }
@@ -114,6 +171,153 @@ func (b *BoxUseCase) ListBoxes(ctx context.Context, boxPage abstract.Page) (abst
 	return boxListResponse, nil
 }
 
+// ListDeletedBoxes is
+func (b *BoxUseCase) ListDeletedBoxes(ctx context.Context, boxPage abstract.Page) (abstract.PaginatedResponse[box.AllResponse], error) {
+	log.Println("[box][useCase][ListDeletedBoxes]")
+	tracer := otel.Tracer("[box_v1][useCase]")
+	ctx, span := tracer.Start(ctx, "[ListDeletedBoxes]")
+	defer span.End()
+
+	var boxAllData []box.AllData
+	var boxListResponse abstract.PaginatedResponse[box.AllResponse]
+
+	if err := b.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		count, err := db.BoxRepo().CountDeletedBoxes(ctx)
+		if err != nil {
+			return err
+		}
+
+		boxListResponse.TotalItems = count
+		boxAllData, err = db.BoxRepo().ListDeletedBoxes(ctx, boxPage.ToStorage())
+		return err
+	}); err != nil {
+		tracing.ErrorTracer(span, err)
+		return abstract.PaginatedResponse[box.AllResponse]{}, err
+	}
+
+	boxListResponse.Items = lo.Map(
+		boxAllData,
+		func(item box.AllData, _ int) box.AllResponse {
+			return item.ToServer()
+		},
+	)
+	boxListResponse.CurrentPage = boxPage.CurrentPage
+	boxListResponse.ItemsPerPage = int64(len(boxListResponse.Items))
+
+	span.SetStatus(codes.Ok, "Successfully got list of deleted boxes")
+	return boxListResponse, nil
+}
+
+// RestoreBox is, the box is not restored while a live box has its name
+func (b *BoxUseCase) RestoreBox(ctx context.Context, boxID int64) error {
+	log.Println("[box][useCase][RestoreBox]")
+	tracer := otel.Tracer("[box_v1][useCase]")
+	ctx, span := tracer.Start(ctx, "[RestoreBox]")
+	defer span.End()
+
+	if err := b.repo.BoxRepo().RestoreBox(ctx, boxID); err != nil {
+		tracing.ErrorTracer(span, err)
+		return err
+	}
+
+	b.invalidateBox(ctx, span, boxID)
+
+	span.SetStatus(codes.Ok, "Successfully restored box")
+	return nil
+}
+
+// PurgeBox is, only a deleted box no order or tariff refers to can be purged
+func (b *BoxUseCase) PurgeBox(ctx context.Context, boxID int64) error {
+	log.Println("[box][useCase][PurgeBox]")
+	tracer := otel.Tracer("[box_v1][useCase]")
+	ctx, span := tracer.Start(ctx, "[PurgeBox]")
+	defer span.End()
+
+	if err := b.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		if _, err := db.BoxRepo().GetDeletedBox(ctx, boxID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errlst.ErrBoxNotFound
+			}
+			return err
+		}
+
+		dependents, err := db.BoxRepo().CountBoxDependents(ctx, boxID)
+		if err != nil {
+			return err
+		}
+
+		if dependents > 0 {
+			return errlst.ErrBoxHasDependents
+		}
+
+		return db.BoxRepo().PurgeBox(ctx, boxID)
+	}); err != nil {
+		tracing.ErrorTracer(span, err)
+		return err
+	}
+
+	b.invalidateBox(ctx, span, boxID)
+
+	span.SetStatus(codes.Ok, "Successfully purged box")
+	return nil
+}
+
+// ListBoxVersions is
+func (b *BoxUseCase) ListBoxVersions(
+	ctx context.Context,
+	request box.VersionsRequest,
+) (abstract.PaginatedResponse[box.VersionResponse], error) {
+	log.Println("[box][useCase][ListBoxVersions]")
+	tracer := otel.Tracer("[box_v1][useCase]")
+	ctx, span := tracer.Start(ctx, "[ListBoxVersions]")
+	defer span.End()
+
+	var versionData []box.VersionData
+	var err error
+	var versionListResponse abstract.PaginatedResponse[box.VersionResponse]
+
+	err = b.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		var count int64
+		count, err = db.BoxRepo().CountBoxVersions(ctx, request.BoxID)
+		if err != nil {
+			tracing.ErrorTracer(span, err)
+			return err
+		}
+
+		if count == 0 {
+			return errlst.ErrBoxNotFound
+		}
+
+		versionListResponse.TotalItems = count
+
+		versionData, err = db.BoxRepo().ListBoxVersions(ctx, request.ToStorage())
+		if err != nil {
+			tracing.ErrorTracer(span, err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return abstract.PaginatedResponse[box.VersionResponse]{}, err
+	}
+
+	versionList := lo.Map(
+		versionData,
+		func(item box.VersionData, _ int) box.VersionResponse {
+			return item.ToServer()
+		},
+	)
+
+	versionListResponse.Items = versionList
+	versionListResponse.CurrentPage = request.CurrentPage
+	versionListResponse.ItemsPerPage = int64(len(versionList))
+
+	span.SetStatus(codes.Ok, "Successfully got list of box versions")
+	return versionListResponse, nil
+}
+
 // GetBox is
 func (b *BoxUseCase) GetBox(ctx context.Context, boxID int64) (box.AllResponse, error) {
 	log.Println("[box][useCase][GetBox]")
@@ -163,3 +367,12 @@ func (b *BoxUseCase) GetBox(ctx context.Context, boxID int64) (box.AllResponse,
 	span.SetStatus(codes.Ok, "Successfully got Box")
 	return response, nil
 }
+
+// invalidateBox drops the cached box after it was changed
+func (b *BoxUseCase) invalidateBox(ctx context.Context, span trace.Span, boxID int64) {
+	err := b.cache.Del(ctx, abstract.CacheArgument{ObjectType: "box", ObjectID: boxID})
+	if err != nil {
+		log.Printf("[box][usecase][invalidateBox] b.cache.Del: %v", err)
+		tracing.ErrorTracer(span, err)
+	}
+}
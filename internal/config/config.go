@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/go-playground/validator/v10"
+
+	"Homework-1/pkg/constants"
 )
 
 // Config is
@@ -18,9 +20,13 @@ type Config struct {
 		MetricsPort string `json:"MetricsPort"`
 	}
 	Postgres Postgres `json:"Postgres"`
-	Auth     struct {
-		User     string `json:"AuthUser"`
-		Password string `json:"Password"`
+	// Auth is the admin that is created on start when there is no user with its name yet,
+	// the password is not shipped in the configs and is taken from the AUTH_PASSWORD env when it is set.
+	// CacheSecret keys the cached logins, it is taken from the AUTH_CACHE_SECRET env when it is set
+	Auth struct {
+		User        string `json:"AuthUser"`
+		Password    string `json:"Password"`
+		CacheSecret string `json:"CacheSecret"`
 	} `json:"Auth"`
 	ShutdownTime time.Duration `json:"ShutdownTime"`
 	TLS          struct {
@@ -32,6 +38,11 @@ type Config struct {
 	Redis         Redis         `json:"Redis"`
 	InMemoryCache InMemoryCache `json:"InMemoryCache"`
 	CacheType     string        `json:"CacheType"`
+	ExpirySweeper ExpirySweeper `json:"ExpirySweeper"`
+	ReturnPolicy  ReturnPolicy  `json:"ReturnPolicy"`
+	Volumetric    Volumetric    `json:"Volumetric"`
+	Manifest      Manifest      `json:"Manifest"`
+	Idempotency   Idempotency   `json:"Idempotency"`
 }
 
 // Postgres is
@@ -45,8 +56,9 @@ type Postgres struct {
 
 // Kafka is
 type Kafka struct {
-	Topic   string   `json:"topic" validate:"required"`
-	Brokers []string `json:"brokers" validate:"required"`
+	Topic       string   `json:"topic" validate:"required"`
+	EventsTopic string   `json:"eventsTopic" validate:"required"`
+	Brokers     []string `json:"brokers" validate:"required"`
 }
 
 // Redis is
@@ -60,6 +72,95 @@ type InMemoryCache struct {
 	CleanTime float64 `json:"CleanTime"`
 }
 
+// ExpirySweeper is, zero values fall back to the defaults from constants
+type ExpirySweeper struct {
+	IntervalSeconds int `json:"IntervalSeconds" validate:"gte=0"`
+	BatchSize       int `json:"BatchSize" validate:"gte=0"`
+}
+
+// ReturnPolicy is, BoxWindowHours overrides WindowHours for orders packed in the box with the given name
+type ReturnPolicy struct {
+	WindowHours    int            `json:"WindowHours" validate:"gte=0"`
+	BoxWindowHours map[string]int `json:"BoxWindowHours" validate:"dive,gte=0"`
+}
+
+// Window is the time a client has to return an order packed in the given box
+func (r ReturnPolicy) Window(boxName string) time.Duration {
+	if hours, ok := r.BoxWindowHours[boxName]; ok {
+		return time.Duration(hours) * time.Hour
+	}
+
+	if r.WindowHours > 0 {
+		return time.Duration(r.WindowHours) * time.Hour
+	}
+
+	return constants.DefaultReturnWindow
+}
+
+// Volumetric is, the volumetric weight of a parcel is its volume in cubic centimeters divided by Divisor
+type Volumetric struct {
+	Divisor float64 `json:"Divisor" validate:"gte=0"`
+}
+
+// WeightDivisor is the configured divisor or the default one when it is not set
+func (v Volumetric) WeightDivisor() float64 {
+	if v.Divisor > 0 {
+		return v.Divisor
+	}
+
+	return constants.DefaultVolumetricDivisor
+}
+
+// Manifest is, Columns maps a manifest field such as orderID to the column (or JSON key) that holds it
+// and PackagingSeparator splits the packaging column of a CSV manifest
+type Manifest struct {
+	Columns            map[string]string `json:"Columns"`
+	PackagingSeparator string            `json:"PackagingSeparator"`
+}
+
+// Column is the column of the given field, a field that is not mapped keeps its own name
+func (m Manifest) Column(field string) string {
+	if column, ok := m.Columns[field]; ok && column != "" {
+		return column
+	}
+
+	return field
+}
+
+// Separator is the configured packaging separator or the default one when it is not set
+func (m Manifest) Separator() string {
+	if m.PackagingSeparator != "" {
+		return m.PackagingSeparator
+	}
+
+	return constants.DefaultPackagingSeparator
+}
+
+// Idempotency is, a stored response is replayed for TTLHours after the first request with its key
+// and a request that is not completed in LeaseSeconds gives its key up to a retry
+type Idempotency struct {
+	TTLHours     int `json:"TTLHours" validate:"gte=0"`
+	LeaseSeconds int `json:"LeaseSeconds" validate:"gte=0"`
+}
+
+// TTL is the configured time to keep a response or the default one when it is not set
+func (i Idempotency) TTL() time.Duration {
+	if i.TTLHours > 0 {
+		return time.Duration(i.TTLHours) * time.Hour
+	}
+
+	return constants.DefaultIdempotencyTTL
+}
+
+// Lease is the configured time a request keeps its key while it is handled or the default one when it is not set
+func (i Idempotency) Lease() time.Duration {
+	if i.LeaseSeconds > 0 {
+		return time.Duration(i.LeaseSeconds) * time.Second
+	}
+
+	return constants.DefaultIdempotencyLease
+}
+
 // LoadConfig is
 func LoadConfig(configPath string) (*Config, error) {
 	// #nosec G304
@@ -82,6 +183,14 @@ func LoadConfig(configPath string) (*Config, error) {
 		return nil, fmt.Errorf("json.NewDecoder.Decode: %w", err)
 	}
 
+	if password := os.Getenv("AUTH_PASSWORD"); password != "" {
+		c.Auth.Password = password
+	}
+
+	if secret := os.Getenv("AUTH_CACHE_SECRET"); secret != "" {
+		c.Auth.CacheSecret = secret
+	}
+
 	err = validator.New().Struct(c)
 	if err != nil {
 		return nil, fmt.Errorf("validator.New.Struct: %w", err)
@@ -4,8 +4,11 @@ import (
 	"context"
 
 	"Homework-1/internal/box"
+	"Homework-1/internal/idempotency"
 	"Homework-1/internal/order"
+	"Homework-1/internal/pricing"
 	"Homework-1/internal/pvz"
+	"Homework-1/internal/user"
 )
 
 // Transaction is
@@ -17,4 +20,7 @@ type Datastore interface {
 	PvzRepo() pvz.Repository
 	OrderRepo() order.Repository
 	BoxRepo() box.Repository
+	PricingRepo() pricing.Repository
+	IdempotencyRepo() idempotency.Repository
+	UserRepo() user.Repository
 }
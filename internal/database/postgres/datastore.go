@@ -10,23 +10,35 @@ import (
 	boxRepository "Homework-1/internal/box/repository"
 	"Homework-1/internal/connection"
 	"Homework-1/internal/database"
+	"Homework-1/internal/idempotency"
+	idempotencyRepository "Homework-1/internal/idempotency/repository"
 	"Homework-1/internal/order"
 	orderRepository "Homework-1/internal/order/repository"
+	"Homework-1/internal/pricing"
+	pricingRepository "Homework-1/internal/pricing/repository"
 	"Homework-1/internal/pvz"
 	pvzRepository "Homework-1/internal/pvz/repository"
+	"Homework-1/internal/user"
+	userRepository "Homework-1/internal/user/repository"
 )
 
 var _ database.Datastore = (*DataStore)(nil)
 
 // DataStore is
 type DataStore struct {
-	db        connection.DB
-	pvz       pvz.Repository
-	pvzInit   sync.Once
-	order     order.Repository
-	orderInit sync.Once
-	box       box.Repository
-	boxInit   sync.Once
+	db              connection.DB
+	pvz             pvz.Repository
+	pvzInit         sync.Once
+	order           order.Repository
+	orderInit       sync.Once
+	box             box.Repository
+	boxInit         sync.Once
+	pricing         pricing.Repository
+	pricingInit     sync.Once
+	idempotency     idempotency.Repository
+	idempotencyInit sync.Once
+	user            user.Repository
+	userInit        sync.Once
 }
 
 // PvzRepo is
@@ -53,6 +65,30 @@ func (d *DataStore) BoxRepo() box.Repository {
 	return d.box
 }
 
+// PricingRepo is
+func (d *DataStore) PricingRepo() pricing.Repository {
+	d.pricingInit.Do(func() {
+		d.pricing = pricingRepository.NewPricingPGRepository(d.db)
+	})
+	return d.pricing
+}
+
+// IdempotencyRepo is
+func (d *DataStore) IdempotencyRepo() idempotency.Repository {
+	d.idempotencyInit.Do(func() {
+		d.idempotency = idempotencyRepository.NewIdempotencyPGRepository(d.db)
+	})
+	return d.idempotency
+}
+
+// UserRepo is
+func (d *DataStore) UserRepo() user.Repository {
+	d.userInit.Do(func() {
+		d.user = userRepository.NewUserPGRepository(d.db)
+	})
+	return d.user
+}
+
 // NewDataStore is
 func NewDataStore(db connection.DBops) database.Datastore {
 	return &DataStore{
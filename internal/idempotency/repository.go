@@ -0,0 +1,15 @@
+package idempotency
+
+import (
+	"context"
+
+	idempotencyModel "Homework-1/internal/model/idempotency"
+)
+
+// Repository is
+type Repository interface {
+	ReserveKey(ctx context.Context, requestData idempotencyModel.RequestData) (bool, error)
+	GetKey(ctx context.Context, username string, key string) (idempotencyModel.Data, error)
+	SaveResponse(ctx context.Context, responseData idempotencyModel.ResponseData) error
+	DeleteKey(ctx context.Context, username string, key string) error
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"Homework-1/internal/connection"
+	"Homework-1/internal/model/idempotency"
+)
+
+// IdempotencyRepository is
+type IdempotencyRepository struct {
+	psqlDB connection.DB
+}
+
+// NewIdempotencyPGRepository is
+func NewIdempotencyPGRepository(psqlDB connection.DB) *IdempotencyRepository {
+	return &IdempotencyRepository{
+		psqlDB: psqlDB,
+	}
+}
+
+// ReserveKey is, a key is scoped to its user, so the same key of two users are two keys. An expired key and a key
+// whose request did not complete in its lease are taken over by the new request, the latter only by the same call,
+// and false means the key is already in use
+func (i *IdempotencyRepository) ReserveKey(ctx context.Context, requestData idempotency.RequestData) (bool, error) {
+	log.Println("[idempotency][repository][ReserveKey]")
+
+	var key string
+	err := i.psqlDB.Get(
+		ctx,
+		&key,
+		"INSERT INTO idempotency_key(username, key, method, request_hash, expires_at, locked_until) VALUES ($1,$2,$3,$4,$5,$6) "+
+			"ON CONFLICT (username, key) DO UPDATE SET method = EXCLUDED.method, request_hash = EXCLUDED.request_hash, "+
+			"response = NULL, code = NULL, message = '', created_at = NOW(), expires_at = EXCLUDED.expires_at, "+
+			"locked_until = EXCLUDED.locked_until "+
+			"WHERE idempotency_key.expires_at < NOW() OR (idempotency_key.code IS NULL "+
+			"AND idempotency_key.locked_until < NOW() AND idempotency_key.method = EXCLUDED.method "+
+			"AND idempotency_key.request_hash = EXCLUDED.request_hash) RETURNING key",
+		requestData.Username,
+		requestData.Key,
+		requestData.Method,
+		requestData.RequestHash,
+		requestData.ExpiresAt,
+		requestData.LockedUntil,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("i.psqlDB.Get: %w", err)
+	}
+
+	return true, nil
+}
+
+// GetKey is
+func (i *IdempotencyRepository) GetKey(ctx context.Context, username string, key string) (idempotency.Data, error) {
+	log.Println("[idempotency][repository][GetKey]")
+
+	var data idempotency.Data
+	err := i.psqlDB.Get(
+		ctx,
+		&data,
+		"SELECT username, key, method, request_hash, response, code, message, created_at, expires_at FROM idempotency_key "+
+			"WHERE username = $1 AND key = $2",
+		username,
+		key,
+	)
+	if err != nil {
+		return idempotency.Data{}, fmt.Errorf("i.psqlDB.Get: %w", err)
+	}
+
+	return data, nil
+}
+
+// SaveResponse is
+func (i *IdempotencyRepository) SaveResponse(ctx context.Context, responseData idempotency.ResponseData) error {
+	log.Println("[idempotency][repository][SaveResponse]")
+
+	_, err := i.psqlDB.Execute(
+		ctx,
+		"UPDATE idempotency_key SET response = $1, code = $2, message = $3, locked_until = NULL WHERE username = $4 AND key = $5",
+		responseData.Response,
+		responseData.Code,
+		responseData.Message,
+		responseData.Username,
+		responseData.Key,
+	)
+	if err != nil {
+		return fmt.Errorf("i.psqlDB.Execute: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteKey is
+func (i *IdempotencyRepository) DeleteKey(ctx context.Context, username string, key string) error {
+	log.Println("[idempotency][repository][DeleteKey]")
+
+	_, err := i.psqlDB.Execute(ctx, "DELETE FROM idempotency_key WHERE username = $1 AND key = $2", username, key)
+	if err != nil {
+		return fmt.Errorf("i.psqlDB.Execute: %w", err)
+	}
+
+	return nil
+}
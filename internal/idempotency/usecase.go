@@ -0,0 +1,16 @@
+// Package idempotency ...
+//
+//go:generate minimock -g -i UseCase -o ./mock/usecase_mock.go -n UseCaseMock
+package idempotency
+
+import (
+	"context"
+
+	idempotencyModel "Homework-1/internal/model/idempotency"
+)
+
+// UseCase is
+type UseCase interface {
+	Begin(ctx context.Context, request idempotencyModel.Request) (idempotencyModel.Response, bool, error)
+	Complete(ctx context.Context, request idempotencyModel.Request, response any, handlerErr error) error
+}
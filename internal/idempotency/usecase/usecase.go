@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+
+	"Homework-1/internal/database"
+	"Homework-1/internal/model/idempotency"
+	"Homework-1/pkg/errlst"
+	"Homework-1/pkg/tracing"
+)
+
+// IdempotencyUseCase is
+type IdempotencyUseCase struct {
+	repo database.Datastore
+}
+
+// NewIdempotencyUseCase is
+func NewIdempotencyUseCase(repo database.Datastore) *IdempotencyUseCase {
+	return &IdempotencyUseCase{repo: repo}
+}
+
+// Begin reserves the key for the request, when the key is already used it returns the stored response
+// and false, a key used for another request or a request that is still handled is an error
+func (i *IdempotencyUseCase) Begin(ctx context.Context, request idempotency.Request) (idempotency.Response, bool, error) {
+	log.Println("[idempotency][useCase][Begin]")
+	tracer := otel.Tracer("[idempotency][useCase]")
+	ctx, span := tracer.Start(ctx, "[Begin]")
+	defer span.End()
+
+	reserved, err := i.repo.IdempotencyRepo().ReserveKey(ctx, request.ToStorage())
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return idempotency.Response{}, false, err
+	}
+
+	if reserved {
+		span.SetStatus(codes.Ok, "Idempotency key reserved")
+		return idempotency.Response{}, true, nil
+	}
+
+	data, err := i.repo.IdempotencyRepo().GetKey(ctx, request.Username, request.Key)
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return idempotency.Response{}, false, err
+	}
+
+	response := data.ToServer()
+	if !response.Matches(request) {
+		tracing.ErrorTracer(span, errlst.ErrIdempotencyKeyReused)
+		return idempotency.Response{}, false, errlst.ErrIdempotencyKeyReused
+	}
+
+	if !response.Completed {
+		tracing.ErrorTracer(span, errlst.ErrIdempotencyKeyInProgress)
+		return idempotency.Response{}, false, errlst.ErrIdempotencyKeyInProgress
+	}
+
+	span.SetStatus(codes.Ok, "Stored response found")
+	return response, false, nil
+}
+
+// Complete stores the outcome of the request, the key of a request that may succeed when retried is released
+func (i *IdempotencyUseCase) Complete(ctx context.Context, request idempotency.Request, response any, handlerErr error) error {
+	log.Println("[idempotency][useCase][Complete]")
+	tracer := otel.Tracer("[idempotency][useCase]")
+	ctx, span := tracer.Start(ctx, "[Complete]")
+	defer span.End()
+
+	if !idempotency.Replayable(handlerErr) {
+		if err := i.repo.IdempotencyRepo().DeleteKey(ctx, request.Username, request.Key); err != nil {
+			tracing.ErrorTracer(span, err)
+			return err
+		}
+
+		span.SetStatus(codes.Ok, "Idempotency key released")
+		return nil
+	}
+
+	responseData, err := idempotency.NewResponseData(request, response, handlerErr)
+	if err == nil {
+		err = i.repo.IdempotencyRepo().SaveResponse(ctx, responseData)
+	}
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		if delErr := i.repo.IdempotencyRepo().DeleteKey(ctx, request.Username, request.Key); delErr != nil {
+			log.Printf("[idempotency][useCase][Complete] DeleteKey: %v", delErr)
+		}
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Response stored")
+	return nil
+}
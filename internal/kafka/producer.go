@@ -8,6 +8,7 @@ import kafkaModel "Homework-1/internal/model/kafka"
 // Producer is
 type Producer interface {
 	SendMessage(topic string, message kafkaModel.Message) error
+	SendEvent(topic string, event kafkaModel.OrderEvent) error
 	Close() error
 	Topic() string
 }
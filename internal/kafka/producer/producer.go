@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/IBM/sarama"
 
@@ -63,6 +64,29 @@ func (p *Producer) SendMessage(topic string, message kafkaModel.Message) error {
 	return nil
 }
 
+// SendEvent is, the order ID is used as the key so events of one order stay ordered
+func (p *Producer) SendEvent(topic string, event kafkaModel.OrderEvent) error {
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic: topic,
+		Key:   sarama.StringEncoder(strconv.FormatInt(event.OrderID, 10)),
+		Value: sarama.StringEncoder(eventBytes),
+	}
+
+	partition, offset, err := p.syncProducer.SendMessage(msg)
+	if err != nil {
+		return fmt.Errorf("p.syncProducer.SendMessage: %w", err)
+	}
+
+	log.Println("[kafka][producer][send_event] Partition: ", partition, " Offset: ", offset, " Event:", event.Type)
+
+	return nil
+}
+
 // Close is
 func (p *Producer) Close() error {
 	err := p.syncProducer.Close()
@@ -0,0 +1,280 @@
+package manifest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"Homework-1/internal/config"
+	abstractModel "Homework-1/internal/model/abstract"
+	orderModel "Homework-1/internal/model/order"
+	"Homework-1/internal/order"
+	"Homework-1/pkg/constants"
+	"Homework-1/pkg/reqvalidator"
+)
+
+// Exported lists
+const (
+	ListReturned = "returned"
+	ListOrders   = "orders"
+)
+
+// Command imports and exports courier manifests through the order use case
+type Command struct {
+	useCase order.UseCase
+	mapping config.Manifest
+	out     io.Writer
+}
+
+// NewCommand is
+func NewCommand(useCase order.UseCase, mapping config.Manifest, out io.Writer) *Command {
+	return &Command{useCase: useCase, mapping: mapping, out: out}
+}
+
+// Run is, args are the subcommand and its flags: import or export
+func (c *Command) Run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New("manifest subcommand is not provided, use import or export")
+	}
+
+	switch args[0] {
+	case "import":
+		return c.runImport(ctx, args[1:])
+	case "export":
+		return c.runExport(ctx, args[1:])
+	default:
+		return fmt.Errorf("unknown manifest subcommand %q, use import or export", args[0])
+	}
+}
+
+// runImport is
+func (c *Command) runImport(ctx context.Context, args []string) error {
+	var path, format string
+	var defaults Defaults
+	var dryRun, strict bool
+
+	flagSet := flag.NewFlagSet("import", flag.ContinueOnError)
+	flagSet.StringVar(&path, "file", "", "manifest file to import")
+	flagSet.StringVar(&format, "format", "", `manifest format "csv" or "jsonl", by default it is given by the file extension`)
+	flagSet.Int64Var(&defaults.PVZID, "pvz", 0, "PVZ of the lines without pvzID")
+	flagSet.IntVar(&defaults.ExpireTimeDuration, "expire", 0, "storage days of the lines without expireTimeDuration")
+	flagSet.BoolVar(&dryRun, "dry-run", false, "only check the manifest and report every line")
+	flagSet.BoolVar(&strict, "strict", false, "receive nothing when any line fails")
+	if err := flagSet.Parse(args); err != nil {
+		return fmt.Errorf("flagSet.Parse: %w", err)
+	}
+
+	if path == "" {
+		return errors.New("flag -file is not specified")
+	}
+
+	manifestFormat, err := formatOf(path, format)
+	if err != nil {
+		return err
+	}
+
+	// #nosec G304
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("os.Open: %w", err)
+	}
+
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf("[manifest][runImport] file.Close: %v\n", err)
+		}
+	}()
+
+	lines, invalid, err := Read(file, manifestFormat, c.mapping, defaults)
+	if err != nil {
+		return fmt.Errorf("manifest.Read: %w", err)
+	}
+
+	response, err := c.Import(ctx, orderModel.BatchRequest{Lines: lines, Strict: strict, DryRun: dryRun}, invalid)
+	if err != nil {
+		return err
+	}
+
+	return c.report(response, dryRun)
+}
+
+// Import validates the lines and receives them, invalid are the lines that could not be read
+func (c *Command) Import(
+	ctx context.Context,
+	request orderModel.BatchRequest,
+	invalid []orderModel.BatchLineResult,
+) (orderModel.BatchResponse, error) {
+	if len(request.Lines)+len(invalid) > constants.ReceiveBatchMaxLines {
+		return orderModel.BatchResponse{}, fmt.Errorf("manifest has more than %d lines", constants.ReceiveBatchMaxLines)
+	}
+
+	valid := make([]orderModel.BatchLine, 0, len(request.Lines))
+	for _, line := range request.Lines {
+		if err := reqvalidator.ValidateRequest(line.Request); err != nil {
+			invalid = append(invalid, orderModel.BatchLineResult{
+				Line:    line.Line,
+				OrderID: line.Request.OrderID,
+				Code:    orderModel.BatchCodeInvalid,
+				Message: err.Error(),
+			})
+			continue
+		}
+
+		valid = append(valid, line)
+	}
+	request.Lines = valid
+
+	var response orderModel.BatchResponse
+	if len(invalid) > 0 && request.Strict && !request.DryRun {
+		response = orderModel.NotProcessed(request.Lines)
+	} else if len(request.Lines) > 0 {
+		var err error
+		response, err = c.useCase.ReceiveOrdersBatch(ctx, request)
+		if err != nil {
+			return orderModel.BatchResponse{}, fmt.Errorf("useCase.ReceiveOrdersBatch: %w", err)
+		}
+	}
+
+	response.AddInvalid(invalid)
+
+	return response, nil
+}
+
+// report is
+func (c *Command) report(response orderModel.BatchResponse, dryRun bool) error {
+	for _, result := range response.Results {
+		if _, err := fmt.Fprintf(c.out, "line %d order %d: %s %s\n", result.Line, result.OrderID, result.Code, result.Message); err != nil {
+			return fmt.Errorf("fmt.Fprintf: %w", err)
+		}
+	}
+
+	var err error
+	if dryRun {
+		_, err = fmt.Fprintf(c.out, "Dry run: %d valid, %d failed\n", response.Valid, response.Failed)
+	} else {
+		_, err = fmt.Fprintf(c.out, "Received: %d, failed: %d\n", response.Received, response.Failed)
+	}
+	if err != nil {
+		return fmt.Errorf("fmt.Fprintf: %w", err)
+	}
+
+	return nil
+}
+
+// runExport is
+func (c *Command) runExport(ctx context.Context, args []string) error {
+	var path, format, list string
+	var request orderModel.ListRequest
+	var status string
+
+	flagSet := flag.NewFlagSet("export", flag.ContinueOnError)
+	flagSet.StringVar(&list, "list", ListReturned, `list to export "returned" or "orders"`)
+	flagSet.StringVar(&path, "file", "", "file to export to, by default the list goes to stdout")
+	flagSet.StringVar(&format, "format", "", `manifest format "csv" or "jsonl", by default it is given by the file extension`)
+	flagSet.Int64Var(&request.PVZID, "pvz", 0, "PVZ to export the orders of")
+	flagSet.Int64Var(&request.Filter.ClientID, "client", 0, "export the orders of the client only")
+	flagSet.StringVar(&status, "status", "", "export the orders in the status only")
+	if err := flagSet.Parse(args); err != nil {
+		return fmt.Errorf("flagSet.Parse: %w", err)
+	}
+
+	request.Filter.Status = orderModel.Status(status)
+	if err := reqvalidator.ValidateRequest(request.Filter); err != nil {
+		return fmt.Errorf("reqvalidator.ValidateRequest: %w", err)
+	}
+	if request.PVZID == 0 {
+		return errors.New("flag -pvz is not specified")
+	}
+
+	manifestFormat := FormatCSV
+	if path != "" || format != "" {
+		var err error
+		if manifestFormat, err = formatOf(path, format); err != nil {
+			return err
+		}
+	}
+
+	out := c.out
+	if path != "" {
+		// #nosec G304
+		file, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("os.Create: %w", err)
+		}
+
+		defer func() {
+			if err := file.Close(); err != nil {
+				log.Printf("[manifest][runExport] file.Close: %v\n", err)
+			}
+		}()
+
+		out = file
+	}
+
+	switch list {
+	case ListReturned:
+		orders, err := c.ExportReturned(ctx, request)
+		if err != nil {
+			return err
+		}
+		return WriteReturned(out, manifestFormat, c.mapping, orders)
+	case ListOrders:
+		orders, err := c.ExportOrders(ctx, request)
+		if err != nil {
+			return err
+		}
+		return WriteOrders(out, manifestFormat, c.mapping, orders)
+	default:
+		return fmt.Errorf("unknown list %q, use returned or orders", list)
+	}
+}
+
+// ExportReturned reads every page of the returned orders
+func (c *Command) ExportReturned(ctx context.Context, request orderModel.ListRequest) ([]orderModel.ReturnedResponse, error) {
+	return readAll(ctx, request, c.useCase.ReturnedOrders)
+}
+
+// ExportOrders reads every page of the orders ordered by id
+func (c *Command) ExportOrders(ctx context.Context, request orderModel.ListRequest) ([]orderModel.AllResponse, error) {
+	request.Filter.SortBy = "order_id"
+	request.Filter.SortDirection = "asc"
+
+	return readAll(ctx, request, c.useCase.OrderList)
+}
+
+// readAll is
+func readAll[T any](
+	ctx context.Context,
+	request orderModel.ListRequest,
+	list func(ctx context.Context, request orderModel.ListRequest) (abstractModel.PaginatedResponse[T], error),
+) ([]T, error) {
+	var items []T
+
+	request.Page = abstractModel.Page{CurrentPage: 1, ItemsPerPage: constants.ManifestExportPageSize}
+	for {
+		response, err := list(ctx, request)
+		if err != nil {
+			return nil, fmt.Errorf("useCase.List: %w", err)
+		}
+
+		items = append(items, response.Items...)
+		if len(response.Items) == 0 || int64(len(items)) >= response.TotalItems {
+			return items, nil
+		}
+
+		request.Page.CurrentPage++
+	}
+}
+
+// formatOf is the format flag or the one given by the file extension
+func formatOf(path string, format string) (Format, error) {
+	if format != "" {
+		return ParseFormat(format)
+	}
+
+	return FormatFromPath(path), nil
+}
@@ -0,0 +1,144 @@
+package manifest
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"Homework-1/internal/config"
+	orderModel "Homework-1/internal/model/order"
+)
+
+// returnedFields are the columns of an exported list of returned orders
+var returnedFields = []string{FieldOrderID, FieldClientID, FieldReturnedAt}
+
+// orderFields are the columns of an exported list of orders
+var orderFields = []string{
+	FieldOrderID, FieldClientID, FieldWeight, FieldBoxID, FieldPackaging, FieldPVZID,
+	FieldLength, FieldWidth, FieldHeight, FieldAcceptedAt, FieldIssuedAt, FieldExpiresAt, FieldCreatedAt,
+}
+
+// WriteReturned writes returned orders for the courier handoff
+func WriteReturned(writer io.Writer, format Format, mapping config.Manifest, orders []orderModel.ReturnedResponse) error {
+	records := make([]map[string]string, len(orders))
+	for index, value := range orders {
+		records[index] = map[string]string{
+			FieldOrderID:    strconv.FormatInt(value.OrderID, 10),
+			FieldClientID:   strconv.FormatInt(value.ClientID, 10),
+			FieldReturnedAt: formatTime(&value.ReturnedAt),
+		}
+	}
+
+	return write(writer, format, mapping, returnedFields, records)
+}
+
+// WriteOrders writes orders in the columns a manifest is imported from
+func WriteOrders(writer io.Writer, format Format, mapping config.Manifest, orders []orderModel.AllResponse) error {
+	records := make([]map[string]string, len(orders))
+	for index, value := range orders {
+		packaging := make([]string, len(value.Packaging))
+		for layer, boxID := range value.Packaging {
+			packaging[layer] = strconv.FormatInt(boxID, 10)
+		}
+
+		records[index] = map[string]string{
+			FieldOrderID:    strconv.FormatInt(value.OrderID, 10),
+			FieldClientID:   strconv.FormatInt(value.ClientID, 10),
+			FieldWeight:     formatFloat(value.Weight),
+			FieldBoxID:      strconv.FormatInt(value.BoxID, 10),
+			FieldPackaging:  strings.Join(packaging, mapping.Separator()),
+			FieldPVZID:      strconv.FormatInt(value.PVZID, 10),
+			FieldLength:     formatFloat(value.Length),
+			FieldWidth:      formatFloat(value.Width),
+			FieldHeight:     formatFloat(value.Height),
+			FieldAcceptedAt: formatTime(value.AcceptedAt),
+			FieldIssuedAt:   formatTime(value.IssuedAt),
+			FieldExpiresAt:  formatTime(value.ExpiresAt),
+			FieldCreatedAt:  formatTime(&value.CreatedAt),
+		}
+	}
+
+	return write(writer, format, mapping, orderFields, records)
+}
+
+// write is, the columns are named by the mapping and JSONL lines keep the column order
+func write(writer io.Writer, format Format, mapping config.Manifest, fields []string, records []map[string]string) error {
+	switch format {
+	case FormatCSV:
+		csvWriter := csv.NewWriter(writer)
+
+		header := make([]string, len(fields))
+		for index, field := range fields {
+			header[index] = mapping.Column(field)
+		}
+
+		if err := csvWriter.Write(header); err != nil {
+			return fmt.Errorf("csvWriter.Write: %w", err)
+		}
+
+		for _, record := range records {
+			row := make([]string, len(fields))
+			for index, field := range fields {
+				row[index] = record[field]
+			}
+
+			if err := csvWriter.Write(row); err != nil {
+				return fmt.Errorf("csvWriter.Write: %w", err)
+			}
+		}
+
+		csvWriter.Flush()
+		return csvWriter.Error()
+	case FormatJSONL:
+		for _, record := range records {
+			var line strings.Builder
+			line.WriteString("{")
+			for index, field := range fields {
+				if index > 0 {
+					line.WriteString(",")
+				}
+
+				key, err := json.Marshal(mapping.Column(field))
+				if err != nil {
+					return fmt.Errorf("json.Marshal: %w", err)
+				}
+
+				value, err := json.Marshal(record[field])
+				if err != nil {
+					return fmt.Errorf("json.Marshal: %w", err)
+				}
+
+				line.Write(key)
+				line.WriteString(":")
+				line.Write(value)
+			}
+			line.WriteString("}\n")
+
+			if _, err := io.WriteString(writer, line.String()); err != nil {
+				return fmt.Errorf("io.WriteString: %w", err)
+			}
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unknown manifest format %q", format)
+	}
+}
+
+// formatTime is, a missing time is an empty column
+func formatTime(value *time.Time) string {
+	if value == nil || value.IsZero() {
+		return ""
+	}
+
+	return value.UTC().Format(time.RFC3339)
+}
+
+// formatFloat is
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,328 @@
+package manifest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"Homework-1/internal/config"
+	abstractModel "Homework-1/internal/model/abstract"
+	orderModel "Homework-1/internal/model/order"
+)
+
+// Format is
+type Format string
+
+// Manifest formats, a JSONL manifest has one JSON object per line
+const (
+	FormatCSV   Format = "csv"
+	FormatJSONL Format = "jsonl"
+)
+
+// Manifest fields, the config maps them to the columns of a courier manifest
+const (
+	FieldOrderID            = "orderID"
+	FieldClientID           = "clientID"
+	FieldWeight             = "weight"
+	FieldBoxID              = "boxID"
+	FieldPackaging          = "packaging"
+	FieldPVZID              = "pvzID"
+	FieldLength             = "length"
+	FieldWidth              = "width"
+	FieldHeight             = "height"
+	FieldExpireTimeDuration = "expireTimeDuration"
+	FieldReturnedAt         = "returnedAt"
+	FieldAcceptedAt         = "acceptedAt"
+	FieldIssuedAt           = "issuedAt"
+	FieldExpiresAt          = "expiresAt"
+	FieldCreatedAt          = "createdAt"
+)
+
+// importFields are the fields read from a manifest
+var importFields = []string{
+	FieldOrderID, FieldClientID, FieldWeight, FieldBoxID, FieldPackaging, FieldPVZID,
+	FieldLength, FieldWidth, FieldHeight, FieldExpireTimeDuration,
+}
+
+// Defaults are the values of the fields a manifest line leaves empty
+type Defaults struct {
+	PVZID              int64
+	ExpireTimeDuration int
+}
+
+// FormatFromPath is the format given by the file extension, anything but .csv is read as JSONL
+func FormatFromPath(path string) Format {
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return FormatCSV
+	}
+
+	return FormatJSONL
+}
+
+// ParseFormat is
+func ParseFormat(format string) (Format, error) {
+	switch Format(strings.ToLower(format)) {
+	case FormatCSV:
+		return FormatCSV, nil
+	case FormatJSONL, "json":
+		return FormatJSONL, nil
+	default:
+		return "", fmt.Errorf("unknown manifest format %q", format)
+	}
+}
+
+// Read reads the manifest lines, the lines that can not be parsed are returned as invalid results.
+// Lines are numbered from 1 without the CSV header
+func Read(reader io.Reader, format Format, mapping config.Manifest, defaults Defaults) ([]orderModel.BatchLine, []orderModel.BatchLineResult, error) {
+	var records []map[string]string
+	var err error
+
+	switch format {
+	case FormatCSV:
+		records, err = readCSV(reader, mapping)
+	case FormatJSONL:
+		records, err = readJSONL(reader, mapping)
+	default:
+		err = fmt.Errorf("unknown manifest format %q", format)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lines := make([]orderModel.BatchLine, 0, len(records))
+	var invalid []orderModel.BatchLineResult
+
+	for index, record := range records {
+		line := int64(index + 1)
+
+		if record == nil {
+			invalid = append(invalid, orderModel.BatchLineResult{
+				Line:    line,
+				Code:    orderModel.BatchCodeInvalid,
+				Message: "Line is not a JSON object",
+			})
+			continue
+		}
+
+		request, err := toRequest(record, mapping, defaults)
+		if err != nil {
+			invalid = append(invalid, orderModel.BatchLineResult{
+				Line:    line,
+				OrderID: request.OrderID,
+				Code:    orderModel.BatchCodeInvalid,
+				Message: err.Error(),
+			})
+			continue
+		}
+
+		lines = append(lines, orderModel.BatchLine{Line: line, Request: request})
+	}
+
+	return lines, invalid, nil
+}
+
+// readCSV is, every record maps a field to its raw value
+func readCSV(reader io.Reader, mapping config.Manifest) ([]map[string]string, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("csvReader.Read: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for index, column := range header {
+		columns[strings.TrimSpace(column)] = index
+	}
+
+	if _, ok := columns[mapping.Column(FieldOrderID)]; !ok {
+		return nil, fmt.Errorf("manifest has no %q column for %s", mapping.Column(FieldOrderID), FieldOrderID)
+	}
+
+	var records []map[string]string
+	for {
+		row, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("csvReader.Read: %w", err)
+		}
+
+		record := make(map[string]string, len(importFields))
+		for _, field := range importFields {
+			if index, ok := columns[mapping.Column(field)]; ok && index < len(row) {
+				record[field] = strings.TrimSpace(row[index])
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// readJSONL is, a line that is not a JSON object gives a nil record so that it is reported with its number
+func readJSONL(reader io.Reader, mapping config.Manifest) ([]map[string]string, error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var records []map[string]string
+	for scanner.Scan() {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(text, &object); err != nil {
+			records = append(records, nil)
+			continue
+		}
+
+		record := make(map[string]string, len(importFields))
+		for _, field := range importFields {
+			raw, ok := object[mapping.Column(field)]
+			if !ok {
+				continue
+			}
+
+			record[field] = jsonValue(raw, mapping.Separator())
+		}
+
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanner.Err: %w", err)
+	}
+
+	return records, nil
+}
+
+// jsonValue is the raw value as it would be written in a CSV column
+func jsonValue(raw json.RawMessage, separator string) string {
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return strings.TrimSpace(text)
+	}
+
+	var list []json.Number
+	if err := json.Unmarshal(raw, &list); err == nil {
+		values := make([]string, len(list))
+		for index, value := range list {
+			values[index] = value.String()
+		}
+		return strings.Join(values, separator)
+	}
+
+	value := strings.TrimSpace(string(raw))
+	if value == "null" {
+		return ""
+	}
+
+	return value
+}
+
+// toRequest is, the packaging of a line without packaging is its box
+func toRequest(record map[string]string, mapping config.Manifest, defaults Defaults) (orderModel.Request, error) {
+	var request orderModel.Request
+	var err error
+
+	if request.OrderID, err = parseInt(record, FieldOrderID); err != nil {
+		return request, err
+	}
+	if request.ClientID, err = parseInt(record, FieldClientID); err != nil {
+		return request, err
+	}
+	if request.BoxID, err = parseInt(record, FieldBoxID); err != nil {
+		return request, err
+	}
+	if request.PVZID, err = parseInt(record, FieldPVZID); err != nil {
+		return request, err
+	}
+	if request.Weight, err = parseFloat(record, FieldWeight); err != nil {
+		return request, err
+	}
+
+	var dimensions abstractModel.Dimensions
+	if dimensions.Length, err = parseFloat(record, FieldLength); err != nil {
+		return request, err
+	}
+	if dimensions.Width, err = parseFloat(record, FieldWidth); err != nil {
+		return request, err
+	}
+	if dimensions.Height, err = parseFloat(record, FieldHeight); err != nil {
+		return request, err
+	}
+	request.Dimensions = dimensions
+
+	expire, err := parseInt(record, FieldExpireTimeDuration)
+	if err != nil {
+		return request, err
+	}
+	request.ExpireTimeDuration = int(expire)
+
+	if packaging := record[FieldPackaging]; packaging != "" {
+		for _, value := range strings.Split(packaging, mapping.Separator()) {
+			boxID, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+			if err != nil {
+				return request, fmt.Errorf("%s: %q is not a box id", FieldPackaging, value)
+			}
+			request.Packaging = append(request.Packaging, boxID)
+		}
+	}
+
+	if len(request.Packaging) == 0 && request.BoxID != 0 {
+		request.Packaging = []int64{request.BoxID}
+	}
+	if len(request.Packaging) > 0 {
+		request.BoxID = request.Packaging[len(request.Packaging)-1]
+	}
+	if request.PVZID == 0 {
+		request.PVZID = defaults.PVZID
+	}
+	if request.ExpireTimeDuration == 0 {
+		request.ExpireTimeDuration = defaults.ExpireTimeDuration
+	}
+
+	return request, nil
+}
+
+// parseInt is, an empty value is zero
+func parseInt(record map[string]string, field string) (int64, error) {
+	if record[field] == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseInt(record[field], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %q is not an integer", field, record[field])
+	}
+
+	return value, nil
+}
+
+// parseFloat is, an empty value is zero
+func parseFloat(record map[string]string, field string) (float64, error) {
+	if record[field] == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseFloat(record[field], 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %q is not a number", field, record[field])
+	}
+
+	return value, nil
+}
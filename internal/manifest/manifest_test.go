@@ -0,0 +1,207 @@
+package manifest
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"Homework-1/internal/config"
+	abstractModel "Homework-1/internal/model/abstract"
+	orderModel "Homework-1/internal/model/order"
+	"Homework-1/internal/order"
+	orderMock "Homework-1/internal/order/mock"
+)
+
+// TestRead is
+func TestRead(t *testing.T) {
+	t.Parallel()
+
+	mapping := config.Manifest{
+		Columns: map[string]string{
+			FieldOrderID:  "order_id",
+			FieldClientID: "client_id",
+			FieldPVZID:    "pvz_id",
+		},
+	}
+	defaults := Defaults{PVZID: 7, ExpireTimeDuration: 30}
+
+	tests := []*struct {
+		description string
+		format      Format
+		manifest    string
+		wantLines   []orderModel.BatchLine
+		wantInvalid []orderModel.BatchLineResult
+		wantErr     string
+	}{
+		{
+			description: "CSV with mapped columns and defaults",
+			format:      FormatCSV,
+			manifest: "order_id,client_id,weight,packaging,pvz_id,length\n" +
+				"1,2,9,3|1,4,30\n" +
+				"2,2,1.5,1,,\n",
+			wantLines: []orderModel.BatchLine{
+				{Line: 1, Request: orderModel.Request{
+					ExpireTimeDuration: 30, OrderID: 1, ClientID: 2, Weight: 9, BoxID: 1, PVZID: 4,
+					Packaging: []int64{3, 1}, Dimensions: abstractModel.Dimensions{Length: 30},
+				}},
+				{Line: 2, Request: orderModel.Request{
+					ExpireTimeDuration: 30, OrderID: 2, ClientID: 2, Weight: 1.5, BoxID: 1, PVZID: 7,
+					Packaging: []int64{1},
+				}},
+			},
+		},
+		{
+			description: "CSV with a line that can not be parsed",
+			format:      FormatCSV,
+			manifest:    "order_id,client_id,weight,boxID\n1,2,heavy,1\n",
+			wantLines:   []orderModel.BatchLine{},
+			wantInvalid: []orderModel.BatchLineResult{
+				{Line: 1, OrderID: 1, Code: orderModel.BatchCodeInvalid, Message: `weight: "heavy" is not a number`},
+			},
+		},
+		{
+			description: "CSV without the order id column",
+			format:      FormatCSV,
+			manifest:    "orderID,client_id\n1,2\n",
+			wantErr:     `manifest has no "order_id" column for orderID`,
+		},
+		{
+			description: "JSONL with numbers, strings and a broken line",
+			format:      FormatJSONL,
+			manifest: `{"order_id": 1, "client_id": "2", "weight": 9, "packaging": [3, 1], "expireTimeDuration": 10}` + "\n" +
+				"\n" +
+				"not json\n",
+			wantLines: []orderModel.BatchLine{
+				{Line: 1, Request: orderModel.Request{
+					ExpireTimeDuration: 10, OrderID: 1, ClientID: 2, Weight: 9, BoxID: 1, PVZID: 7,
+					Packaging: []int64{3, 1},
+				}},
+			},
+			wantInvalid: []orderModel.BatchLineResult{
+				{Line: 2, Code: orderModel.BatchCodeInvalid, Message: "Line is not a JSON object"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			lines, invalid, err := Read(strings.NewReader(tt.manifest), tt.format, mapping, defaults)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantLines, lines)
+			assert.Equal(t, tt.wantInvalid, invalid)
+		})
+	}
+}
+
+// TestCommand_Import is
+func TestCommand_Import(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	validLine := orderModel.BatchLine{Line: 1, Request: orderModel.Request{
+		ExpireTimeDuration: 30, OrderID: 1, ClientID: 2, Weight: 9, BoxID: 1, PVZID: 4, Packaging: []int64{1},
+	}}
+	invalidLine := orderModel.BatchLine{Line: 2, Request: orderModel.Request{
+		OrderID: 2, ClientID: 2, Weight: 9, BoxID: 1, PVZID: 4, Packaging: []int64{1},
+	}}
+	invalidMessage := "Key: 'Request.ExpireTimeDuration' Error:Field validation for 'ExpireTimeDuration' failed on the 'required' tag"
+
+	tests := []*struct {
+		description string
+		request     orderModel.BatchRequest
+		invalid     []orderModel.BatchLineResult
+		wantResp    orderModel.BatchResponse
+		useCase     order.UseCase
+	}{
+		{
+			description: "Dry run reports every line",
+			request:     orderModel.BatchRequest{Lines: []orderModel.BatchLine{validLine, invalidLine}, DryRun: true},
+			invalid:     []orderModel.BatchLineResult{{Line: 3, Code: orderModel.BatchCodeInvalid, Message: "Line is not a JSON object"}},
+			wantResp: orderModel.BatchResponse{
+				Results: []orderModel.BatchLineResult{
+					{Line: 1, OrderID: 1, Code: orderModel.BatchCodeValid},
+					{Line: 2, OrderID: 2, Code: orderModel.BatchCodeInvalid, Message: invalidMessage},
+					{Line: 3, Code: orderModel.BatchCodeInvalid, Message: "Line is not a JSON object"},
+				},
+				Valid:  1,
+				Failed: 2,
+			},
+			useCase: orderMock.NewUseCaseMock(ctrl).ReceiveOrdersBatchMock.
+				When(minimock.AnyContext, orderModel.BatchRequest{Lines: []orderModel.BatchLine{validLine}, DryRun: true}).
+				Then(orderModel.BatchResponse{
+					Results: []orderModel.BatchLineResult{{Line: 1, OrderID: 1, Code: orderModel.BatchCodeValid}},
+					Valid:   1,
+				}, nil),
+		},
+		{
+			description: "Strict import with an invalid line is not processed",
+			request:     orderModel.BatchRequest{Lines: []orderModel.BatchLine{validLine, invalidLine}, Strict: true},
+			wantResp: orderModel.BatchResponse{
+				Results: []orderModel.BatchLineResult{
+					{Line: 1, OrderID: 1, Code: orderModel.BatchCodeNotProcessed},
+					{Line: 2, OrderID: 2, Code: orderModel.BatchCodeInvalid, Message: invalidMessage},
+				},
+				Failed: 2,
+			},
+			useCase: orderMock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewCommand(tt.useCase, config.Manifest{}, &bytes.Buffer{}).Import(context.Background(), tt.request, tt.invalid)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantResp, response)
+		})
+	}
+}
+
+// TestWriteReturned is
+func TestWriteReturned(t *testing.T) {
+	t.Parallel()
+
+	returnedAt := time.Date(2024, 5, 12, 10, 0, 0, 0, time.UTC)
+	orders := []orderModel.ReturnedResponse{{OrderID: 1, ClientID: 2, ReturnedAt: returnedAt}}
+	mapping := config.Manifest{Columns: map[string]string{FieldOrderID: "order_id"}}
+
+	tests := []*struct {
+		description string
+		format      Format
+		want        string
+	}{
+		{
+			description: "CSV",
+			format:      FormatCSV,
+			want:        "order_id,clientID,returnedAt\n1,2,2024-05-12T10:00:00Z\n",
+		},
+		{
+			description: "JSONL",
+			format:      FormatJSONL,
+			want:        `{"order_id":"1","clientID":"2","returnedAt":"2024-05-12T10:00:00Z"}` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			var out bytes.Buffer
+			err := WriteReturned(&out, tt.format, mapping, orders)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+}
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders ADD COLUMN pvz_id BIGINT;
+ALTER TABLE orders ADD CONSTRAINT orders_pvz_id_fkey FOREIGN KEY (pvz_id) REFERENCES pvz(id);
+CREATE INDEX orders_pvz_id_idx ON orders(pvz_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX orders_pvz_id_idx;
+ALTER TABLE orders DROP CONSTRAINT orders_pvz_id_fkey;
+ALTER TABLE orders DROP COLUMN pvz_id;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE order_status AS ENUM ('received', 'issued', 'returned_by_client', 'expired', 'handed_to_courier');
+
+ALTER TABLE orders ADD COLUMN status order_status NOT NULL DEFAULT 'received';
+
+UPDATE orders SET status = CASE
+    WHEN returned_at IS NOT NULL THEN 'handed_to_courier'::order_status
+    WHEN accepted_at IS NOT NULL THEN 'returned_by_client'::order_status
+    WHEN issued_at IS NOT NULL THEN 'issued'::order_status
+    ELSE 'received'::order_status
+END;
+
+CREATE INDEX orders_pvz_id_status_idx ON orders(pvz_id, status);
+
+CREATE TABLE order_status_history(
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL,
+    from_status order_status,
+    to_status order_status NOT NULL,
+    created_at  TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (order_id) REFERENCES orders(order_id)
+);
+
+CREATE INDEX order_status_history_order_id_idx ON order_status_history(order_id, created_at);
+
+INSERT INTO order_status_history(order_id, from_status, to_status, created_at)
+SELECT order_id, NULL, status, created_at FROM orders;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE order_status_history;
+DROP INDEX orders_pvz_id_status_idx;
+ALTER TABLE orders DROP COLUMN status;
+DROP TYPE order_status;
+-- +goose StatementEnd
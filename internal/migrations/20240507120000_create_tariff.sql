@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE tariff(
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT UNIQUE NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT FALSE,
+    free_storage_days BIGINT NOT NULL DEFAULT 0,
+    storage_fee_per_day NUMERIC(12,2) NOT NULL DEFAULT 0,
+    discount_percent NUMERIC(5,2) NOT NULL DEFAULT 0,
+    discount_min_orders BIGINT NOT NULL DEFAULT 0,
+    deleted_at TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX tariff_is_active_idx ON tariff(is_active) WHERE is_active;
+
+CREATE TABLE tariff_weight_bracket(
+    id BIGSERIAL PRIMARY KEY,
+    tariff_id BIGINT NOT NULL,
+    min_weight NUMERIC(12,2) NOT NULL,
+    max_weight NUMERIC(12,2) NOT NULL,
+    cost NUMERIC(12,2) NOT NULL DEFAULT 0,
+    FOREIGN KEY (tariff_id) REFERENCES tariff(id)
+);
+
+CREATE INDEX tariff_weight_bracket_tariff_id_idx ON tariff_weight_bracket(tariff_id, min_weight);
+
+CREATE TABLE tariff_box_surcharge(
+    tariff_id BIGINT NOT NULL,
+    box_id BIGINT NOT NULL,
+    surcharge NUMERIC(12,2) NOT NULL DEFAULT 0,
+    PRIMARY KEY (tariff_id, box_id),
+    FOREIGN KEY (tariff_id) REFERENCES tariff(id),
+    FOREIGN KEY (box_id) REFERENCES box(id)
+);
+
+INSERT INTO tariff (name, is_active) VALUES ('default', true);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE tariff_box_surcharge;
+DROP TABLE tariff_weight_bracket;
+DROP TABLE tariff;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE order_packaging(
+    order_id BIGINT NOT NULL,
+    position INT NOT NULL,
+    box_id BIGINT NOT NULL,
+    PRIMARY KEY (order_id, position),
+    FOREIGN KEY (order_id) REFERENCES orders(order_id),
+    FOREIGN KEY (box_id) REFERENCES box(id)
+);
+
+CREATE INDEX order_packaging_box_id_idx ON order_packaging(box_id);
+
+INSERT INTO order_packaging(order_id, position, box_id)
+SELECT order_id, 0, box_id FROM orders WHERE box_id IS NOT NULL;
+
+CREATE TABLE box_wrap_rule(
+    inner_box_id BIGINT NOT NULL,
+    outer_box_id BIGINT NOT NULL,
+    PRIMARY KEY (inner_box_id, outer_box_id),
+    FOREIGN KEY (inner_box_id) REFERENCES box(id),
+    FOREIGN KEY (outer_box_id) REFERENCES box(id)
+);
+
+INSERT INTO box_wrap_rule(inner_box_id, outer_box_id)
+SELECT i.id, o.id FROM box i, box o
+WHERE (i.name, o.name) IN (('textile', 'package'), ('textile', 'box'), ('package', 'box'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE box_wrap_rule;
+DROP TABLE order_packaging;
+-- +goose StatementEnd
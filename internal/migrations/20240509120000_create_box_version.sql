@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE box_version(
+    id BIGSERIAL PRIMARY KEY,
+    box_id BIGINT NOT NULL,
+    version BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    cost NUMERIC(12,2) NOT NULL DEFAULT 0,
+    is_check BOOLEAN NOT NULL DEFAULT FALSE,
+    weight NUMERIC(12,2) NOT NULL DEFAULT 0,
+    created_at  TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (box_id, version),
+    FOREIGN KEY (box_id) REFERENCES box(id)
+);
+
+INSERT INTO box_version(box_id, version, name, cost, is_check, weight, created_at)
+SELECT id, 1, name, COALESCE(cost, 0), COALESCE(is_check, FALSE), COALESCE(weight, 0), updated_at FROM box;
+
+ALTER TABLE order_packaging ADD COLUMN box_version_id BIGINT;
+ALTER TABLE order_packaging ADD CONSTRAINT order_packaging_box_version_id_fkey FOREIGN KEY (box_version_id) REFERENCES box_version(id);
+
+UPDATE order_packaging p SET box_version_id = v.id FROM box_version v WHERE v.box_id = p.box_id;
+
+ALTER TABLE order_packaging ALTER COLUMN box_version_id SET NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE order_packaging DROP CONSTRAINT order_packaging_box_version_id_fkey;
+ALTER TABLE order_packaging DROP COLUMN box_version_id;
+DROP TABLE box_version;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE box ADD COLUMN length NUMERIC(12,2) NOT NULL DEFAULT 0;
+ALTER TABLE box ADD COLUMN width NUMERIC(12,2) NOT NULL DEFAULT 0;
+ALTER TABLE box ADD COLUMN height NUMERIC(12,2) NOT NULL DEFAULT 0;
+
+ALTER TABLE box_version ADD COLUMN length NUMERIC(12,2) NOT NULL DEFAULT 0;
+ALTER TABLE box_version ADD COLUMN width NUMERIC(12,2) NOT NULL DEFAULT 0;
+ALTER TABLE box_version ADD COLUMN height NUMERIC(12,2) NOT NULL DEFAULT 0;
+
+ALTER TABLE orders ADD COLUMN length NUMERIC(12,2) NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN width NUMERIC(12,2) NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN height NUMERIC(12,2) NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders DROP COLUMN height;
+ALTER TABLE orders DROP COLUMN width;
+ALTER TABLE orders DROP COLUMN length;
+
+ALTER TABLE box_version DROP COLUMN height;
+ALTER TABLE box_version DROP COLUMN width;
+ALTER TABLE box_version DROP COLUMN length;
+
+ALTER TABLE box DROP COLUMN height;
+ALTER TABLE box DROP COLUMN width;
+ALTER TABLE box DROP COLUMN length;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE idempotency_key(
+    key TEXT PRIMARY KEY,
+    method TEXT NOT NULL,
+    request_hash TEXT NOT NULL,
+    response BYTEA,
+    code INT,
+    message TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idempotency_key_expires_at_idx ON idempotency_key(expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE idempotency_key;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE pvz_cell(
+    id BIGSERIAL PRIMARY KEY,
+    pvz_id BIGINT NOT NULL REFERENCES pvz(id),
+    code TEXT NOT NULL,
+    max_weight NUMERIC(12,2) NOT NULL,
+    max_count BIGINT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (pvz_id, code)
+);
+
+ALTER TABLE orders ADD COLUMN cell_id BIGINT;
+ALTER TABLE orders ADD CONSTRAINT orders_cell_id_fkey FOREIGN KEY (cell_id) REFERENCES pvz_cell(id);
+CREATE INDEX orders_cell_id_idx ON orders(cell_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX orders_cell_id_idx;
+ALTER TABLE orders DROP CONSTRAINT orders_cell_id_fkey;
+ALTER TABLE orders DROP COLUMN cell_id;
+
+DROP TABLE pvz_cell;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pvz ADD COLUMN timezone TEXT NOT NULL DEFAULT 'UTC';
+ALTER TABLE pvz ADD COLUMN schedule JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE pvz ADD COLUMN holidays DATE[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pvz DROP COLUMN holidays;
+ALTER TABLE pvz DROP COLUMN schedule;
+ALTER TABLE pvz DROP COLUMN timezone;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pvz ADD COLUMN latitude DOUBLE PRECISION;
+ALTER TABLE pvz ADD COLUMN longitude DOUBLE PRECISION;
+ALTER TABLE pvz ADD CONSTRAINT pvz_location_check CHECK (
+    (latitude IS NULL) = (longitude IS NULL)
+    AND latitude BETWEEN -90 AND 90
+    AND longitude BETWEEN -180 AND 180
+);
+CREATE INDEX pvz_location_idx ON pvz(latitude, longitude) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX pvz_location_idx;
+ALTER TABLE pvz DROP CONSTRAINT pvz_location_check;
+ALTER TABLE pvz DROP COLUMN longitude;
+ALTER TABLE pvz DROP COLUMN latitude;
+-- +goose StatementEnd
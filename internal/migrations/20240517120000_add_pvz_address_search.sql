@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE pvz ADD COLUMN country TEXT NOT NULL DEFAULT '';
+ALTER TABLE pvz ADD COLUMN city TEXT NOT NULL DEFAULT '';
+ALTER TABLE pvz ADD COLUMN street TEXT NOT NULL DEFAULT '';
+ALTER TABLE pvz ADD COLUMN building TEXT NOT NULL DEFAULT '';
+ALTER TABLE pvz ADD COLUMN postal_code TEXT NOT NULL DEFAULT '';
+ALTER TABLE pvz ADD COLUMN search_text TEXT GENERATED ALWAYS AS (
+    lower(
+        coalesce(name, '') || ' ' || coalesce(address, '') || ' ' || country || ' ' ||
+        city || ' ' || street || ' ' || building || ' ' || postal_code
+    )
+) STORED;
+
+CREATE INDEX pvz_city_idx ON pvz(lower(city)) WHERE deleted_at IS NULL;
+CREATE INDEX pvz_search_fts_idx ON pvz USING GIN (to_tsvector('simple', search_text));
+CREATE INDEX pvz_search_trgm_idx ON pvz USING GIN (search_text gin_trgm_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX pvz_search_trgm_idx;
+DROP INDEX pvz_search_fts_idx;
+DROP INDEX pvz_city_idx;
+
+ALTER TABLE pvz DROP COLUMN search_text;
+ALTER TABLE pvz DROP COLUMN postal_code;
+ALTER TABLE pvz DROP COLUMN building;
+ALTER TABLE pvz DROP COLUMN street;
+ALTER TABLE pvz DROP COLUMN city;
+ALTER TABLE pvz DROP COLUMN country;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE box DROP CONSTRAINT box_name_key;
+CREATE UNIQUE INDEX box_name_key ON box(name) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX box_name_key;
+ALTER TABLE box ADD CONSTRAINT box_name_key UNIQUE (name);
+-- +goose StatementEnd
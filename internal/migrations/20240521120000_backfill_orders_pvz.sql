@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the orders received before they were tied to a PVZ are moved to a PVZ made for them,
+-- so they are still listed, issued and accepted there and are counted when a PVZ is deleted
+WITH migration_pvz AS (
+    INSERT INTO pvz(name, address, contact)
+    SELECT 'Orders without a PVZ', '', ''
+    WHERE EXISTS (SELECT 1 FROM orders WHERE pvz_id IS NULL)
+    RETURNING id
+)
+UPDATE orders SET pvz_id = (SELECT id FROM migration_pvz) WHERE pvz_id IS NULL;
+
+ALTER TABLE orders ALTER COLUMN pvz_id SET NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders ALTER COLUMN pvz_id DROP NOT NULL;
+-- +goose StatementEnd
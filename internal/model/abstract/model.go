@@ -8,6 +8,7 @@ import (
 
 	redisModel "Homework-1/internal/model/redis"
 	"Homework-1/pkg/api/abstract"
+	"Homework-1/pkg/money"
 )
 
 // PageData is
@@ -49,8 +50,8 @@ func (p *Page) ToStorage() PageData {
 // PageFromGRPC is
 func PageFromGRPC(pageGRPC *abstract.Page) Page {
 	return Page{
-		CurrentPage:  pageGRPC.CurrentPage,
-		ItemsPerPage: pageGRPC.ItemsPerPage,
+		CurrentPage:  pageGRPC.GetCurrentPage(),
+		ItemsPerPage: pageGRPC.GetItemsPerPage(),
 	}
 }
 
@@ -86,3 +87,79 @@ func SafeTimestamp(t *time.Time) *timestamppb.Timestamp {
 	}
 	return timestamppb.New(*t)
 }
+
+// SafeTime is
+func SafeTime(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	value := t.AsTime()
+	return &value
+}
+
+// MoneyToGRPC is
+func MoneyToGRPC(amount money.Amount) *abstract.Money {
+	return &abstract.Money{
+		Units:    int64(amount),
+		Currency: money.Currency,
+	}
+}
+
+// MoneyFromGRPC is, a request without the Money message falls back to the deprecated float field
+func MoneyFromGRPC(amount *abstract.Money, legacy float64) (money.Amount, string) {
+	if amount == nil {
+		return money.FromFloat(legacy), money.Currency
+	}
+
+	if amount.GetCurrency() == "" {
+		return money.Amount(amount.GetUnits()), money.Currency
+	}
+
+	return money.Amount(amount.GetUnits()), amount.GetCurrency()
+}
+
+// RequestCurrency is the currency of a request with several sums, the first foreign one is kept so that validation rejects it
+func RequestCurrency(currencies ...string) string {
+	for _, currency := range currencies {
+		if currency != money.Currency {
+			return currency
+		}
+	}
+
+	return money.Currency
+}
+
+// Dimensions is, sizes are in centimeters and zero means the size is unknown
+type Dimensions struct {
+	Length float64 `json:"length" db:"length" validate:"gte=0"`
+	Width  float64 `json:"width" db:"width" validate:"gte=0"`
+	Height float64 `json:"height" db:"height" validate:"gte=0"`
+}
+
+// Volume is
+func (d Dimensions) Volume() float64 {
+	return d.Length * d.Width * d.Height
+}
+
+// IsZero is
+func (d Dimensions) IsZero() bool {
+	return d.Length == 0 && d.Width == 0 && d.Height == 0
+}
+
+// DimensionsToGRPC is
+func DimensionsToGRPC(dimensions Dimensions) *abstract.Dimensions {
+	return &abstract.Dimensions{
+		Length: dimensions.Length,
+		Width:  dimensions.Width,
+		Height: dimensions.Height,
+	}
+}
+
+// DimensionsFromGRPC is
+func DimensionsFromGRPC(dimensions *abstract.Dimensions) Dimensions {
+	return Dimensions{
+		Length: dimensions.GetLength(),
+		Width:  dimensions.GetWidth(),
+		Height: dimensions.GetHeight(),
+	}
+}
@@ -5,99 +5,225 @@ import (
 
 	abstractModel "Homework-1/internal/model/abstract"
 	"Homework-1/pkg/api/box_v1"
+	"Homework-1/pkg/money"
 )
 
 // Request is
 type Request struct {
-	Name    string  `json:"name" validate:"required"`
-	Cost    float64 `json:"cost" validate:"required"`
-	IsCheck bool    `json:"isCheck"`
-	Weight  float64 `json:"weight" validate:"required"`
+	Name     string       `json:"name" validate:"required"`
+	Cost     money.Amount `json:"cost" validate:"required"`
+	Currency string       `json:"currency" validate:"eq=RUB"`
+	IsCheck  bool         `json:"isCheck"`
+	Weight   float64      `json:"weight" validate:"required"`
+	abstractModel.Dimensions
 }
 
 // Data is
 type Data struct {
-	Name    string  `db:"name"`
-	Cost    float64 `db:"cost"`
-	IsCheck bool    `db:"is_check"`
-	Weight  float64 `db:"weight"`
+	Name    string       `db:"name"`
+	Cost    money.Amount `db:"cost"`
+	IsCheck bool         `db:"is_check"`
+	Weight  float64      `db:"weight"`
+	abstractModel.Dimensions
 }
 
-// DataWithOrder is
-type DataWithOrder struct {
-	Cost        float64 `db:"cost"`
-	IsCheck     bool    `db:"is_check"`
-	Weight      float64 `db:"weight"`
-	OrderWeight float64 `db:"order_weight"`
+// UpdateRequest is, nil fields are left as they are
+type UpdateRequest struct {
+	ID         int64                     `json:"id" validate:"required,gt=0"`
+	Name       *string                   `json:"name,omitempty" validate:"omitempty,min=1"`
+	Cost       *money.Amount             `json:"cost,omitempty" validate:"omitempty,gte=0"`
+	Currency   string                    `json:"currency,omitempty" validate:"omitempty,eq=RUB"`
+	IsCheck    *bool                     `json:"isCheck,omitempty"`
+	Weight     *float64                  `json:"weight,omitempty" validate:"omitempty,gte=0"`
+	Dimensions *abstractModel.Dimensions `json:"dimensions,omitempty"`
+}
+
+// UpdateData is
+type UpdateData struct {
+	ID         int64                     `db:"id"`
+	Name       *string                   `db:"name"`
+	Cost       *money.Amount             `db:"cost"`
+	IsCheck    *bool                     `db:"is_check"`
+	Weight     *float64                  `db:"weight"`
+	Dimensions *abstractModel.Dimensions `db:"dimensions"`
+}
+
+// ToStorage is
+func (b *UpdateRequest) ToStorage() UpdateData {
+	return UpdateData{
+		ID:         b.ID,
+		Name:       b.Name,
+		Cost:       b.Cost,
+		IsCheck:    b.IsCheck,
+		Weight:     b.Weight,
+		Dimensions: b.Dimensions,
+	}
 }
 
 // ToStorage is
 func (b *Request) ToStorage() Data {
 	return Data{
-		Name:    b.Name,
-		Cost:    b.Cost,
-		IsCheck: b.IsCheck,
-		Weight:  b.Weight,
+		Name:       b.Name,
+		Cost:       b.Cost,
+		IsCheck:    b.IsCheck,
+		Weight:     b.Weight,
+		Dimensions: b.Dimensions,
 	}
 }
 
 // AllData is
 type AllData struct {
-	ID        int64     `db:"id"`
-	Name      string    `db:"name"`
-	Cost      float64   `db:"cost"`
-	IsCheck   bool      `db:"is_check"`
-	Weight    float64   `db:"weight"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID      int64        `db:"id"`
+	Name    string       `db:"name"`
+	Cost    money.Amount `db:"cost"`
+	IsCheck bool         `db:"is_check"`
+	Weight  float64      `db:"weight"`
+	abstractModel.Dimensions
+	ArchivedAt *time.Time `db:"archived_at"`
+	DeletedAt  *time.Time `db:"deleted_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
 }
 
 // AllResponse is
 type AllResponse struct {
-	ID        int64     `json:"id"`
-	Name      string    `json:"name"`
-	Cost      float64   `json:"cost"`
-	IsCheck   bool      `json:"isCheck"`
-	Weight    float64   `json:"weight"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID      int64        `json:"id"`
+	Name    string       `json:"name"`
+	Cost    money.Amount `json:"cost"`
+	IsCheck bool         `json:"isCheck"`
+	Weight  float64      `json:"weight"`
+	abstractModel.Dimensions
+	ArchivedAt *time.Time `json:"archivedAt,omitempty"`
+	DeletedAt  *time.Time `json:"deletedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
 }
 
 // ToServer is
 func (b *AllData) ToServer() AllResponse {
 	return AllResponse{
-		ID:        b.ID,
-		Name:      b.Name,
-		Cost:      b.Cost,
-		IsCheck:   b.IsCheck,
-		Weight:    b.Weight,
-		CreatedAt: b.CreatedAt,
-		UpdatedAt: b.UpdatedAt,
+		ID:         b.ID,
+		Name:       b.Name,
+		Cost:       b.Cost,
+		IsCheck:    b.IsCheck,
+		Weight:     b.Weight,
+		Dimensions: b.Dimensions,
+		ArchivedAt: b.ArchivedAt,
+		DeletedAt:  b.DeletedAt,
+		CreatedAt:  b.CreatedAt,
+		UpdatedAt:  b.UpdatedAt,
+	}
+}
+
+// WrapRuleData is, the outer box can wrap the inner one
+type WrapRuleData struct {
+	InnerBoxID int64 `db:"inner_box_id"`
+	OuterBoxID int64 `db:"outer_box_id"`
+}
+
+// VersionsRequest is
+type VersionsRequest struct {
+	BoxID int64 `json:"boxID" validate:"required,gt=0"`
+	abstractModel.Page
+}
+
+// VersionsRequestData is
+type VersionsRequestData struct {
+	BoxID int64 `db:"box_id"`
+	abstractModel.PageData
+}
+
+// ToStorage is
+func (v *VersionsRequest) ToStorage() VersionsRequestData {
+	return VersionsRequestData{
+		BoxID:    v.BoxID,
+		PageData: v.Page.ToStorage(),
 	}
 }
 
+// VersionData is
+type VersionData struct {
+	BoxID   int64        `db:"box_id"`
+	Version int64        `db:"version"`
+	Name    string       `db:"name"`
+	Cost    money.Amount `db:"cost"`
+	IsCheck bool         `db:"is_check"`
+	Weight  float64      `db:"weight"`
+	abstractModel.Dimensions
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// VersionResponse is
+type VersionResponse struct {
+	BoxID   int64        `json:"boxID"`
+	Version int64        `json:"version"`
+	Name    string       `json:"name"`
+	Cost    money.Amount `json:"cost"`
+	IsCheck bool         `json:"isCheck"`
+	Weight  float64      `json:"weight"`
+	abstractModel.Dimensions
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ToServer is
+func (v *VersionData) ToServer() VersionResponse {
+	return VersionResponse(*v)
+}
+
 // FromGRPC is
 func FromGRPC(boxGRPC *box_v1.Box) Request {
+	//nolint:staticcheck // the float cost is still accepted from old clients
+	cost, currency := abstractModel.MoneyFromGRPC(boxGRPC.GetCostAmount(), boxGRPC.GetCost())
+
 	return Request{
-		Name:    boxGRPC.Name,
-		Cost:    boxGRPC.Cost,
-		IsCheck: boxGRPC.IsCheck,
-		Weight:  boxGRPC.Weight,
+		Name:       boxGRPC.Name,
+		Cost:       cost,
+		Currency:   currency,
+		IsCheck:    boxGRPC.IsCheck,
+		Weight:     boxGRPC.Weight,
+		Dimensions: abstractModel.DimensionsFromGRPC(boxGRPC.GetDimensions()),
 	}
 }
 
+// FromUpdateGRPC is
+func FromUpdateGRPC(request *box_v1.BoxUpdateRequest) UpdateRequest {
+	updateRequest := UpdateRequest{
+		ID:      request.GetBoxID(),
+		Name:    request.Name,
+		IsCheck: request.IsCheck,
+		Weight:  request.Weight,
+	}
+
+	if request.Dimensions != nil {
+		dimensions := abstractModel.DimensionsFromGRPC(request.Dimensions)
+		updateRequest.Dimensions = &dimensions
+	}
+
+	if request.CostAmount != nil {
+		cost, currency := abstractModel.MoneyFromGRPC(request.CostAmount, 0)
+		updateRequest.Cost = &cost
+		updateRequest.Currency = currency
+	}
+
+	return updateRequest
+}
+
 // InfoToGRPC is
 func InfoToGRPC(allResponse AllResponse) *box_v1.BoxAllInfo {
 	return &box_v1.BoxAllInfo{
 		ID: allResponse.ID,
 		Box: &box_v1.Box{
-			Name:    allResponse.Name,
-			Cost:    allResponse.Cost,
-			IsCheck: allResponse.IsCheck,
-			Weight:  allResponse.Weight,
+			Name:       allResponse.Name,
+			Cost:       allResponse.Cost.Float(),
+			IsCheck:    allResponse.IsCheck,
+			Weight:     allResponse.Weight,
+			CostAmount: abstractModel.MoneyToGRPC(allResponse.Cost),
+			Dimensions: abstractModel.DimensionsToGRPC(allResponse.Dimensions),
 		},
-		CreatedAt: abstractModel.SafeTimestamp(&allResponse.CreatedAt),
-		UpdatedAt: abstractModel.SafeTimestamp(&allResponse.UpdatedAt),
+		CreatedAt:  abstractModel.SafeTimestamp(&allResponse.CreatedAt),
+		UpdatedAt:  abstractModel.SafeTimestamp(&allResponse.UpdatedAt),
+		ArchivedAt: abstractModel.SafeTimestamp(allResponse.ArchivedAt),
+		DeletedAt:  abstractModel.SafeTimestamp(allResponse.DeletedAt),
 	}
 }
 
@@ -123,3 +249,41 @@ func ListToGRPC(response abstractModel.PaginatedResponse[AllResponse]) *box_v1.B
 			response.TotalItems,
 		)}
 }
+
+// FromVersionsGRPC is
+func FromVersionsGRPC(request *box_v1.BoxVersionsRequest) VersionsRequest {
+	return VersionsRequest{
+		BoxID: request.GetBoxID(),
+		Page:  abstractModel.PageFromGRPC(request.GetPage()),
+	}
+}
+
+// VersionListToGRPC is
+func VersionListToGRPC(response abstractModel.PaginatedResponse[VersionResponse]) *box_v1.BoxVersionListResponse {
+	versions := make([]*box_v1.BoxVersion, len(response.Items))
+	for index, value := range response.Items {
+		versions[index] = &box_v1.BoxVersion{
+			BoxID:   value.BoxID,
+			Version: value.Version,
+			Box: &box_v1.Box{
+				Name:       value.Name,
+				Cost:       value.Cost.Float(),
+				IsCheck:    value.IsCheck,
+				Weight:     value.Weight,
+				CostAmount: abstractModel.MoneyToGRPC(value.Cost),
+				Dimensions: abstractModel.DimensionsToGRPC(value.Dimensions),
+			},
+			CreatedAt: abstractModel.SafeTimestamp(&value.CreatedAt),
+		}
+	}
+
+	return &box_v1.BoxVersionListResponse{
+		Versions: versions,
+		Pagination: abstractModel.PaginationToGRPC(
+			abstractModel.Page{
+				CurrentPage:  response.CurrentPage,
+				ItemsPerPage: response.ItemsPerPage,
+			},
+			response.TotalItems,
+		)}
+}
@@ -0,0 +1,178 @@
+package idempotency
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Request is a call made with an idempotency key, the key is scoped to the user that sent it
+// and RequestHash tells apart the bodies sent with the same key
+type Request struct {
+	Username    string
+	Key         string
+	Method      string
+	RequestHash string
+	TTL         time.Duration
+	Lease       time.Duration
+}
+
+// NewRequest is
+func NewRequest(username string, key string, method string, request proto.Message, ttl, lease time.Duration) (Request, error) {
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return Request{}, fmt.Errorf("proto.Marshal: %w", err)
+	}
+
+	hash := sha256.Sum256(body)
+
+	return Request{
+		Username:    username,
+		Key:         key,
+		Method:      method,
+		RequestHash: hex.EncodeToString(hash[:]),
+		TTL:         ttl,
+		Lease:       lease,
+	}, nil
+}
+
+// RequestData is
+type RequestData struct {
+	Username    string    `db:"username"`
+	Key         string    `db:"key"`
+	Method      string    `db:"method"`
+	RequestHash string    `db:"request_hash"`
+	ExpiresAt   time.Time `db:"expires_at"`
+	LockedUntil time.Time `db:"locked_until"`
+}
+
+// ToStorage is
+func (r *Request) ToStorage() RequestData {
+	now := time.Now()
+
+	return RequestData{
+		Username:    r.Username,
+		Key:         r.Key,
+		Method:      r.Method,
+		RequestHash: r.RequestHash,
+		ExpiresAt:   now.Add(r.TTL),
+		LockedUntil: now.Add(r.Lease),
+	}
+}
+
+// Data is, a key without a code is still being handled
+type Data struct {
+	Username    string    `db:"username"`
+	Key         string    `db:"key"`
+	Method      string    `db:"method"`
+	RequestHash string    `db:"request_hash"`
+	Response    []byte    `db:"response"`
+	Code        *int32    `db:"code"`
+	Message     string    `db:"message"`
+	CreatedAt   time.Time `db:"created_at"`
+	ExpiresAt   time.Time `db:"expires_at"`
+}
+
+// ToServer is
+func (d *Data) ToServer() Response {
+	response := Response{
+		Key:         d.Key,
+		Method:      d.Method,
+		RequestHash: d.RequestHash,
+		Body:        d.Response,
+		Message:     d.Message,
+		Completed:   d.Code != nil,
+	}
+	if d.Code != nil {
+		response.Code = codes.Code(*d.Code)
+	}
+
+	return response
+}
+
+// Response is the stored outcome of the first request with a key, Body is the response wrapped in anypb.Any
+type Response struct {
+	Key         string
+	Method      string
+	RequestHash string
+	Body        []byte
+	Code        codes.Code
+	Message     string
+	Completed   bool
+}
+
+// Matches tells whether the response was stored for the same call
+func (r *Response) Matches(request Request) bool {
+	return r.Method == request.Method && r.RequestHash == request.RequestHash
+}
+
+// Replay is the stored response or the stored error of the first request
+func (r *Response) Replay() (any, error) {
+	if r.Code != codes.OK {
+		return nil, status.Error(r.Code, r.Message)
+	}
+
+	var body anypb.Any
+	if err := proto.Unmarshal(r.Body, &body); err != nil {
+		return nil, fmt.Errorf("proto.Unmarshal: %w", err)
+	}
+
+	message, err := body.UnmarshalNew()
+	if err != nil {
+		return nil, fmt.Errorf("body.UnmarshalNew: %w", err)
+	}
+
+	return message, nil
+}
+
+// ResponseData is
+type ResponseData struct {
+	Username string `db:"username"`
+	Key      string `db:"key"`
+	Response []byte `db:"response"`
+	Code     int32  `db:"code"`
+	Message  string `db:"message"`
+}
+
+// NewResponseData is, only a proto response can be stored
+func NewResponseData(request Request, response any, err error) (ResponseData, error) {
+	if err != nil {
+		st := status.Convert(err)
+		return ResponseData{Username: request.Username, Key: request.Key, Code: int32(st.Code()), Message: st.Message()}, nil
+	}
+
+	message, ok := response.(proto.Message)
+	if !ok {
+		return ResponseData{}, fmt.Errorf("response %T is not a proto message", response)
+	}
+
+	body, err := anypb.New(message)
+	if err != nil {
+		return ResponseData{}, fmt.Errorf("anypb.New: %w", err)
+	}
+
+	marshaled, err := proto.Marshal(body)
+	if err != nil {
+		return ResponseData{}, fmt.Errorf("proto.Marshal: %w", err)
+	}
+
+	return ResponseData{Username: request.Username, Key: request.Key, Response: marshaled, Code: int32(codes.OK)}, nil
+}
+
+// Replayable tells whether an error is the final answer to a request, a retry of a request
+// that failed for another reason is handled again
+func Replayable(err error) bool {
+	switch status.Code(err) {
+	case codes.OK, codes.InvalidArgument, codes.NotFound, codes.AlreadyExists,
+		codes.PermissionDenied, codes.FailedPrecondition, codes.OutOfRange:
+		return true
+	default:
+		return false
+	}
+}
@@ -8,3 +8,13 @@ type Message struct {
 	Request   string    `json:"request"`
 	Timestamp time.Time `json:"timestamp"`
 }
+
+// OrderEvent is a domain event published when an order changes its status outside of a request
+type OrderEvent struct {
+	Type       string    `json:"type"`
+	OrderID    int64     `json:"orderID"`
+	PVZID      int64     `json:"pvzID"`
+	FromStatus string    `json:"fromStatus"`
+	ToStatus   string    `json:"toStatus"`
+	Timestamp  time.Time `json:"timestamp"`
+}
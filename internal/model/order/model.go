@@ -1,12 +1,17 @@
 package order
 
 import (
+	"sort"
 	"time"
 
+	"github.com/lib/pq"
+	"github.com/samber/lo"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	abstractModel "Homework-1/internal/model/abstract"
+	"Homework-1/internal/model/box"
 	"Homework-1/pkg/api/order_v1"
+	"Homework-1/pkg/money"
 )
 
 // Request is - receive order -expire in days, -orderid, -userid
@@ -16,15 +21,23 @@ type Request struct {
 	ClientID           int64   `json:"clientID" validate:"required"`
 	Weight             float64 `json:"weight" validate:"required"`
 	BoxID              int64   `json:"boxID" validate:"required"`
+	PVZID              int64   `json:"pvzID" validate:"required"`
+	Packaging          []int64 `json:"packaging" validate:"required,min=1,dive,gt=0"`
+	abstractModel.Dimensions
 }
 
 // RequestData is
 type RequestData struct {
-	ExpireTimeDuration int     `db:"expire_time_duration"`
-	OrderID            int64   `db:"order_ID" `
-	ClientID           int64   `db:"client_ID"`
-	Weight             float64 `db:"weight"`
-	BoxID              int64   `db:"box_id"`
+	ExpireTimeDuration int       `db:"expire_time_duration"`
+	OrderID            int64     `db:"order_ID" `
+	ClientID           int64     `db:"client_ID"`
+	Weight             float64   `db:"weight"`
+	BoxID              int64     `db:"box_id"`
+	PVZID              int64     `db:"pvz_id"`
+	Packaging          []int64   `db:"packaging"`
+	CellID             *int64    `db:"cell_id"`
+	ExpiresAt          time.Time `db:"expires_at"`
+	abstractModel.Dimensions
 }
 
 // ToStorage is
@@ -35,12 +48,55 @@ func (o *Request) ToStorage() RequestData {
 		ClientID:           o.ClientID,
 		Weight:             o.Weight,
 		BoxID:              o.BoxID,
+		PVZID:              o.PVZID,
+		Packaging:          append([]int64(nil), o.Packaging...),
+		Dimensions:         o.Dimensions,
 	}
 }
 
+// BatchLine is a manifest line, Line is its position in the manifest starting from 1
+type BatchLine struct {
+	Line    int64
+	Request Request
+}
+
+// BatchRequest is, a strict batch receives nothing when any line fails
+// and a dry run only checks the lines without receiving them
+type BatchRequest struct {
+	Lines  []BatchLine
+	Strict bool
+	DryRun bool
+}
+
+// Batch line codes that are not caused by an error
+const (
+	BatchCodeReceived      = "received"
+	BatchCodeValid         = "valid"
+	BatchCodeInvalid       = "invalid"
+	BatchCodeDuplicateLine = "duplicate_line"
+	BatchCodeNotProcessed  = "not_processed"
+)
+
+// BatchLineResult is
+type BatchLineResult struct {
+	Line    int64  `json:"line"`
+	OrderID int64  `json:"orderID"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// BatchResponse is, Valid counts the lines of a dry run that can be received
+type BatchResponse struct {
+	Results  []BatchLineResult `json:"results"`
+	Received int64             `json:"received"`
+	Valid    int64             `json:"valid"`
+	Failed   int64             `json:"failed"`
+}
+
 // RequestOrderIDs is
 type RequestOrderIDs struct {
 	OrderIDs []int64 `json:"orderIDs" validate:"required,min=1"`
+	PVZID    int64   `json:"pvzID" validate:"required"`
 }
 
 // WithBoxID is
@@ -52,13 +108,150 @@ type WithBoxID struct {
 // RequestOrderIDsData is
 type RequestOrderIDsData struct {
 	OrderIDs []int64 `db:"orderIDs"`
+	PVZID    int64   `db:"pvz_id"`
 }
 
 // ToStorage is
 func (o *RequestOrderIDs) ToStorage() RequestOrderIDsData {
 	orderIDs := make([]int64, len(o.OrderIDs))
 	_ = copy(orderIDs, o.OrderIDs)
-	return RequestOrderIDsData{OrderIDs: orderIDs}
+	return RequestOrderIDsData{OrderIDs: orderIDs, PVZID: o.PVZID}
+}
+
+// PackagingLine is
+type PackagingLine struct {
+	BoxID   int64        `json:"boxID"`
+	BoxName string       `json:"boxName"`
+	Cost    money.Amount `json:"cost"`
+}
+
+// IssueLine is, BoxID and BoxName are the outer packaging layer
+type IssueLine struct {
+	OrderID          int64           `json:"orderID"`
+	BoxID            int64           `json:"boxID"`
+	BoxName          string          `json:"boxName"`
+	PackagingCost    money.Amount    `json:"packagingCost"`
+	Weight           float64         `json:"weight"`
+	WeightCost       money.Amount    `json:"weightCost"`
+	StorageFee       money.Amount    `json:"storageFee"`
+	Cost             money.Amount    `json:"cost"`
+	Packaging        []PackagingLine `json:"packaging"`
+	ChargeableWeight float64         `json:"chargeableWeight"`
+}
+
+// IssueResponse is
+type IssueResponse struct {
+	ClientID  int64        `json:"clientID"`
+	Lines     []IssueLine  `json:"lines"`
+	TotalCost money.Amount `json:"totalCost"`
+	Discount  money.Amount `json:"discount"`
+	IssuedAt  time.Time    `json:"issuedAt"`
+}
+
+// IssueProblem is
+type IssueProblem struct {
+	OrderID int64  `json:"orderID"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// QuoteResponse is
+type QuoteResponse struct {
+	ClientID  int64          `json:"clientID"`
+	Lines     []IssueLine    `json:"lines"`
+	TotalCost money.Amount   `json:"totalCost"`
+	Discount  money.Amount   `json:"discount"`
+	Problems  []IssueProblem `json:"problems"`
+}
+
+// IDRequest is
+type IDRequest struct {
+	OrderID int64 `json:"orderID" validate:"required"`
+	PVZID   int64 `json:"pvzID" validate:"required"`
+}
+
+// IDRequestData is
+type IDRequestData struct {
+	OrderID int64 `db:"order_id"`
+	PVZID   int64 `db:"pvz_id"`
+}
+
+// ToStorage is
+func (o *IDRequest) ToStorage() IDRequestData {
+	return IDRequestData{
+		OrderID: o.OrderID,
+		PVZID:   o.PVZID,
+	}
+}
+
+// Filter is
+type Filter struct {
+	ClientID      int64      `json:"clientID" validate:"gte=0"`
+	BoxID         int64      `json:"boxID" validate:"gte=0"`
+	Status        Status     `json:"status" validate:"omitempty,oneof=received issued returned_by_client expired handed_to_courier"`
+	CreatedFrom   *time.Time `json:"createdFrom"`
+	CreatedTo     *time.Time `json:"createdTo"`
+	ExpiresFrom   *time.Time `json:"expiresFrom"`
+	ExpiresTo     *time.Time `json:"expiresTo"`
+	MinWeight     float64    `json:"minWeight" validate:"gte=0"`
+	MaxWeight     float64    `json:"maxWeight" validate:"omitempty,gtefield=MinWeight"`
+	SortBy        string     `json:"sortBy" validate:"omitempty,oneof=created_at expires_at weight order_id client_id"`
+	SortDirection string     `json:"sortDirection" validate:"omitempty,oneof=asc desc"`
+}
+
+// FilterData is
+type FilterData struct {
+	ClientID      int64      `db:"client_id"`
+	BoxID         int64      `db:"box_id"`
+	Status        Status     `db:"status"`
+	CreatedFrom   *time.Time `db:"created_from"`
+	CreatedTo     *time.Time `db:"created_to"`
+	ExpiresFrom   *time.Time `db:"expires_from"`
+	ExpiresTo     *time.Time `db:"expires_to"`
+	MinWeight     float64    `db:"min_weight"`
+	MaxWeight     float64    `db:"max_weight"`
+	SortBy        string     `db:"sort_by"`
+	SortDirection string     `db:"sort_direction"`
+}
+
+// ToStorage is
+func (f *Filter) ToStorage() FilterData {
+	return FilterData{
+		ClientID:      f.ClientID,
+		BoxID:         f.BoxID,
+		Status:        f.Status,
+		CreatedFrom:   f.CreatedFrom,
+		CreatedTo:     f.CreatedTo,
+		ExpiresFrom:   f.ExpiresFrom,
+		ExpiresTo:     f.ExpiresTo,
+		MinWeight:     f.MinWeight,
+		MaxWeight:     f.MaxWeight,
+		SortBy:        f.SortBy,
+		SortDirection: f.SortDirection,
+	}
+}
+
+// ListRequest is
+type ListRequest struct {
+	PVZID int64 `json:"pvzID" validate:"required"`
+	abstractModel.Page
+	Filter Filter `json:"filter"`
+}
+
+// ListRequestData is
+type ListRequestData struct {
+	PVZID int64 `db:"pvz_id"`
+	abstractModel.PageData
+	Filter FilterData
+}
+
+// ToStorage is
+func (l *ListRequest) ToStorage() ListRequestData {
+	return ListRequestData{
+		PVZID:    l.PVZID,
+		PageData: l.Page.ToStorage(),
+		Filter:   l.Filter.ToStorage(),
+	}
 }
 
 // ReturnedRequestOrder is
@@ -108,12 +301,14 @@ func (r *ReturnedData) ToServer() ReturnedResponse {
 type RequestWithClientID struct {
 	OrderID  int64 `json:"orderID" validate:"required"`
 	ClientID int64 `json:"clientID" validate:"required"`
+	PVZID    int64 `json:"pvzID" validate:"required"`
 }
 
 // RequestWithClientIDData is
 type RequestWithClientIDData struct {
 	OrderID  int64 `db:"order_id"`
 	ClientID int64 `db:"client_id"`
+	PVZID    int64 `db:"pvz_id"`
 }
 
 // ToStorage is
@@ -121,6 +316,7 @@ func (o *RequestWithClientID) ToStorage() RequestWithClientIDData {
 	return RequestWithClientIDData{
 		OrderID:  o.OrderID,
 		ClientID: o.ClientID,
+		PVZID:    o.PVZID,
 	}
 }
 
@@ -133,16 +329,22 @@ type AllResponse struct {
 	ExpiresAt  *time.Time `json:"expiresAt"`
 	Weight     float64    `json:"weight"`
 	BoxID      int64      `json:"boxID"`
-	CreatedAt  time.Time  `json:"createdAt"`
-	UpdatedAt  time.Time  `json:"updatedAt"`
+	PVZID      int64      `json:"pvzID"`
+	Packaging  []int64    `json:"packaging"`
+	abstractModel.Dimensions
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
 // AllResponseData is
 type AllResponseData struct {
-	OrderID    int64      `db:"order_id"`
-	Weight     float64    `db:"weight"`
-	ClientID   int64      `db:"client_id"`
-	BoxID      int64      `db:"box_id"`
+	OrderID   int64         `db:"order_id"`
+	Weight    float64       `db:"weight"`
+	ClientID  int64         `db:"client_id"`
+	BoxID     int64         `db:"box_id"`
+	PVZID     int64         `db:"pvz_id"`
+	Packaging pq.Int64Array `db:"packaging"`
+	abstractModel.Dimensions
 	AcceptedAt *time.Time `db:"accepted_at"`
 	IssuedAt   *time.Time `db:"issued_at"`
 	ExpiresAt  *time.Time `db:"expires_at"`
@@ -162,9 +364,92 @@ func (o *AllResponseData) ToServer() AllResponse {
 		UpdatedAt:  o.UpdatedAt,
 		Weight:     o.Weight,
 		BoxID:      o.BoxID,
+		PVZID:      o.PVZID,
+		Packaging:  o.Packaging,
+		Dimensions: o.Dimensions,
+	}
+}
+
+// DetailsResponse is
+type DetailsResponse struct {
+	AllResponse
+	Status     Status          `json:"status"`
+	ReturnedAt *time.Time      `json:"returnedAt"`
+	Box        box.AllResponse `json:"box"`
+	CellCode   string          `json:"cellCode,omitempty"`
+}
+
+// DetailsData is
+type DetailsData struct {
+	AllResponseData
+	Status       Status       `db:"status"`
+	ReturnedAt   *time.Time   `db:"returned_at"`
+	BoxName      string       `db:"box_name"`
+	BoxCost      money.Amount `db:"box_cost"`
+	BoxIsCheck   bool         `db:"box_is_check"`
+	BoxWeight    float64      `db:"box_weight"`
+	BoxLength    float64      `db:"box_length"`
+	BoxWidth     float64      `db:"box_width"`
+	BoxHeight    float64      `db:"box_height"`
+	BoxCreatedAt time.Time    `db:"box_created_at"`
+	BoxUpdatedAt time.Time    `db:"box_updated_at"`
+	CellCode     *string      `db:"cell_code"`
+}
+
+// ToServer is
+func (d *DetailsData) ToServer() DetailsResponse {
+	return DetailsResponse{
+		AllResponse: d.AllResponseData.ToServer(),
+		Status:      d.Status,
+		ReturnedAt:  d.ReturnedAt,
+		Box: box.AllResponse{
+			ID:      d.BoxID,
+			Name:    d.BoxName,
+			Cost:    d.BoxCost,
+			IsCheck: d.BoxIsCheck,
+			Weight:  d.BoxWeight,
+			Dimensions: abstractModel.Dimensions{
+				Length: d.BoxLength,
+				Width:  d.BoxWidth,
+				Height: d.BoxHeight,
+			},
+			CreatedAt: d.BoxCreatedAt,
+			UpdatedAt: d.BoxUpdatedAt,
+		},
+		CellCode: lo.FromPtr(d.CellCode),
+	}
+}
+
+// StatusChange is
+type StatusChange struct {
+	FromStatus *Status   `json:"fromStatus"`
+	ToStatus   Status    `json:"toStatus"`
+	ChangedAt  time.Time `json:"changedAt"`
+}
+
+// StatusChangeData is
+type StatusChangeData struct {
+	FromStatus *Status   `db:"from_status"`
+	ToStatus   Status    `db:"to_status"`
+	ChangedAt  time.Time `db:"created_at"`
+}
+
+// ToServer is
+func (s *StatusChangeData) ToServer() StatusChange {
+	return StatusChange{
+		FromStatus: s.FromStatus,
+		ToStatus:   s.ToStatus,
+		ChangedAt:  s.ChangedAt,
 	}
 }
 
+// HistoryResponse is
+type HistoryResponse struct {
+	OrderID       int64          `json:"orderID"`
+	PVZID         int64          `json:"pvzID"`
+	StatusChanges []StatusChange `json:"statusChanges"`
+}
+
 // ListUniqueClients is
 type ListUniqueClients struct {
 	ClientID int64 `json:"clientID"`
@@ -180,14 +465,66 @@ func (l *ListUniqueClientsData) ToServer() ListUniqueClients {
 	return ListUniqueClients{ClientID: l.ClientIDs}
 }
 
-// FromCreateGRPC is
+// FromCreateGRPC is, the box of an order without packaging is its only layer
+// and the outer packaging layer is the box of an order with packaging
 func FromCreateGRPC(request *order_v1.OrderCreateRequest) Request {
+	boxID := request.GetOrder().GetBoxID()
+	packaging := request.GetOrder().GetPackaging()
+
+	if len(packaging) == 0 && boxID != 0 {
+		packaging = []int64{boxID}
+	}
+	if len(packaging) > 0 {
+		boxID = packaging[len(packaging)-1]
+	}
+
 	return Request{
-		ExpireTimeDuration: int(request.ExpireTimeDuration),
-		OrderID:            request.Order.OrderID,
-		ClientID:           request.Order.ClientID,
-		Weight:             request.Order.Weight,
-		BoxID:              request.Order.BoxID,
+		ExpireTimeDuration: int(request.GetExpireTimeDuration()),
+		OrderID:            request.GetOrder().GetOrderID(),
+		ClientID:           request.GetOrder().GetClientID(),
+		Weight:             request.GetOrder().GetWeight(),
+		BoxID:              boxID,
+		PVZID:              request.GetOrder().GetPvzID(),
+		Packaging:          packaging,
+		Dimensions:         abstractModel.DimensionsFromGRPC(request.GetOrder().GetDimensions()),
+	}
+}
+
+// NotProcessed is the response of a strict batch that has lines failed before reaching the use case
+func NotProcessed(lines []BatchLine) BatchResponse {
+	results := make([]BatchLineResult, len(lines))
+	for index, line := range lines {
+		results[index] = BatchLineResult{Line: line.Line, OrderID: line.Request.OrderID, Code: BatchCodeNotProcessed}
+	}
+
+	return BatchResponse{Results: results, Failed: int64(len(lines))}
+}
+
+// AddInvalid adds the lines that failed validation to the response keeping the results in manifest order
+func (b *BatchResponse) AddInvalid(invalid []BatchLineResult) {
+	b.Results = append(b.Results, invalid...)
+	b.Failed += int64(len(invalid))
+	sort.Slice(b.Results, func(i, j int) bool {
+		return b.Results[i].Line < b.Results[j].Line
+	})
+}
+
+// BatchToGRPC is
+func BatchToGRPC(response BatchResponse) *order_v1.ReceiveOrdersBatchResponse {
+	results := make([]*order_v1.ReceiveOrderResult, len(response.Results))
+	for index, value := range response.Results {
+		results[index] = &order_v1.ReceiveOrderResult{
+			Line:    value.Line,
+			OrderID: value.OrderID,
+			Code:    value.Code,
+			Message: value.Message,
+		}
+	}
+
+	return &order_v1.ReceiveOrdersBatchResponse{
+		Results:  results,
+		Received: response.Received,
+		Failed:   response.Failed,
 	}
 }
 
@@ -197,7 +534,115 @@ func FromIssueGRPC(request *order_v1.IssueOrderRequest) RequestOrderIDs {
 	for index, orderID := range request.OrderIDRequest {
 		requestOrderIDs[index] = orderID.OrderID
 	}
-	return RequestOrderIDs{OrderIDs: requestOrderIDs}
+	return RequestOrderIDs{OrderIDs: requestOrderIDs, PVZID: request.PvzID}
+}
+
+// IssueLinesToGRPC is
+func IssueLinesToGRPC(issueLines []IssueLine) []*order_v1.IssueOrderLine {
+	lines := make([]*order_v1.IssueOrderLine, len(issueLines))
+	for index, value := range issueLines {
+		lines[index] = &order_v1.IssueOrderLine{
+			OrderID:             value.OrderID,
+			BoxID:               value.BoxID,
+			BoxName:             value.BoxName,
+			PackagingCost:       value.PackagingCost.Float(),
+			Weight:              value.Weight,
+			WeightCost:          value.WeightCost.Float(),
+			StorageFee:          value.StorageFee.Float(),
+			Cost:                value.Cost.Float(),
+			PackagingCostAmount: abstractModel.MoneyToGRPC(value.PackagingCost),
+			WeightCostAmount:    abstractModel.MoneyToGRPC(value.WeightCost),
+			StorageFeeAmount:    abstractModel.MoneyToGRPC(value.StorageFee),
+			CostAmount:          abstractModel.MoneyToGRPC(value.Cost),
+			Packaging:           PackagingToGRPC(value.Packaging),
+			ChargeableWeight:    value.ChargeableWeight,
+		}
+	}
+
+	return lines
+}
+
+// PackagingToGRPC is
+func PackagingToGRPC(packagingLines []PackagingLine) []*order_v1.PackagingLayer {
+	layers := make([]*order_v1.PackagingLayer, len(packagingLines))
+	for index, value := range packagingLines {
+		layers[index] = &order_v1.PackagingLayer{
+			BoxID:   value.BoxID,
+			BoxName: value.BoxName,
+			Cost:    abstractModel.MoneyToGRPC(value.Cost),
+		}
+	}
+
+	return layers
+}
+
+// IssueToGRPC is
+func IssueToGRPC(response IssueResponse) *order_v1.IssueOrderResponse {
+	return &order_v1.IssueOrderResponse{
+		ClientID:        response.ClientID,
+		Lines:           IssueLinesToGRPC(response.Lines),
+		TotalCost:       response.TotalCost.Float(),
+		Discount:        response.Discount.Float(),
+		TotalCostAmount: abstractModel.MoneyToGRPC(response.TotalCost),
+		DiscountAmount:  abstractModel.MoneyToGRPC(response.Discount),
+		IssuedAt:        timestamppb.New(response.IssuedAt),
+	}
+}
+
+// QuoteToGRPC is
+func QuoteToGRPC(response QuoteResponse) *order_v1.QuoteIssueResponse {
+	problems := make([]*order_v1.IssueProblem, len(response.Problems))
+	for index, value := range response.Problems {
+		problems[index] = &order_v1.IssueProblem{
+			OrderID: value.OrderID,
+			Code:    value.Code,
+			Message: value.Message,
+		}
+	}
+
+	return &order_v1.QuoteIssueResponse{
+		ClientID:        response.ClientID,
+		Lines:           IssueLinesToGRPC(response.Lines),
+		TotalCost:       response.TotalCost.Float(),
+		Discount:        response.Discount.Float(),
+		TotalCostAmount: abstractModel.MoneyToGRPC(response.TotalCost),
+		DiscountAmount:  abstractModel.MoneyToGRPC(response.Discount),
+		Problems:        problems,
+	}
+}
+
+// FromIDGRPC is
+func FromIDGRPC(request *order_v1.OrderIDRequest) IDRequest {
+	return IDRequest{
+		OrderID: request.OrderID,
+		PVZID:   request.PvzID,
+	}
+}
+
+// FromListGRPC is
+func FromListGRPC(request *order_v1.OrderListRequest) ListRequest {
+	return ListRequest{
+		PVZID:  request.PvzID,
+		Page:   abstractModel.PageFromGRPC(request.Page),
+		Filter: FilterFromGRPC(request.Filter),
+	}
+}
+
+// FilterFromGRPC is
+func FilterFromGRPC(filter *order_v1.OrderFilter) Filter {
+	return Filter{
+		ClientID:      filter.GetClientID(),
+		BoxID:         filter.GetBoxID(),
+		Status:        Status(filter.GetStatus()),
+		CreatedFrom:   abstractModel.SafeTime(filter.GetCreatedFrom()),
+		CreatedTo:     abstractModel.SafeTime(filter.GetCreatedTo()),
+		ExpiresFrom:   abstractModel.SafeTime(filter.GetExpiresFrom()),
+		ExpiresTo:     abstractModel.SafeTime(filter.GetExpiresTo()),
+		MinWeight:     filter.GetMinWeight(),
+		MaxWeight:     filter.GetMaxWeight(),
+		SortBy:        filter.GetSortBy(),
+		SortDirection: filter.GetSortDirection(),
+	}
 }
 
 // UniqueClientInfoToGRPC is
@@ -252,30 +697,69 @@ func ReturnedListToGRPC(response abstractModel.PaginatedResponse[ReturnedRespons
 	}
 }
 
+// InfoToGRPC is
+func InfoToGRPC(allResponse AllResponse) *order_v1.OrderAllInfo {
+	return &order_v1.OrderAllInfo{
+		Order: &order_v1.Order{
+			OrderID:    allResponse.OrderID,
+			ClientID:   allResponse.ClientID,
+			Weight:     allResponse.Weight,
+			BoxID:      allResponse.BoxID,
+			PvzID:      allResponse.PVZID,
+			Packaging:  allResponse.Packaging,
+			Dimensions: abstractModel.DimensionsToGRPC(allResponse.Dimensions),
+		},
+		CreatedAt:  abstractModel.SafeTimestamp(&allResponse.CreatedAt),
+		UpdatedAt:  abstractModel.SafeTimestamp(&allResponse.UpdatedAt),
+		AcceptedAt: abstractModel.SafeTimestamp(allResponse.AcceptedAt),
+		IssuedAt:   abstractModel.SafeTimestamp(allResponse.IssuedAt),
+		ExpiresAt:  abstractModel.SafeTimestamp(allResponse.ExpiresAt),
+	}
+}
+
 // AllInfoToGrpc is
 func AllInfoToGrpc(allResponse []AllResponse) []*order_v1.OrderAllInfo {
 	response := make([]*order_v1.OrderAllInfo, len(allResponse))
 	for index, value := range allResponse {
-		createdAt := value.CreatedAt
-		updatedAt := value.UpdatedAt
-		response[index] = &order_v1.OrderAllInfo{
-			Order: &order_v1.Order{
-				OrderID:  value.OrderID,
-				ClientID: value.ClientID,
-				Weight:   value.Weight,
-				BoxID:    value.BoxID,
-			},
-			CreatedAt:  abstractModel.SafeTimestamp(&createdAt),
-			UpdatedAt:  abstractModel.SafeTimestamp(&updatedAt),
-			AcceptedAt: abstractModel.SafeTimestamp(value.AcceptedAt),
-			IssuedAt:   abstractModel.SafeTimestamp(value.IssuedAt),
-			ExpiresAt:  abstractModel.SafeTimestamp(value.ExpiresAt),
-		}
+		response[index] = InfoToGRPC(value)
 	}
 
 	return response
 }
 
+// DetailsToGRPC is
+func DetailsToGRPC(response DetailsResponse) *order_v1.OrderDetails {
+	return &order_v1.OrderDetails{
+		OrderAllInfo: InfoToGRPC(response.AllResponse),
+		Status:       string(response.Status),
+		ReturnedAt:   abstractModel.SafeTimestamp(response.ReturnedAt),
+		Box:          box.InfoToGRPC(response.Box),
+		CellCode:     response.CellCode,
+	}
+}
+
+// HistoryToGRPC is
+func HistoryToGRPC(response HistoryResponse) *order_v1.OrderHistoryResponse {
+	statusChanges := make([]*order_v1.OrderStatusChange, len(response.StatusChanges))
+	for index, value := range response.StatusChanges {
+		var fromStatus string
+		if value.FromStatus != nil {
+			fromStatus = string(*value.FromStatus)
+		}
+
+		statusChanges[index] = &order_v1.OrderStatusChange{
+			FromStatus: fromStatus,
+			ToStatus:   string(value.ToStatus),
+			ChangedAt:  timestamppb.New(value.ChangedAt),
+		}
+	}
+
+	return &order_v1.OrderHistoryResponse{
+		OrderID:       response.OrderID,
+		StatusChanges: statusChanges,
+	}
+}
+
 // ListToGRPC is
 func ListToGRPC(response abstractModel.PaginatedResponse[AllResponse]) *order_v1.OrderListResponse {
 	return &order_v1.OrderListResponse{
@@ -295,5 +779,6 @@ func FromAcceptGRPC(request *order_v1.RequestWithClientID) RequestWithClientID {
 	return RequestWithClientID{
 		OrderID:  request.OrderID,
 		ClientID: request.ClientID,
+		PVZID:    request.PvzID,
 	}
 }
@@ -0,0 +1,110 @@
+package order
+
+import (
+	"fmt"
+	"time"
+
+	abstractModel "Homework-1/internal/model/abstract"
+	"Homework-1/pkg/errlst"
+)
+
+// Status is
+type Status string
+
+const (
+	// StatusReceived is
+	StatusReceived Status = "received"
+	// StatusIssued is
+	StatusIssued Status = "issued"
+	// StatusReturnedByClient is
+	StatusReturnedByClient Status = "returned_by_client"
+	// StatusExpired is
+	StatusExpired Status = "expired"
+	// StatusHandedToCourier is
+	StatusHandedToCourier Status = "handed_to_courier"
+)
+
+// transitions is the single source of truth for the order lifecycle,
+// every status change made by the order use case has to be listed here
+var transitions = map[Status][]Status{
+	StatusReceived:         {StatusIssued, StatusExpired},
+	StatusIssued:           {StatusReturnedByClient},
+	StatusReturnedByClient: {StatusHandedToCourier},
+	StatusExpired:          {StatusHandedToCourier},
+	StatusHandedToCourier:  {},
+}
+
+// CanTransitionTo is
+func (s Status) CanTransitionTo(to Status) bool {
+	for _, next := range transitions[s] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// At is the status as seen at the given moment, a received order whose
+// storage period is over is reported as expired even before it was moved there
+func (s Status) At(expiresAt *time.Time, now time.Time) Status {
+	if s == StatusReceived && expiresAt != nil && expiresAt.Before(now) {
+		return StatusExpired
+	}
+
+	return s
+}
+
+// TransitionError is
+type TransitionError struct {
+	From Status
+	To   Status
+}
+
+// Error is
+func (t *TransitionError) Error() string {
+	return fmt.Sprintf("%v: %s -> %s", errlst.ErrInvalidStatusTransition, t.From, t.To)
+}
+
+// Unwrap is
+func (t *TransitionError) Unwrap() error {
+	return errlst.ErrInvalidStatusTransition
+}
+
+// StateData is
+type StateData struct {
+	OrderID  int64   `db:"order_id"`
+	PVZID    int64   `db:"pvz_id"`
+	ClientID int64   `db:"client_id"`
+	BoxID    int64   `db:"box_id"`
+	BoxName  string  `db:"box_name"`
+	Weight   float64 `db:"weight"`
+	abstractModel.Dimensions
+	Status    Status     `db:"status"`
+	ExpiresAt *time.Time `db:"expires_at"`
+	IssuedAt  *time.Time `db:"issued_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+// StatusTransitionData is, CellID is the cell an order that comes back into its PVZ is stored in
+type StatusTransitionData struct {
+	OrderID    int64  `db:"order_id"`
+	PVZID      int64  `db:"pvz_id"`
+	FromStatus Status `db:"from_status"`
+	ToStatus   Status `db:"to_status"`
+	CellID     *int64 `db:"cell_id"`
+}
+
+// TransitionTo is
+func (s *StateData) TransitionTo(to Status) (StatusTransitionData, error) {
+	if !s.Status.CanTransitionTo(to) {
+		return StatusTransitionData{}, &TransitionError{From: s.Status, To: to}
+	}
+
+	return StatusTransitionData{
+		OrderID:    s.OrderID,
+		PVZID:      s.PVZID,
+		FromStatus: s.Status,
+		ToStatus:   to,
+	}, nil
+}
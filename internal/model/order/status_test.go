@@ -0,0 +1,86 @@
+package order
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"Homework-1/pkg/errlst"
+)
+
+// TestStatus_CanTransitionTo is
+func TestStatus_CanTransitionTo(t *testing.T) {
+	t.Parallel()
+
+	statuses := []Status{StatusReceived, StatusIssued, StatusReturnedByClient, StatusExpired, StatusHandedToCourier}
+	allowed := map[Status][]Status{
+		StatusReceived:         {StatusIssued, StatusExpired},
+		StatusIssued:           {StatusReturnedByClient},
+		StatusReturnedByClient: {StatusHandedToCourier},
+		StatusExpired:          {StatusHandedToCourier},
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			from, to := from, to
+			want := false
+			for _, next := range allowed[from] {
+				if next == to {
+					want = true
+				}
+			}
+
+			t.Run(string(from)+" -> "+string(to), func(t *testing.T) {
+				t.Parallel()
+				assert.Equal(t, want, from.CanTransitionTo(to))
+
+				state := StateData{OrderID: 1, PVZID: 2, Status: from}
+				transitionData, err := state.TransitionTo(to)
+				if want {
+					assert.NoError(t, err)
+					assert.Equal(t, StatusTransitionData{OrderID: 1, PVZID: 2, FromStatus: from, ToStatus: to}, transitionData)
+					return
+				}
+
+				assert.ErrorIs(t, err, errlst.ErrInvalidStatusTransition)
+				var transitionErr *TransitionError
+				assert.True(t, errors.As(err, &transitionErr))
+				assert.Equal(t, &TransitionError{From: from, To: to}, transitionErr)
+				assert.Equal(t, StatusTransitionData{}, transitionData)
+			})
+		}
+	}
+}
+
+// TestStatus_At is
+func TestStatus_At(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 5, 20, 12, 0, 0, 0, time.UTC)
+	before := now.Add(-time.Minute)
+	after := now.Add(time.Minute)
+
+	tests := []*struct {
+		description string
+		status      Status
+		expiresAt   *time.Time
+		want        Status
+	}{
+		{description: "Received order before its storage period is over", status: StatusReceived, expiresAt: &after, want: StatusReceived},
+		{description: "Received order at the end of its storage period", status: StatusReceived, expiresAt: &now, want: StatusReceived},
+		{description: "Received order after its storage period is over", status: StatusReceived, expiresAt: &before, want: StatusExpired},
+		{description: "Received order without a storage period", status: StatusReceived, want: StatusReceived},
+		{description: "Issued order after its storage period is over", status: StatusIssued, expiresAt: &before, want: StatusIssued},
+		{description: "Returned order after its storage period is over", status: StatusReturnedByClient, expiresAt: &before, want: StatusReturnedByClient},
+		{description: "Expired order stays expired", status: StatusExpired, expiresAt: &before, want: StatusExpired},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.status.At(tt.expiresAt, now))
+		})
+	}
+}
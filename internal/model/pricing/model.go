@@ -0,0 +1,257 @@
+package pricing
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+
+	abstractModel "Homework-1/internal/model/abstract"
+	"Homework-1/pkg/api/pricing_v1"
+	"Homework-1/pkg/money"
+)
+
+// WeightBracket is
+type WeightBracket struct {
+	MinWeight float64      `json:"minWeight" validate:"gte=0"`
+	MaxWeight float64      `json:"maxWeight" validate:"gtfield=MinWeight"`
+	Cost      money.Amount `json:"cost" validate:"gte=0"`
+}
+
+// BoxSurcharge is
+type BoxSurcharge struct {
+	BoxID     int64        `json:"boxID" validate:"gt=0"`
+	Surcharge money.Amount `json:"surcharge" validate:"gte=0"`
+}
+
+// Request is
+type Request struct {
+	Name              string          `json:"name" validate:"required"`
+	WeightBrackets    []WeightBracket `json:"weightBrackets" validate:"dive"`
+	BoxSurcharges     []BoxSurcharge  `json:"boxSurcharges" validate:"dive"`
+	FreeStorageDays   int64           `json:"freeStorageDays" validate:"gte=0"`
+	StorageFeePerDay  money.Amount    `json:"storageFeePerDay" validate:"gte=0"`
+	DiscountPercent   float64         `json:"discountPercent" validate:"gte=0,lte=100"`
+	DiscountMinOrders int64           `json:"discountMinOrders" validate:"gte=0"`
+	Currency          string          `json:"currency" validate:"eq=RUB"`
+}
+
+// Data is
+type Data struct {
+	Name              string       `db:"name"`
+	FreeStorageDays   int64        `db:"free_storage_days"`
+	StorageFeePerDay  money.Amount `db:"storage_fee_per_day"`
+	DiscountPercent   float64      `db:"discount_percent"`
+	DiscountMinOrders int64        `db:"discount_min_orders"`
+	WeightBrackets    []WeightBracketData
+	BoxSurcharges     []BoxSurchargeData
+}
+
+// WeightBracketData is
+type WeightBracketData struct {
+	MinWeight float64      `db:"min_weight"`
+	MaxWeight float64      `db:"max_weight"`
+	Cost      money.Amount `db:"cost"`
+}
+
+// BoxSurchargeData is
+type BoxSurchargeData struct {
+	BoxID     int64        `db:"box_id"`
+	Surcharge money.Amount `db:"surcharge"`
+}
+
+// ToStorage is
+func (t *Request) ToStorage() Data {
+	return Data{
+		Name:              t.Name,
+		FreeStorageDays:   t.FreeStorageDays,
+		StorageFeePerDay:  t.StorageFeePerDay,
+		DiscountPercent:   t.DiscountPercent,
+		DiscountMinOrders: t.DiscountMinOrders,
+		WeightBrackets: lo.Map(t.WeightBrackets, func(item WeightBracket, _ int) WeightBracketData {
+			return WeightBracketData(item)
+		}),
+		BoxSurcharges: lo.Map(t.BoxSurcharges, func(item BoxSurcharge, _ int) BoxSurchargeData {
+			return BoxSurchargeData(item)
+		}),
+	}
+}
+
+// AllData is
+type AllData struct {
+	ID                int64        `db:"id"`
+	Name              string       `db:"name"`
+	IsActive          bool         `db:"is_active"`
+	FreeStorageDays   int64        `db:"free_storage_days"`
+	StorageFeePerDay  money.Amount `db:"storage_fee_per_day"`
+	DiscountPercent   float64      `db:"discount_percent"`
+	DiscountMinOrders int64        `db:"discount_min_orders"`
+	CreatedAt         time.Time    `db:"created_at"`
+	UpdatedAt         time.Time    `db:"updated_at"`
+	WeightBrackets    []WeightBracketData
+	BoxSurcharges     []BoxSurchargeData
+}
+
+// AllResponse is
+type AllResponse struct {
+	ID                int64           `json:"id"`
+	Name              string          `json:"name"`
+	IsActive          bool            `json:"isActive"`
+	WeightBrackets    []WeightBracket `json:"weightBrackets"`
+	BoxSurcharges     []BoxSurcharge  `json:"boxSurcharges"`
+	FreeStorageDays   int64           `json:"freeStorageDays"`
+	StorageFeePerDay  money.Amount    `json:"storageFeePerDay"`
+	DiscountPercent   float64         `json:"discountPercent"`
+	DiscountMinOrders int64           `json:"discountMinOrders"`
+	CreatedAt         time.Time       `json:"createdAt"`
+	UpdatedAt         time.Time       `json:"updatedAt"`
+}
+
+// ToServer is
+func (t *AllData) ToServer() AllResponse {
+	return AllResponse{
+		ID:                t.ID,
+		Name:              t.Name,
+		IsActive:          t.IsActive,
+		FreeStorageDays:   t.FreeStorageDays,
+		StorageFeePerDay:  t.StorageFeePerDay,
+		DiscountPercent:   t.DiscountPercent,
+		DiscountMinOrders: t.DiscountMinOrders,
+		CreatedAt:         t.CreatedAt,
+		UpdatedAt:         t.UpdatedAt,
+		WeightBrackets: lo.Map(t.WeightBrackets, func(item WeightBracketData, _ int) WeightBracket {
+			return WeightBracket(item)
+		}),
+		BoxSurcharges: lo.Map(t.BoxSurcharges, func(item BoxSurchargeData, _ int) BoxSurcharge {
+			return BoxSurcharge(item)
+		}),
+	}
+}
+
+// Layer is a single packaging layer of an order, Dimensions are the inner size of its box
+type Layer struct {
+	BoxID      int64
+	Cost       money.Amount
+	IsCheck    bool
+	Weight     float64
+	Dimensions abstractModel.Dimensions
+}
+
+// Item is a single order as seen by the tariff, its packaging goes from the innermost layer to the outermost one.
+// VolumetricDivisor turns the volume of the order into its volumetric weight, zero turns it off
+type Item struct {
+	Packaging         []Layer
+	Weight            float64
+	Dimensions        abstractModel.Dimensions
+	VolumetricDivisor float64
+	StoredDays        int64
+}
+
+// Cost is the price of a single order split by tariff rule, Layers holds the packaging cost of each layer
+// and ChargeableWeight is the weight the tariff brackets were applied to
+type Cost struct {
+	Layers           []money.Amount
+	Packaging        money.Amount
+	Weight           money.Amount
+	Storage          money.Amount
+	ChargeableWeight float64
+}
+
+// Total is
+func (c Cost) Total() money.Amount {
+	return c.Packaging + c.Weight + c.Storage
+}
+
+// FromGRPC is
+func FromGRPC(tariffGRPC *pricing_v1.Tariff) Request {
+	if tariffGRPC == nil {
+		return Request{}
+	}
+
+	var currencies []string
+
+	//nolint:staticcheck // the float fee is still accepted from old clients
+	storageFeePerDay, currency := abstractModel.MoneyFromGRPC(tariffGRPC.GetStorageFeePerDayAmount(), tariffGRPC.GetStorageFeePerDay())
+	currencies = append(currencies, currency)
+
+	weightBrackets := lo.Map(tariffGRPC.WeightBrackets, func(item *pricing_v1.WeightBracket, _ int) WeightBracket {
+		//nolint:staticcheck // the float cost is still accepted from old clients
+		cost, currency := abstractModel.MoneyFromGRPC(item.GetCostAmount(), item.GetCost())
+		currencies = append(currencies, currency)
+
+		return WeightBracket{
+			MinWeight: item.GetMinWeight(),
+			MaxWeight: item.GetMaxWeight(),
+			Cost:      cost,
+		}
+	})
+
+	boxSurcharges := lo.Map(tariffGRPC.BoxSurcharges, func(item *pricing_v1.BoxSurcharge, _ int) BoxSurcharge {
+		//nolint:staticcheck // the float surcharge is still accepted from old clients
+		surcharge, currency := abstractModel.MoneyFromGRPC(item.GetSurchargeAmount(), item.GetSurcharge())
+		currencies = append(currencies, currency)
+
+		return BoxSurcharge{
+			BoxID:     item.GetBoxID(),
+			Surcharge: surcharge,
+		}
+	})
+
+	return Request{
+		Name:              tariffGRPC.Name,
+		WeightBrackets:    weightBrackets,
+		BoxSurcharges:     boxSurcharges,
+		FreeStorageDays:   tariffGRPC.FreeStorageDays,
+		StorageFeePerDay:  storageFeePerDay,
+		DiscountPercent:   tariffGRPC.DiscountPercent,
+		DiscountMinOrders: tariffGRPC.DiscountMinOrders,
+		Currency:          abstractModel.RequestCurrency(currencies...),
+	}
+}
+
+// InfoToGRPC is
+func InfoToGRPC(allResponse AllResponse) *pricing_v1.TariffAllInfo {
+	return &pricing_v1.TariffAllInfo{
+		ID: allResponse.ID,
+		Tariff: &pricing_v1.Tariff{
+			Name: allResponse.Name,
+			WeightBrackets: lo.Map(allResponse.WeightBrackets, func(item WeightBracket, _ int) *pricing_v1.WeightBracket {
+				return &pricing_v1.WeightBracket{
+					MinWeight:  item.MinWeight,
+					MaxWeight:  item.MaxWeight,
+					Cost:       item.Cost.Float(),
+					CostAmount: abstractModel.MoneyToGRPC(item.Cost),
+				}
+			}),
+			BoxSurcharges: lo.Map(allResponse.BoxSurcharges, func(item BoxSurcharge, _ int) *pricing_v1.BoxSurcharge {
+				return &pricing_v1.BoxSurcharge{
+					BoxID:           item.BoxID,
+					Surcharge:       item.Surcharge.Float(),
+					SurchargeAmount: abstractModel.MoneyToGRPC(item.Surcharge),
+				}
+			}),
+			FreeStorageDays:        allResponse.FreeStorageDays,
+			StorageFeePerDay:       allResponse.StorageFeePerDay.Float(),
+			DiscountPercent:        allResponse.DiscountPercent,
+			DiscountMinOrders:      allResponse.DiscountMinOrders,
+			StorageFeePerDayAmount: abstractModel.MoneyToGRPC(allResponse.StorageFeePerDay),
+		},
+		IsActive:  allResponse.IsActive,
+		CreatedAt: abstractModel.SafeTimestamp(&allResponse.CreatedAt),
+		UpdatedAt: abstractModel.SafeTimestamp(&allResponse.UpdatedAt),
+	}
+}
+
+// ListToGRPC is
+func ListToGRPC(response abstractModel.PaginatedResponse[AllResponse]) *pricing_v1.TariffListResponse {
+	return &pricing_v1.TariffListResponse{
+		TariffAllInfo: lo.Map(response.Items, func(item AllResponse, _ int) *pricing_v1.TariffAllInfo {
+			return InfoToGRPC(item)
+		}),
+		Pagination: abstractModel.PaginationToGRPC(
+			abstractModel.Page{
+				CurrentPage:  response.CurrentPage,
+				ItemsPerPage: response.ItemsPerPage,
+			},
+			response.TotalItems,
+		)}
+}
@@ -0,0 +1,132 @@
+package pvz
+
+import (
+	"strings"
+
+	abstractModel "Homework-1/internal/model/abstract"
+	"Homework-1/pkg/api/pvz_v1"
+)
+
+// StructuredAddress is
+type StructuredAddress struct {
+	Country    string `json:"country" validate:"max=100"`
+	City       string `json:"city" validate:"max=100"`
+	Street     string `json:"street" validate:"max=200"`
+	Building   string `json:"building" validate:"max=50"`
+	PostalCode string `json:"postalCode" validate:"max=20"`
+}
+
+// StructuredAddressData is
+type StructuredAddressData struct {
+	Country    string `db:"country"`
+	City       string `db:"city"`
+	Street     string `db:"street"`
+	Building   string `db:"building"`
+	PostalCode string `db:"postal_code"`
+}
+
+// SearchRequest is
+type SearchRequest struct {
+	City  string             `json:"city" validate:"max=100"`
+	Query string             `json:"query" validate:"max=200"`
+	Page  abstractModel.Page `json:"page"`
+}
+
+// SearchData is
+type SearchData struct {
+	City  string
+	Query string
+	abstractModel.PageData
+}
+
+// String is the parts of the address that are set, from the postal code to the building
+func (a *StructuredAddress) String() string {
+	parts := make([]string, 0, 5)
+	for _, part := range []string{a.PostalCode, a.Country, a.City, a.Street, a.Building} {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// ToStorage is
+func (a *StructuredAddress) ToStorage() StructuredAddressData {
+	return StructuredAddressData{
+		Country:    a.Country,
+		City:       a.City,
+		Street:     a.Street,
+		Building:   a.Building,
+		PostalCode: a.PostalCode,
+	}
+}
+
+// ToServer is
+func (a *StructuredAddressData) ToServer() StructuredAddress {
+	return StructuredAddress{
+		Country:    a.Country,
+		City:       a.City,
+		Street:     a.Street,
+		Building:   a.Building,
+		PostalCode: a.PostalCode,
+	}
+}
+
+// ToStorage is
+func (s *SearchRequest) ToStorage() SearchData {
+	return SearchData{
+		City:     strings.TrimSpace(s.City),
+		Query:    strings.TrimSpace(s.Query),
+		PageData: s.Page.ToStorage(),
+	}
+}
+
+// addressFromGRPC is the address of the request, it is made of the structured address when it is not set
+func addressFromGRPC(pvz *pvz_v1.PVZ) (string, *StructuredAddress) {
+	structuredAddress := StructuredAddressFromGRPC(pvz.StructuredAddress)
+	if pvz.Address == "" && structuredAddress != nil {
+		return structuredAddress.String(), structuredAddress
+	}
+
+	return pvz.Address, structuredAddress
+}
+
+// StructuredAddressFromGRPC is
+func StructuredAddressFromGRPC(address *pvz_v1.StructuredAddress) *StructuredAddress {
+	if address == nil {
+		return nil
+	}
+
+	return &StructuredAddress{
+		Country:    address.Country,
+		City:       address.City,
+		Street:     address.Street,
+		Building:   address.Building,
+		PostalCode: address.PostalCode,
+	}
+}
+
+// StructuredAddressToGRPC is, nothing is sent for a PVZ with only the free text address
+func StructuredAddressToGRPC(address StructuredAddress) *pvz_v1.StructuredAddress {
+	if address == (StructuredAddress{}) {
+		return nil
+	}
+
+	return &pvz_v1.StructuredAddress{
+		Country:    address.Country,
+		City:       address.City,
+		Street:     address.Street,
+		Building:   address.Building,
+		PostalCode: address.PostalCode,
+	}
+}
+
+// FromSearchGRPC is
+func FromSearchGRPC(request *pvz_v1.SearchRequest) SearchRequest {
+	return SearchRequest{
+		City:  request.City,
+		Query: request.Query,
+		Page:  abstractModel.PageFromGRPC(request.Page),
+	}
+}
@@ -0,0 +1,144 @@
+package pvz
+
+import (
+	"github.com/samber/lo"
+
+	"Homework-1/pkg/api/pvz_v1"
+)
+
+// CellRequest is, a cell holds at most MaxCount orders weighing MaxWeight in total
+type CellRequest struct {
+	PVZID     int64   `json:"pvzID" validate:"required"`
+	Code      string  `json:"code" validate:"required,max=32"`
+	MaxWeight float64 `json:"maxWeight" validate:"gt=0"`
+	MaxCount  int64   `json:"maxCount" validate:"gt=0"`
+}
+
+// CellData is
+type CellData struct {
+	PVZID     int64   `db:"pvz_id"`
+	Code      string  `db:"code"`
+	MaxWeight float64 `db:"max_weight"`
+	MaxCount  int64   `db:"max_count"`
+}
+
+// CellIDRequest is
+type CellIDRequest struct {
+	PVZID int64  `json:"pvzID" validate:"required"`
+	Code  string `json:"code" validate:"required"`
+}
+
+// CellOccupancyData is
+type CellOccupancyData struct {
+	Code      string  `db:"code"`
+	MaxWeight float64 `db:"max_weight"`
+	MaxCount  int64   `db:"max_count"`
+	Orders    int64   `db:"orders"`
+	Weight    float64 `db:"weight"`
+}
+
+// CellOccupancy is, Utilization is the share of the cell places taken by orders
+type CellOccupancy struct {
+	Code        string  `json:"code"`
+	MaxWeight   float64 `json:"maxWeight"`
+	MaxCount    int64   `json:"maxCount"`
+	Orders      int64   `json:"orders"`
+	Weight      float64 `json:"weight"`
+	Utilization float64 `json:"utilization"`
+}
+
+// OccupancyResponse is, Capacity is the number of places in all cells of the PVZ and Occupied the taken ones
+type OccupancyResponse struct {
+	PVZID       int64           `json:"pvzID"`
+	Cells       []CellOccupancy `json:"cells"`
+	Capacity    int64           `json:"capacity"`
+	Occupied    int64           `json:"occupied"`
+	Utilization float64         `json:"utilization"`
+}
+
+// ToStorage is
+func (c *CellRequest) ToStorage() CellData {
+	return CellData{
+		PVZID:     c.PVZID,
+		Code:      c.Code,
+		MaxWeight: c.MaxWeight,
+		MaxCount:  c.MaxCount,
+	}
+}
+
+// ToServer is
+func (c *CellOccupancyData) ToServer() CellOccupancy {
+	return CellOccupancy{
+		Code:        c.Code,
+		MaxWeight:   c.MaxWeight,
+		MaxCount:    c.MaxCount,
+		Orders:      c.Orders,
+		Weight:      c.Weight,
+		Utilization: utilization(c.Orders, c.MaxCount),
+	}
+}
+
+// NewOccupancyResponse is
+func NewOccupancyResponse(pvzID int64, cellsData []CellOccupancyData) OccupancyResponse {
+	response := OccupancyResponse{
+		PVZID: pvzID,
+		Cells: lo.Map(cellsData, func(item CellOccupancyData, _ int) CellOccupancy {
+			return item.ToServer()
+		}),
+	}
+
+	for _, cell := range response.Cells {
+		response.Capacity += cell.MaxCount
+		response.Occupied += cell.Orders
+	}
+	response.Utilization = utilization(response.Occupied, response.Capacity)
+
+	return response
+}
+
+// utilization is
+func utilization(occupied int64, capacity int64) float64 {
+	if capacity == 0 {
+		return 0
+	}
+
+	return float64(occupied) / float64(capacity)
+}
+
+// FromCellCreateGRPC is
+func FromCellCreateGRPC(request *pvz_v1.CellCreateRequest) CellRequest {
+	return CellRequest{
+		PVZID:     request.PvzID,
+		Code:      request.Code,
+		MaxWeight: request.MaxWeight,
+		MaxCount:  request.MaxCount,
+	}
+}
+
+// FromCellIDGRPC is
+func FromCellIDGRPC(request *pvz_v1.CellIDRequest) CellIDRequest {
+	return CellIDRequest{
+		PVZID: request.PvzID,
+		Code:  request.Code,
+	}
+}
+
+// OccupancyToGRPC is
+func OccupancyToGRPC(response OccupancyResponse) *pvz_v1.OccupancyResponse {
+	return &pvz_v1.OccupancyResponse{
+		PvzID: response.PVZID,
+		Cells: lo.Map(response.Cells, func(item CellOccupancy, _ int) *pvz_v1.CellOccupancy {
+			return &pvz_v1.CellOccupancy{
+				Code:        item.Code,
+				MaxWeight:   item.MaxWeight,
+				MaxCount:    item.MaxCount,
+				Orders:      item.Orders,
+				Weight:      item.Weight,
+				Utilization: item.Utilization,
+			}
+		}),
+		Capacity:    response.Capacity,
+		Occupied:    response.Occupied,
+		Utilization: response.Utilization,
+	}
+}
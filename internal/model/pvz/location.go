@@ -0,0 +1,164 @@
+package pvz
+
+import (
+	"math"
+
+	"github.com/samber/lo"
+
+	abstractModel "Homework-1/internal/model/abstract"
+	"Homework-1/pkg/api/pvz_v1"
+	"Homework-1/pkg/constants"
+)
+
+// Location is
+type Location struct {
+	Latitude  float64 `json:"latitude" validate:"gte=-90,lte=90"`
+	Longitude float64 `json:"longitude" validate:"gte=-180,lte=180"`
+}
+
+// LocationData is, a PVZ without a location has neither of them
+type LocationData struct {
+	Latitude  *float64 `db:"latitude"`
+	Longitude *float64 `db:"longitude"`
+}
+
+// NearbyRequest is
+type NearbyRequest struct {
+	Point    *Location          `json:"point" validate:"required"`
+	RadiusKm float64            `json:"radiusKm" validate:"gt=0,lte=1000"`
+	Page     abstractModel.Page `json:"page"`
+}
+
+// NearbyData is, the PVZ are looked for inside the bounding box of the circle first
+type NearbyData struct {
+	Latitude     float64
+	Longitude    float64
+	RadiusKm     float64
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+	abstractModel.PageData
+}
+
+// NearbyAllData is
+type NearbyAllData struct {
+	AllData
+	DistanceKm float64 `db:"distance_km"`
+}
+
+// NearbyResponse is
+type NearbyResponse struct {
+	AllResponse
+	DistanceKm float64 `json:"distanceKm"`
+}
+
+// ToStorage is
+func (l *Location) ToStorage() LocationData {
+	if l == nil {
+		return LocationData{}
+	}
+
+	return LocationData{
+		Latitude:  lo.ToPtr(l.Latitude),
+		Longitude: lo.ToPtr(l.Longitude),
+	}
+}
+
+// ToServer is
+func (l *LocationData) ToServer() *Location {
+	if l.Latitude == nil || l.Longitude == nil {
+		return nil
+	}
+
+	return &Location{
+		Latitude:  *l.Latitude,
+		Longitude: *l.Longitude,
+	}
+}
+
+// ToStorage is, the box spans all longitudes when the circle reaches a pole or the antimeridian
+func (n *NearbyRequest) ToStorage() NearbyData {
+	latitudeDelta := n.RadiusKm / constants.KmPerDegree
+
+	nearbyData := NearbyData{
+		Latitude:     n.Point.Latitude,
+		Longitude:    n.Point.Longitude,
+		RadiusKm:     n.RadiusKm,
+		MinLatitude:  math.Max(n.Point.Latitude-latitudeDelta, -90),
+		MaxLatitude:  math.Min(n.Point.Latitude+latitudeDelta, 90),
+		MinLongitude: -180,
+		MaxLongitude: 180,
+		PageData:     n.Page.ToStorage(),
+	}
+
+	if nearbyData.MinLatitude > -90 && nearbyData.MaxLatitude < 90 {
+		longitudeDelta := latitudeDelta / math.Cos(n.Point.Latitude*math.Pi/180)
+		if n.Point.Longitude-longitudeDelta > -180 && n.Point.Longitude+longitudeDelta < 180 {
+			nearbyData.MinLongitude = n.Point.Longitude - longitudeDelta
+			nearbyData.MaxLongitude = n.Point.Longitude + longitudeDelta
+		}
+	}
+
+	return nearbyData
+}
+
+// ToServer is
+func (n *NearbyAllData) ToServer() NearbyResponse {
+	return NearbyResponse{
+		AllResponse: n.AllData.ToPVZServer(),
+		DistanceKm:  n.DistanceKm,
+	}
+}
+
+// LocationFromGRPC is
+func LocationFromGRPC(point *pvz_v1.GeoPoint) *Location {
+	if point == nil {
+		return nil
+	}
+
+	return &Location{
+		Latitude:  point.Latitude,
+		Longitude: point.Longitude,
+	}
+}
+
+// LocationToGRPC is
+func LocationToGRPC(location *Location) *pvz_v1.GeoPoint {
+	if location == nil {
+		return nil
+	}
+
+	return &pvz_v1.GeoPoint{
+		Latitude:  location.Latitude,
+		Longitude: location.Longitude,
+	}
+}
+
+// FromNearbyGRPC is
+func FromNearbyGRPC(request *pvz_v1.NearbyRequest) NearbyRequest {
+	return NearbyRequest{
+		Point:    LocationFromGRPC(request.Point),
+		RadiusKm: request.RadiusKm,
+		Page:     abstractModel.PageFromGRPC(request.Page),
+	}
+}
+
+// NearbyToGRPC is
+func NearbyToGRPC(response abstractModel.PaginatedResponse[NearbyResponse]) *pvz_v1.NearbyResponse {
+	return &pvz_v1.NearbyResponse{
+		NearbyPVZ: lo.Map(response.Items, func(item NearbyResponse, _ int) *pvz_v1.NearbyPVZ {
+			return &pvz_v1.NearbyPVZ{
+				PvzAllInfo: InfoToGRPC(item.AllResponse),
+				DistanceKm: item.DistanceKm,
+			}
+		}),
+		Pagination: abstractModel.PaginationToGRPC(
+			abstractModel.Page{
+				CurrentPage:  response.CurrentPage,
+				ItemsPerPage: response.ItemsPerPage,
+			},
+			response.TotalItems,
+		),
+	}
+}
@@ -3,6 +3,7 @@ package pvz
 import (
 	"time"
 
+	"github.com/samber/lo"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	abstractModel "Homework-1/internal/model/abstract"
@@ -11,25 +12,42 @@ import (
 
 // Request is
 type Request struct {
-	Name    string `json:"name" validate:"required,min=4,max=100"`
-	Address string `json:"address" validate:"required,min=2"`
-	Contact string `json:"contact" validate:"required,phone"`
+	Name              string            `json:"name" validate:"required,min=4,max=100"`
+	Address           string            `json:"address" validate:"required,min=2"`
+	Contact           string            `json:"contact" validate:"required,phone"`
+	Schedule          Schedule          `json:"schedule"`
+	Location          *Location         `json:"location,omitempty"`
+	StructuredAddress StructuredAddress `json:"structuredAddress"`
 }
 
-// UpdateRequest is
+// UpdateRequest is, Version is the version of the PVZ the change was made on
 type UpdateRequest struct {
-	ID      int64   `json:"id" validate:"required"`
-	Name    *string `json:"name,omitempty"`
-	Address *string `json:"address,omitempty"`
-	Contact *string `json:"contact,omitempty"`
+	ID                int64              `json:"id" validate:"required"`
+	Name              *string            `json:"name,omitempty"`
+	Address           *string            `json:"address,omitempty"`
+	Contact           *string            `json:"contact,omitempty"`
+	Schedule          *Schedule          `json:"schedule,omitempty"`
+	Location          *Location          `json:"location,omitempty"`
+	StructuredAddress *StructuredAddress `json:"structuredAddress,omitempty"`
+	Version           int64              `json:"version" validate:"required"`
 }
 
 // UpdateData is
 type UpdateData struct {
-	ID      int64   `db:"id"`
-	Name    *string `db:"name"`
-	Address *string `db:"address"`
-	Contact *string `db:"contact"`
+	ID                int64                  `db:"id"`
+	Name              *string                `db:"name"`
+	Address           *string                `db:"address"`
+	Contact           *string                `db:"contact"`
+	Schedule          *ScheduleData          `db:"-"`
+	Location          *LocationData          `db:"-"`
+	StructuredAddress *StructuredAddressData `db:"-"`
+	Version           int64                  `db:"version"`
+}
+
+// DeleteRequest is
+type DeleteRequest struct {
+	ID      int64 `json:"id"`
+	Version int64 `json:"version" validate:"required"`
 }
 
 // Data is
@@ -37,65 +55,106 @@ type Data struct {
 	Name    string `db:"name"`
 	Address string `db:"address"`
 	Contact string `db:"contact"`
+	ScheduleData
+	LocationData
+	StructuredAddressData
 }
 
 // AllResponse is
 type AllResponse struct {
-	ID        int64     `json:"id"`
-	Name      string    `json:"name"`
-	Address   string    `json:"address"`
-	Contact   string    `json:"contact"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID                int64             `json:"id"`
+	Name              string            `json:"name"`
+	Address           string            `json:"address"`
+	Contact           string            `json:"contact"`
+	CreatedAt         time.Time         `json:"createdAt"`
+	UpdatedAt         time.Time         `json:"updatedAt"`
+	Version           int64             `json:"version"`
+	DeletedAt         *time.Time        `json:"deletedAt,omitempty"`
+	Schedule          Schedule          `json:"schedule"`
+	Location          *Location         `json:"location,omitempty"`
+	StructuredAddress StructuredAddress `json:"structuredAddress"`
 }
 
 // AllData is
 type AllData struct {
-	ID        int64     `db:"id"`
-	Name      string    `db:"name"`
-	Address   string    `db:"address"`
-	Contact   string    `db:"contact"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID        int64      `db:"id"`
+	Name      string     `db:"name"`
+	Address   string     `db:"address"`
+	Contact   string     `db:"contact"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	Version   int64      `db:"version"`
+	DeletedAt *time.Time `db:"deleted_at"`
+	ScheduleData
+	LocationData
+	StructuredAddressData
 }
 
 // ToStorage is
 func (p *Request) ToStorage() Data {
 	return Data{
-		Name:    p.Name,
-		Address: p.Address,
-		Contact: p.Contact,
+		Name:                  p.Name,
+		Address:               p.Address,
+		Contact:               p.Contact,
+		ScheduleData:          p.Schedule.ToStorage(),
+		LocationData:          p.Location.ToStorage(),
+		StructuredAddressData: p.StructuredAddress.ToStorage(),
 	}
 }
 
 // ToPVZServer is
 func (p *AllData) ToPVZServer() AllResponse {
 	return AllResponse{
-		ID:        p.ID,
-		Name:      p.Name,
-		Address:   p.Address,
-		Contact:   p.Contact,
-		CreatedAt: p.CreatedAt,
-		UpdatedAt: p.UpdatedAt,
+		ID:                p.ID,
+		Name:              p.Name,
+		Address:           p.Address,
+		Contact:           p.Contact,
+		CreatedAt:         p.CreatedAt,
+		UpdatedAt:         p.UpdatedAt,
+		Version:           p.Version,
+		DeletedAt:         p.DeletedAt,
+		Schedule:          p.ScheduleData.ToServer(),
+		Location:          p.LocationData.ToServer(),
+		StructuredAddress: p.StructuredAddressData.ToServer(),
 	}
 }
 
 // ToStorage is
 func (p *UpdateRequest) ToStorage() UpdateData {
-	return UpdateData{
+	updateData := UpdateData{
 		ID:      p.ID,
 		Name:    p.Name,
 		Address: p.Address,
 		Contact: p.Contact,
+		Version: p.Version,
+	}
+	if p.Schedule != nil {
+		scheduleData := p.Schedule.ToStorage()
+		updateData.Schedule = &scheduleData
+	}
+	if p.Location != nil {
+		locationData := p.Location.ToStorage()
+		updateData.Location = &locationData
 	}
+	if p.StructuredAddress != nil {
+		structuredAddressData := p.StructuredAddress.ToStorage()
+		updateData.StructuredAddress = &structuredAddressData
+	}
+
+	return updateData
 }
 
 // FromCreateGRPC is
 func FromCreateGRPC(pvz *pvz_v1.PVZ) Request {
+	address, structuredAddress := addressFromGRPC(pvz)
+
 	return Request{
-		Name:    pvz.Name,
-		Address: pvz.Address,
-		Contact: pvz.Contact,
+		Name:              pvz.Name,
+		Address:           address,
+		Contact:           pvz.Contact,
+		Schedule:          ScheduleFromGRPC(pvz),
+		Location:          LocationFromGRPC(pvz.Location),
+		StructuredAddress: lo.FromPtr(structuredAddress),
 	}
 }
 
@@ -104,12 +163,19 @@ func InfoToGRPC(allResponse AllResponse) *pvz_v1.PVZAllInfo {
 	return &pvz_v1.PVZAllInfo{
 		ID: allResponse.ID,
 		Pvz: &pvz_v1.PVZ{
-			Name:    allResponse.Name,
-			Address: allResponse.Address,
-			Contact: allResponse.Contact,
+			Name:              allResponse.Name,
+			Address:           allResponse.Address,
+			Contact:           allResponse.Contact,
+			Timezone:          allResponse.Schedule.Timezone,
+			Schedule:          WorkingDaysToGRPC(allResponse.Schedule.Days),
+			Holidays:          allResponse.Schedule.Holidays,
+			Location:          LocationToGRPC(allResponse.Location),
+			StructuredAddress: StructuredAddressToGRPC(allResponse.StructuredAddress),
 		},
 		CreatedAt: timestamppb.New(allResponse.CreatedAt),
 		UpdatedAt: timestamppb.New(allResponse.UpdatedAt),
+		Version:   allResponse.Version,
+		DeletedAt: abstractModel.SafeTimestamp(allResponse.DeletedAt),
 	}
 }
 
@@ -138,10 +204,25 @@ func ListToGRPC(response abstractModel.PaginatedResponse[AllResponse]) *pvz_v1.L
 
 // FromUpdateGRPC is
 func FromUpdateGRPC(pvz *pvz_v1.UpdateRequest) UpdateRequest {
+	schedule := ScheduleFromGRPC(pvz.Pvz)
+	address, structuredAddress := addressFromGRPC(pvz.Pvz)
+
 	return UpdateRequest{
-		ID:      pvz.ID,
-		Name:    &pvz.Pvz.Name,
-		Address: &pvz.Pvz.Address,
-		Contact: &pvz.Pvz.Contact,
+		ID:                pvz.ID,
+		Name:              &pvz.Pvz.Name,
+		Address:           &address,
+		Contact:           &pvz.Pvz.Contact,
+		Schedule:          &schedule,
+		Location:          LocationFromGRPC(pvz.Pvz.Location),
+		Version:           pvz.Version,
+		StructuredAddress: structuredAddress,
+	}
+}
+
+// FromDeleteGRPC is
+func FromDeleteGRPC(request *pvz_v1.DeletePVZRequest) DeleteRequest {
+	return DeleteRequest{
+		ID:      request.PvzID,
+		Version: request.Version,
 	}
 }
@@ -0,0 +1,184 @@
+package pvz
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/samber/lo"
+
+	"Homework-1/pkg/api/pvz_v1"
+	"Homework-1/pkg/constants"
+	"Homework-1/pkg/errlst"
+)
+
+// hoursLayout is the layout of the opening and closing hours of a working day
+const hoursLayout = "15:04"
+
+// dayLayout is the layout of a holiday
+const dayLayout = "2006-01-02"
+
+// WorkingDay is, the PVZ is open on the weekday (0 is Sunday) from Opens to Closes of its local time
+type WorkingDay struct {
+	Weekday int    `json:"weekday" validate:"gte=0,lte=6"`
+	Opens   string `json:"opens" validate:"datetime=15:04"`
+	Closes  string `json:"closes" validate:"datetime=15:04"`
+}
+
+// WorkingDays is stored as JSON
+type WorkingDays []WorkingDay
+
+// Value is
+func (w WorkingDays) Value() (driver.Value, error) {
+	if w == nil {
+		w = WorkingDays{}
+	}
+
+	return json.Marshal(w)
+}
+
+// Scan is
+func (w *WorkingDays) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case []byte:
+		return json.Unmarshal(value, w)
+	case string:
+		return json.Unmarshal([]byte(value), w)
+	case nil:
+		*w = nil
+		return nil
+	default:
+		return fmt.Errorf("working days can not be scanned from %T", src)
+	}
+}
+
+// Schedule is, a PVZ without working days is open every day round the clock.
+// Holidays are the local dates the PVZ is closed on
+type Schedule struct {
+	Timezone string      `json:"timezone" validate:"timezone"`
+	Days     WorkingDays `json:"days" validate:"unique=Weekday,dive"`
+	Holidays []string    `json:"holidays" validate:"dive,datetime=2006-01-02"`
+}
+
+// ScheduleData is
+type ScheduleData struct {
+	Timezone string         `db:"timezone"`
+	Days     WorkingDays    `db:"schedule"`
+	Holidays pq.StringArray `db:"holidays"`
+}
+
+// ToStorage is
+func (s *Schedule) ToStorage() ScheduleData {
+	return ScheduleData{
+		Timezone: timezoneOrDefault(s.Timezone),
+		Days:     s.Days,
+		Holidays: append(pq.StringArray{}, s.Holidays...),
+	}
+}
+
+// ToServer is
+func (s *ScheduleData) ToServer() Schedule {
+	return Schedule{
+		Timezone: s.Timezone,
+		Days:     s.Days,
+		Holidays: s.Holidays,
+	}
+}
+
+// CheckHours is
+func (s *Schedule) CheckHours() error {
+	for _, day := range s.Days {
+		if day.Closes <= day.Opens {
+			return fmt.Errorf("%w: weekday %d", errlst.ErrInvalidWorkingHours, day.Weekday)
+		}
+	}
+
+	return nil
+}
+
+// ExpiresAt is the closing time of the last storage day. The days are counted in the PVZ time zone
+// from the day after receiving and only the days the PVZ is open on count
+func (s *Schedule) ExpiresAt(receivedAt time.Time, storageDays int) (time.Time, error) {
+	if storageDays <= 0 {
+		return receivedAt.AddDate(0, 0, storageDays), nil
+	}
+
+	location, err := time.LoadLocation(timezoneOrDefault(s.Timezone))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("time.LoadLocation: %w", err)
+	}
+
+	holidays := lo.SliceToMap(s.Holidays, func(day string) (string, bool) {
+		return day, true
+	})
+	days := lo.SliceToMap(s.Days, func(day WorkingDay) (time.Weekday, WorkingDay) {
+		return time.Weekday(day.Weekday), day
+	})
+
+	local := receivedAt.In(location)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, location)
+
+	// Every week has an open day unless it is a holiday, so the last storage day is found before the limit
+	limit := (storageDays+len(s.Holidays))*7 + 7
+	for counted, lookahead := 0, 0; lookahead < limit; lookahead++ {
+		day = day.AddDate(0, 0, 1)
+		if holidays[day.Format(dayLayout)] {
+			continue
+		}
+
+		closes := day.AddDate(0, 0, 1)
+		if len(days) > 0 {
+			workingDay, ok := days[day.Weekday()]
+			if !ok {
+				continue
+			}
+
+			hours, err := time.Parse(hoursLayout, workingDay.Closes)
+			if err != nil {
+				return time.Time{}, fmt.Errorf("time.Parse: %w", err)
+			}
+			closes = time.Date(day.Year(), day.Month(), day.Day(), hours.Hour(), hours.Minute(), 0, 0, location)
+		}
+
+		if counted++; counted == storageDays {
+			return closes, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("PVZ is not open for %d days", storageDays)
+}
+
+// timezoneOrDefault is
+func timezoneOrDefault(timezone string) string {
+	if timezone == "" {
+		return constants.DefaultPVZTimezone
+	}
+
+	return timezone
+}
+
+// ScheduleFromGRPC is
+func ScheduleFromGRPC(pvz *pvz_v1.PVZ) Schedule {
+	var days WorkingDays
+	for _, day := range pvz.Schedule {
+		days = append(days, WorkingDay{Weekday: int(day.Weekday), Opens: day.Opens, Closes: day.Closes})
+	}
+
+	return Schedule{
+		Timezone: timezoneOrDefault(pvz.Timezone),
+		Days:     days,
+		Holidays: pvz.Holidays,
+	}
+}
+
+// WorkingDaysToGRPC is
+func WorkingDaysToGRPC(days WorkingDays) []*pvz_v1.WorkingDay {
+	var response []*pvz_v1.WorkingDay
+	for _, day := range days {
+		response = append(response, &pvz_v1.WorkingDay{Weekday: int32(day.Weekday), Opens: day.Opens, Closes: day.Closes})
+	}
+
+	return response
+}
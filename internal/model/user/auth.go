@@ -0,0 +1,55 @@
+package user
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash/fnv"
+)
+
+// AuthCache is a verified login, it lets the next calls of the user skip the bcrypt check
+type AuthCache struct {
+	Username       string      `json:"username"`
+	PasswordDigest string      `json:"passwordDigest"`
+	User           AllResponse `json:"user"`
+}
+
+// AuthCacheID is the cache id of the logins of the username, two usernames may share it
+// so the cached login is only used when its username matches
+func AuthCacheID(username string) int64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(username))
+
+	return int64(hash.Sum64() >> 1)
+}
+
+// PasswordDigest is an HMAC of the credentials keyed with the server secret, so a digest read from the cache
+// can not be brute-forced without the secret
+func PasswordDigest(secret []byte, username, password string) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(username + ":" + password))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Matches tells whether the cached login is the one of the credentials
+func (a *AuthCache) Matches(secret []byte, username, password string) bool {
+	return a.Username == username &&
+		hmac.Equal([]byte(a.PasswordDigest), []byte(PasswordDigest(secret, username, password)))
+}
+
+// contextKey is
+type contextKey struct{}
+
+// NewContext is the context of a call made by the authenticated user
+func NewContext(ctx context.Context, user AllResponse) context.Context {
+	return context.WithValue(ctx, contextKey{}, user)
+}
+
+// FromContext is the authenticated user of the call
+func FromContext(ctx context.Context) (AllResponse, bool) {
+	user, ok := ctx.Value(contextKey{}).(AllResponse)
+
+	return user, ok
+}
@@ -0,0 +1,150 @@
+package user
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+
+	abstractModel "Homework-1/internal/model/abstract"
+	"Homework-1/pkg/api/user_v1"
+)
+
+// Role is
+type Role string
+
+const (
+	// RoleAdmin manages the users and may call every method
+	RoleAdmin Role = "admin"
+	// RoleOperator is
+	RoleOperator Role = "operator"
+	// RolePVZAdmin is
+	RolePVZAdmin Role = "pvz_admin"
+	// RoleCatalogAdmin is
+	RoleCatalogAdmin Role = "catalog_admin"
+	// RoleAuditor is
+	RoleAuditor Role = "auditor"
+)
+
+// Request is, bcrypt only reads the first 72 bytes of a password
+type Request struct {
+	Username string `json:"username" validate:"required,max=100"`
+	Password string `json:"password" validate:"required,min=8,max=72"`
+	Role     Role   `json:"role" validate:"oneof=admin operator pvz_admin catalog_admin auditor"`
+}
+
+// UpdateRequest is, nil fields are left unchanged
+type UpdateRequest struct {
+	ID       int64   `json:"id" validate:"gt=0"`
+	Password *string `json:"password" validate:"omitempty,min=8,max=72"`
+	Role     *Role   `json:"role" validate:"omitempty,oneof=admin operator pvz_admin catalog_admin auditor"`
+}
+
+// Data is
+type Data struct {
+	Username     string `db:"username"`
+	PasswordHash string `db:"password_hash"`
+	Role         Role   `db:"role"`
+}
+
+// UpdateData is
+type UpdateData struct {
+	ID           int64   `db:"id"`
+	PasswordHash *string `db:"password_hash"`
+	Role         *Role   `db:"role"`
+}
+
+// AllData is
+type AllData struct {
+	ID           int64     `db:"id"`
+	Username     string    `db:"username"`
+	PasswordHash string    `db:"password_hash"`
+	Role         Role      `db:"role"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
+}
+
+// AllResponse is, the password hash never leaves the use case
+type AllResponse struct {
+	ID        int64     `json:"id"`
+	Username  string    `json:"username"`
+	Role      Role      `json:"role"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ToStorage is
+func (u *Request) ToStorage(passwordHash string) Data {
+	return Data{
+		Username:     u.Username,
+		PasswordHash: passwordHash,
+		Role:         u.Role,
+	}
+}
+
+// ToStorage is
+func (u *UpdateRequest) ToStorage(passwordHash *string) UpdateData {
+	return UpdateData{
+		ID:           u.ID,
+		PasswordHash: passwordHash,
+		Role:         u.Role,
+	}
+}
+
+// ToServer is
+func (u *AllData) ToServer() AllResponse {
+	return AllResponse{
+		ID:        u.ID,
+		Username:  u.Username,
+		Role:      u.Role,
+		CreatedAt: u.CreatedAt,
+		UpdatedAt: u.UpdatedAt,
+	}
+}
+
+// FromGRPC is
+func FromGRPC(userGRPC *user_v1.User) Request {
+	return Request{
+		Username: userGRPC.GetUsername(),
+		Password: userGRPC.GetPassword(),
+		Role:     Role(userGRPC.GetRole()),
+	}
+}
+
+// FromUpdateGRPC is
+func FromUpdateGRPC(request *user_v1.UserUpdateRequest) UpdateRequest {
+	updateRequest := UpdateRequest{ID: request.GetUserID()}
+	if request.GetPassword() != "" {
+		updateRequest.Password = lo.ToPtr(request.GetPassword())
+	}
+	if request.GetRole() != "" {
+		updateRequest.Role = lo.ToPtr(Role(request.GetRole()))
+	}
+
+	return updateRequest
+}
+
+// InfoToGRPC is
+func InfoToGRPC(allResponse AllResponse) *user_v1.UserAllInfo {
+	return &user_v1.UserAllInfo{
+		ID:        allResponse.ID,
+		Username:  allResponse.Username,
+		Role:      string(allResponse.Role),
+		CreatedAt: abstractModel.SafeTimestamp(&allResponse.CreatedAt),
+		UpdatedAt: abstractModel.SafeTimestamp(&allResponse.UpdatedAt),
+	}
+}
+
+// ListToGRPC is
+func ListToGRPC(response abstractModel.PaginatedResponse[AllResponse]) *user_v1.UserListResponse {
+	return &user_v1.UserListResponse{
+		UserAllInfo: lo.Map(response.Items, func(item AllResponse, _ int) *user_v1.UserAllInfo {
+			return InfoToGRPC(item)
+		}),
+		Pagination: abstractModel.PaginationToGRPC(
+			abstractModel.Page{
+				CurrentPage:  response.CurrentPage,
+				ItemsPerPage: response.ItemsPerPage,
+			},
+			response.TotalItems,
+		)}
+}
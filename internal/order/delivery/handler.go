@@ -4,19 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log"
-	"strconv"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 	grpcCodes "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
-	abstractModel "Homework-1/internal/model/abstract"
 	orderModel "Homework-1/internal/model/order"
 	orderInterface "Homework-1/internal/order"
 	"Homework-1/pkg/api/abstract"
 	"Homework-1/pkg/api/order_v1"
+	"Homework-1/pkg/constants"
 	"Homework-1/pkg/errlst"
 	"Homework-1/pkg/reqvalidator"
 	"Homework-1/pkg/tracing"
@@ -60,6 +60,34 @@ func (o *OrderHandler) ReceiveOrder(ctx context.Context, request *order_v1.Order
 			tracing.EventErrorTracer(span, err, "order already exists")
 			return nil, status.Errorf(grpcCodes.AlreadyExists, fmt.Sprintf("Failed to create: %v", err))
 		}
+		if errors.Is(err, errlst.ErrPVZNotFound) {
+			tracing.EventErrorTracer(span, err, "PVZ not found")
+			return nil, status.Errorf(grpcCodes.NotFound, fmt.Sprintf("Failed to create: %v", err))
+		}
+		if errors.Is(err, errlst.ErrBoxNotFound) {
+			tracing.EventErrorTracer(span, err, "box not found")
+			return nil, status.Errorf(grpcCodes.NotFound, fmt.Sprintf("Failed to create: %v", err))
+		}
+		if errors.Is(err, errlst.ErrPVZFull) {
+			tracing.EventErrorTracer(span, err, "PVZ is full")
+			return nil, status.Errorf(grpcCodes.ResourceExhausted, fmt.Sprintf("Failed to create: %v", err))
+		}
+		if errors.Is(err, errlst.ErrBoxArchived) {
+			tracing.EventErrorTracer(span, err, "box archived")
+			return nil, status.Errorf(grpcCodes.FailedPrecondition, fmt.Sprintf("Failed to create: %v", err))
+		}
+		if errors.Is(err, errlst.ErrPackagingNotCompatible) {
+			tracing.EventErrorTracer(span, err, "packaging not compatible")
+			return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("Failed to create: %v", err))
+		}
+		if errors.Is(err, errlst.ErrOrderDoesNotFit) {
+			tracing.EventErrorTracer(span, err, "order does not fit into the box")
+			return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("Failed to create: %v", err))
+		}
+		if errors.Is(err, errlst.ErrInvalidBoxLimit) || errors.Is(err, errlst.ErrWeightNotPriced) {
+			tracing.EventErrorTracer(span, err, "order rejected by tariff")
+			return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("Failed to create: %v", err))
+		}
 		tracing.EventErrorTracer(span, err, "Internal server error")
 		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to create: %v", err))
 	}
@@ -68,8 +96,69 @@ func (o *OrderHandler) ReceiveOrder(ctx context.Context, request *order_v1.Order
 	return &abstract.MessageResponse{Message: "Successfully Created Order"}, nil
 }
 
+// ReceiveOrdersBatch is, lines that fail validation are reported here and the rest of the manifest goes to the use case
+func (o *OrderHandler) ReceiveOrdersBatch(stream order_v1.OrderService_ReceiveOrdersBatchServer) error {
+	log.Print("[order][delivery][ReceiveOrdersBatch]")
+	tracer := otel.Tracer("[order][delivery]")
+	ctx, span := tracer.Start(stream.Context(), "[ReceiveOrdersBatch]")
+	defer span.End()
+
+	var (
+		batchReq orderModel.BatchRequest
+		invalid  []orderModel.BatchLineResult
+	)
+
+	for line := int64(1); ; line++ {
+		request, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			tracing.EventErrorTracer(span, err, "Failed to read manifest")
+			return status.Errorf(grpcCodes.Canceled, fmt.Sprintf("Failed to read manifest: %v", err))
+		}
+
+		if line > constants.ReceiveBatchMaxLines {
+			tracing.EventErrorTracer(span, errlst.ErrManifestTooLarge, "Manifest is too large")
+			return status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("Error: %v", errlst.ErrManifestTooLarge))
+		}
+
+		batchReq.Strict = batchReq.Strict || request.GetStrict()
+		orderReq := orderModel.FromCreateGRPC(request.GetLine())
+
+		if err := reqvalidator.ValidateRequest(orderReq); err != nil {
+			invalid = append(invalid, orderModel.BatchLineResult{
+				Line:    line,
+				OrderID: orderReq.OrderID,
+				Code:    orderModel.BatchCodeInvalid,
+				Message: err.Error(),
+			})
+			continue
+		}
+
+		batchReq.Lines = append(batchReq.Lines, orderModel.BatchLine{Line: line, Request: orderReq})
+	}
+
+	var response orderModel.BatchResponse
+	if len(invalid) > 0 && batchReq.Strict {
+		response = orderModel.NotProcessed(batchReq.Lines)
+	} else if len(batchReq.Lines) > 0 {
+		var err error
+		response, err = o.useCase.ReceiveOrdersBatch(ctx, batchReq)
+		if err != nil {
+			tracing.EventErrorTracer(span, err, "Internal server error")
+			return status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to receive batch: %v", err))
+		}
+	}
+
+	response.AddInvalid(invalid)
+
+	span.SetStatus(codes.Ok, "Successfully received batch of orders")
+	return stream.SendAndClose(orderModel.BatchToGRPC(response))
+}
+
 // IssueOrder is
-func (o *OrderHandler) IssueOrder(ctx context.Context, request *order_v1.IssueOrderRequest) (*abstract.MessageResponse, error) {
+func (o *OrderHandler) IssueOrder(ctx context.Context, request *order_v1.IssueOrderRequest) (*order_v1.IssueOrderResponse, error) {
 	log.Printf("[order][delivery][IssueOrder]")
 	tracer := otel.Tracer("[order][delivery]")
 	ctx, span := tracer.Start(ctx, "[IssueOrder]")
@@ -82,26 +171,57 @@ func (o *OrderHandler) IssueOrder(ctx context.Context, request *order_v1.IssueOr
 		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("reqvalidator.ReadRequest: %v", err))
 	}
 
-	totalAdditionalCost, err := o.useCase.IssueOrders(ctx, issueReq)
+	issueResponse, err := o.useCase.IssueOrders(ctx, issueReq)
 	if err != nil {
 		if errors.Is(err, errlst.ErrOrderNotFound) {
 			tracing.EventErrorTracer(span, err, "order not found")
 			return nil, status.Errorf(grpcCodes.NotFound, fmt.Sprintf("Failed to find: %v", err))
 		}
+		if errors.Is(err, errlst.ErrInvalidStatusTransition) ||
+			errors.Is(err, errlst.ErrOrderExpired) ||
+			errors.Is(err, errlst.ErrClientIDNotFound) {
+			tracing.EventErrorTracer(span, err, "order can not be issued")
+			return nil, status.Errorf(grpcCodes.FailedPrecondition, fmt.Sprintf("Failed to issue: %v", err))
+		}
 		tracing.EventErrorTracer(span, err, "Internal server error")
 		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to find: %v", err))
 	}
 	span.SetStatus(codes.Ok, "Successfully issued Orders")
-	return &abstract.MessageResponse{Message: "Successfully Issued All OrderIDs and Total additional cost:" + strconv.FormatFloat(totalAdditionalCost, 'f', -1, 64)}, nil
+	return orderModel.IssueToGRPC(issueResponse), nil
+}
+
+// QuoteIssue is
+func (o *OrderHandler) QuoteIssue(ctx context.Context, request *order_v1.IssueOrderRequest) (*order_v1.QuoteIssueResponse, error) {
+	log.Printf("[order][delivery][QuoteIssue]")
+	tracer := otel.Tracer("[order][delivery]")
+	ctx, span := tracer.Start(ctx, "[QuoteIssue]")
+	defer span.End()
+
+	quoteReq := orderModel.FromIssueGRPC(request)
+
+	err := reqvalidator.ValidateRequest(&quoteReq)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("reqvalidator.ReadRequest: %v", err))
+	}
+
+	quoteResponse, err := o.useCase.QuoteIssue(ctx, quoteReq)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to quote: %v", err))
+	}
+
+	span.SetStatus(codes.Ok, "Successfully quoted Orders")
+	return orderModel.QuoteToGRPC(quoteResponse), nil
 }
 
 // ReturnedOrders is
-func (o *OrderHandler) ReturnedOrders(ctx context.Context, request *abstract.Page) (*order_v1.ReturnedListResponse, error) {
+func (o *OrderHandler) ReturnedOrders(ctx context.Context, request *order_v1.OrderListRequest) (*order_v1.ReturnedListResponse, error) {
 	log.Printf("[order][delivery][ReturnedOrders]")
 	tracer := otel.Tracer("[order][delivery]")
 	ctx, span := tracer.Start(ctx, "[ReturnedOrders]")
 	defer span.End()
-	page := abstractModel.PageFromGRPC(request)
+	page := orderModel.FromListGRPC(request)
 
 	err := reqvalidator.ValidateRequest(page)
 	if err != nil {
@@ -138,6 +258,22 @@ func (o *OrderHandler) AcceptOrder(ctx context.Context, request *order_v1.Reques
 			tracing.EventErrorTracer(span, err, "order not found")
 			return nil, status.Errorf(grpcCodes.NotFound, fmt.Sprintf("Failed to find order: %v", err))
 		}
+		if errors.Is(err, errlst.ErrWrongClient) {
+			tracing.EventErrorTracer(span, err, "wrong client")
+			return nil, status.Errorf(grpcCodes.PermissionDenied, fmt.Sprintf("Failed to accept order: %v", err))
+		}
+		if errors.Is(err, errlst.ErrOrderNotIssued) || errors.Is(err, errlst.ErrReturnWindowElapsed) {
+			tracing.EventErrorTracer(span, err, "order can not be returned")
+			return nil, status.Errorf(grpcCodes.FailedPrecondition, fmt.Sprintf("Failed to accept order: %v", err))
+		}
+		if errors.Is(err, errlst.ErrPVZFull) {
+			tracing.EventErrorTracer(span, err, "PVZ is full")
+			return nil, status.Errorf(grpcCodes.ResourceExhausted, fmt.Sprintf("Failed to accept order: %v", err))
+		}
+		if errors.Is(err, errlst.ErrInvalidStatusTransition) {
+			tracing.EventErrorTracer(span, err, "invalid status transition")
+			return nil, status.Errorf(grpcCodes.FailedPrecondition, fmt.Sprintf("Failed to accept order: %v", err))
+		}
 		tracing.EventErrorTracer(span, err, "Internal server error")
 		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to accept order: %v", err))
 	}
@@ -158,12 +294,24 @@ func (o *OrderHandler) TurnInOrder(ctx context.Context, request *order_v1.OrderI
 		return nil, status.Errorf(grpcCodes.InvalidArgument, "invalid request id")
 	}
 
-	err := o.useCase.DeleteReturnedOrder(ctx, request.OrderID)
+	turnInRequest := orderModel.FromIDGRPC(request)
+
+	err := reqvalidator.ValidateRequest(turnInRequest)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("reqvalidator.ReadRequest: %v", err))
+	}
+
+	err = o.useCase.DeleteReturnedOrder(ctx, turnInRequest)
 	if err != nil {
 		if errors.Is(err, errlst.ErrOrderNotFound) {
 			tracing.EventErrorTracer(span, err, "order not found")
 			return nil, status.Errorf(grpcCodes.NotFound, fmt.Sprintf("Failed to find order: %v", err))
 		}
+		if errors.Is(err, errlst.ErrInvalidStatusTransition) {
+			tracing.EventErrorTracer(span, err, "invalid status transition")
+			return nil, status.Errorf(grpcCodes.FailedPrecondition, fmt.Sprintf("Failed to turn in order: %v", err))
+		}
 		tracing.EventErrorTracer(span, err, "Internal server error")
 		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to turn in order: %v", err))
 	}
@@ -172,13 +320,13 @@ func (o *OrderHandler) TurnInOrder(ctx context.Context, request *order_v1.OrderI
 }
 
 // OrderList is
-func (o *OrderHandler) OrderList(ctx context.Context, request *abstract.Page) (*order_v1.OrderListResponse, error) {
+func (o *OrderHandler) OrderList(ctx context.Context, request *order_v1.OrderListRequest) (*order_v1.OrderListResponse, error) {
 	log.Printf("[order][delivery][OrderList]")
 	tracer := otel.Tracer("[order][delivery]")
 	ctx, span := tracer.Start(ctx, "[OrderList]")
 	defer span.End()
 
-	page := abstractModel.PageFromGRPC(request)
+	page := orderModel.FromListGRPC(request)
 
 	err := reqvalidator.ValidateRequest(page)
 	if err != nil {
@@ -197,13 +345,13 @@ func (o *OrderHandler) OrderList(ctx context.Context, request *abstract.Page) (*
 }
 
 // UniqueClientList is
-func (o *OrderHandler) UniqueClientList(ctx context.Context, request *abstract.Page) (*order_v1.UniqueClientListResponse, error) {
+func (o *OrderHandler) UniqueClientList(ctx context.Context, request *order_v1.OrderListRequest) (*order_v1.UniqueClientListResponse, error) {
 	log.Printf("[order][delivery][UniqueClientsList]")
 	tracer := otel.Tracer("[order][delivery]")
 	ctx, span := tracer.Start(ctx, "[UniqueClientList]")
 	defer span.End()
 
-	clientPagination := abstractModel.PageFromGRPC(request)
+	clientPagination := orderModel.FromListGRPC(request)
 
 	err := reqvalidator.ValidateRequest(&clientPagination)
 	if err != nil {
@@ -221,3 +369,61 @@ func (o *OrderHandler) UniqueClientList(ctx context.Context, request *abstract.P
 
 	return orderModel.UniqueClientListToGRPC(listUniqueClients), nil
 }
+
+// GetOrderByID is
+func (o *OrderHandler) GetOrderByID(ctx context.Context, request *order_v1.OrderIDRequest) (*order_v1.OrderDetails, error) {
+	log.Printf("[order][delivery][GetOrderByID]")
+	tracer := otel.Tracer("[order][delivery]")
+	ctx, span := tracer.Start(ctx, "[GetOrderByID]")
+	defer span.End()
+
+	orderRequest := orderModel.FromIDGRPC(request)
+
+	err := reqvalidator.ValidateRequest(&orderRequest)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("reqvalidator.ReadRequest: %v", err))
+	}
+
+	orderDetails, err := o.useCase.GetOrderByID(ctx, orderRequest)
+	if err != nil {
+		if errors.Is(err, errlst.ErrOrderNotFound) {
+			tracing.EventErrorTracer(span, err, "order not found")
+			return nil, status.Errorf(grpcCodes.NotFound, fmt.Sprintf("Failed to find order: %v", err))
+		}
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to get order: %v", err))
+	}
+
+	span.SetStatus(codes.Ok, "Successfully got order")
+	return orderModel.DetailsToGRPC(orderDetails), nil
+}
+
+// GetOrderHistory is
+func (o *OrderHandler) GetOrderHistory(ctx context.Context, request *order_v1.OrderIDRequest) (*order_v1.OrderHistoryResponse, error) {
+	log.Printf("[order][delivery][GetOrderHistory]")
+	tracer := otel.Tracer("[order][delivery]")
+	ctx, span := tracer.Start(ctx, "[GetOrderHistory]")
+	defer span.End()
+
+	orderRequest := orderModel.FromIDGRPC(request)
+
+	err := reqvalidator.ValidateRequest(&orderRequest)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("reqvalidator.ReadRequest: %v", err))
+	}
+
+	orderHistory, err := o.useCase.GetOrderHistory(ctx, orderRequest)
+	if err != nil {
+		if errors.Is(err, errlst.ErrOrderNotFound) {
+			tracing.EventErrorTracer(span, err, "order not found")
+			return nil, status.Errorf(grpcCodes.NotFound, fmt.Sprintf("Failed to find order: %v", err))
+		}
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to get order history: %v", err))
+	}
+
+	span.SetStatus(codes.Ok, "Successfully got order history")
+	return orderModel.HistoryToGRPC(orderHistory), nil
+}
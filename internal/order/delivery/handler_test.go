@@ -2,20 +2,25 @@ package delivery
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"testing"
 	"time"
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	abstractModel "Homework-1/internal/model/abstract"
+	boxModel "Homework-1/internal/model/box"
 	orderModel "Homework-1/internal/model/order"
 	"Homework-1/internal/order"
 	orderMock "Homework-1/internal/order/mock"
 	"Homework-1/pkg/api/abstract"
+	"Homework-1/pkg/api/box_v1"
 	"Homework-1/pkg/api/order_v1"
 	"Homework-1/pkg/errlst"
 )
@@ -41,6 +46,7 @@ func TestOrderHandler_ReceiveOrder(t *testing.T) {
 					ClientID: 2,
 					Weight:   9,
 					BoxID:    3,
+					PvzID:    4,
 				},
 				ExpireTimeDuration: 30,
 			},
@@ -55,6 +61,8 @@ func TestOrderHandler_ReceiveOrder(t *testing.T) {
 						ClientID:           2,
 						Weight:             9,
 						BoxID:              3,
+						PVZID:              4,
+						Packaging:          []int64{3},
 					}).Then(nil),
 		},
 		{
@@ -65,6 +73,7 @@ func TestOrderHandler_ReceiveOrder(t *testing.T) {
 					ClientID: 2,
 					Weight:   9,
 					BoxID:    3,
+					PvzID:    4,
 				},
 				ExpireTimeDuration: 30,
 			},
@@ -79,8 +88,271 @@ func TestOrderHandler_ReceiveOrder(t *testing.T) {
 						ClientID:           2,
 						Weight:             9,
 						BoxID:              3,
+						PVZID:              4,
+						Packaging:          []int64{3},
 					}).Then(errlst.ErrOrderAlreadyExists),
 		},
+		{
+			description: "PVZ not found",
+			requestBody: order_v1.OrderCreateRequest{
+				Order: &order_v1.Order{
+					OrderID:  1,
+					ClientID: 2,
+					Weight:   9,
+					BoxID:    3,
+					PvzID:    4,
+				},
+				ExpireTimeDuration: 30,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.NotFound, "Failed to create: PVZ not found"),
+			useCase: orderMock.NewUseCaseMock(ctrl).CreateReceiveOrderMock.
+				When(
+					minimock.AnyContext,
+					orderModel.Request{
+						ExpireTimeDuration: 30,
+						OrderID:            1,
+						ClientID:           2,
+						Weight:             9,
+						BoxID:              3,
+						PVZID:              4,
+						Packaging:          []int64{3},
+					}).Then(errlst.ErrPVZNotFound),
+		},
+		{
+			description: "Box not found",
+			requestBody: order_v1.OrderCreateRequest{
+				Order: &order_v1.Order{
+					OrderID:  1,
+					ClientID: 2,
+					Weight:   9,
+					BoxID:    3,
+					PvzID:    4,
+				},
+				ExpireTimeDuration: 30,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.NotFound, "Failed to create: Box not found"),
+			useCase: orderMock.NewUseCaseMock(ctrl).CreateReceiveOrderMock.
+				When(
+					minimock.AnyContext,
+					orderModel.Request{
+						ExpireTimeDuration: 30,
+						OrderID:            1,
+						ClientID:           2,
+						Weight:             9,
+						BoxID:              3,
+						PVZID:              4,
+						Packaging:          []int64{3},
+					}).Then(errlst.ErrBoxNotFound),
+		},
+		{
+			description: "PVZ has no free cell",
+			requestBody: order_v1.OrderCreateRequest{
+				Order: &order_v1.Order{
+					OrderID:  1,
+					ClientID: 2,
+					Weight:   9,
+					BoxID:    3,
+					PvzID:    4,
+				},
+				ExpireTimeDuration: 30,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.ResourceExhausted, "Failed to create: PVZ has no free cell for the order"),
+			useCase: orderMock.NewUseCaseMock(ctrl).CreateReceiveOrderMock.
+				When(
+					minimock.AnyContext,
+					orderModel.Request{
+						ExpireTimeDuration: 30,
+						OrderID:            1,
+						ClientID:           2,
+						Weight:             9,
+						BoxID:              3,
+						PVZID:              4,
+						Packaging:          []int64{3},
+					}).Then(errlst.ErrPVZFull),
+		},
+		{
+			description: "Order does not fit the box",
+			requestBody: order_v1.OrderCreateRequest{
+				Order: &order_v1.Order{
+					OrderID:  1,
+					ClientID: 2,
+					Weight:   9,
+					BoxID:    3,
+					PvzID:    4,
+				},
+				ExpireTimeDuration: 30,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.InvalidArgument, "Failed to create: Exceeding box_v1 limit"),
+			useCase: orderMock.NewUseCaseMock(ctrl).CreateReceiveOrderMock.
+				When(
+					minimock.AnyContext,
+					orderModel.Request{
+						ExpireTimeDuration: 30,
+						OrderID:            1,
+						ClientID:           2,
+						Weight:             9,
+						BoxID:              3,
+						PVZID:              4,
+						Packaging:          []int64{3},
+					}).Then(errlst.ErrInvalidBoxLimit),
+		},
+		{
+			description: "Successfully created order in several packaging layers",
+			requestBody: order_v1.OrderCreateRequest{
+				Order: &order_v1.Order{
+					OrderID:   1,
+					ClientID:  2,
+					Weight:    9,
+					PvzID:     4,
+					Packaging: []int64{3, 1},
+				},
+				ExpireTimeDuration: 30,
+			},
+			wantResp: &abstract.MessageResponse{Message: "Successfully Created Order"},
+			wantErr:  nil,
+			useCase: orderMock.NewUseCaseMock(ctrl).CreateReceiveOrderMock.
+				When(
+					minimock.AnyContext,
+					orderModel.Request{
+						ExpireTimeDuration: 30,
+						OrderID:            1,
+						ClientID:           2,
+						Weight:             9,
+						BoxID:              1,
+						PVZID:              4,
+						Packaging:          []int64{3, 1},
+					}).Then(nil),
+		},
+		{
+			description: "Packaging layer can not wrap the inner one",
+			requestBody: order_v1.OrderCreateRequest{
+				Order: &order_v1.Order{
+					OrderID:   1,
+					ClientID:  2,
+					Weight:    9,
+					PvzID:     4,
+					Packaging: []int64{2, 1},
+				},
+				ExpireTimeDuration: 30,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.InvalidArgument, "Failed to create: Packaging layer can not wrap the layer inside it"),
+			useCase: orderMock.NewUseCaseMock(ctrl).CreateReceiveOrderMock.
+				When(
+					minimock.AnyContext,
+					orderModel.Request{
+						ExpireTimeDuration: 30,
+						OrderID:            1,
+						ClientID:           2,
+						Weight:             9,
+						BoxID:              1,
+						PVZID:              4,
+						Packaging:          []int64{2, 1},
+					}).Then(errlst.ErrPackagingNotCompatible),
+		},
+		{
+			description: "Box is archived",
+			requestBody: order_v1.OrderCreateRequest{
+				Order: &order_v1.Order{
+					OrderID:  1,
+					ClientID: 2,
+					Weight:   9,
+					BoxID:    3,
+					PvzID:    4,
+				},
+				ExpireTimeDuration: 30,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.FailedPrecondition, "Failed to create: Box is archived"),
+			useCase: orderMock.NewUseCaseMock(ctrl).CreateReceiveOrderMock.
+				When(
+					minimock.AnyContext,
+					orderModel.Request{
+						ExpireTimeDuration: 30,
+						OrderID:            1,
+						ClientID:           2,
+						Weight:             9,
+						BoxID:              3,
+						PVZID:              4,
+						Packaging:          []int64{3},
+					}).Then(errlst.ErrBoxArchived),
+		},
+		{
+			description: "Order does not fit into the box",
+			requestBody: order_v1.OrderCreateRequest{
+				Order: &order_v1.Order{
+					OrderID:    1,
+					ClientID:   2,
+					Weight:     9,
+					BoxID:      3,
+					PvzID:      4,
+					Dimensions: &abstract.Dimensions{Length: 30, Width: 20, Height: 50},
+				},
+				ExpireTimeDuration: 30,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.InvalidArgument, "Failed to create: Order does not fit into the box 3: height 50 exceeds 40"),
+			useCase: orderMock.NewUseCaseMock(ctrl).CreateReceiveOrderMock.
+				When(
+					minimock.AnyContext,
+					orderModel.Request{
+						ExpireTimeDuration: 30,
+						OrderID:            1,
+						ClientID:           2,
+						Weight:             9,
+						BoxID:              3,
+						PVZID:              4,
+						Packaging:          []int64{3},
+						Dimensions:         abstractModel.Dimensions{Length: 30, Width: 20, Height: 50},
+					}).Then(fmt.Errorf("%w 3: height 50 exceeds 40", errlst.ErrOrderDoesNotFit)),
+		},
+		{
+			description: "Packaging with an invalid box id",
+			requestBody: order_v1.OrderCreateRequest{
+				Order: &order_v1.Order{
+					OrderID:   1,
+					ClientID:  2,
+					Weight:    9,
+					PvzID:     4,
+					Packaging: []int64{0, 1},
+				},
+				ExpireTimeDuration: 30,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ReadRequest: Key: 'Request.Packaging[0]' Error:Field validation for 'Packaging[0]' failed on the 'gt' tag"),
+			useCase:  orderMock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Order weight is not priced by the tariff",
+			requestBody: order_v1.OrderCreateRequest{
+				Order: &order_v1.Order{
+					OrderID:  1,
+					ClientID: 2,
+					Weight:   9,
+					BoxID:    3,
+					PvzID:    4,
+				},
+				ExpireTimeDuration: 30,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.InvalidArgument, "Failed to create: Order weight is outside of the tariff weight brackets"),
+			useCase: orderMock.NewUseCaseMock(ctrl).CreateReceiveOrderMock.
+				When(
+					minimock.AnyContext,
+					orderModel.Request{
+						ExpireTimeDuration: 30,
+						OrderID:            1,
+						ClientID:           2,
+						Weight:             9,
+						BoxID:              3,
+						PVZID:              4,
+						Packaging:          []int64{3},
+					}).Then(errlst.ErrWeightNotPriced),
+		},
 		{
 			description: "Internal Server error",
 			requestBody: order_v1.OrderCreateRequest{
@@ -89,6 +361,7 @@ func TestOrderHandler_ReceiveOrder(t *testing.T) {
 					ClientID: 2,
 					Weight:   9,
 					BoxID:    3,
+					PvzID:    4,
 				},
 				ExpireTimeDuration: 30,
 			},
@@ -103,6 +376,8 @@ func TestOrderHandler_ReceiveOrder(t *testing.T) {
 						ClientID:           2,
 						Weight:             9,
 						BoxID:              3,
+						PVZID:              4,
+						Packaging:          []int64{3},
 					}).Then(assert.AnError),
 		},
 		{
@@ -113,6 +388,7 @@ func TestOrderHandler_ReceiveOrder(t *testing.T) {
 					ClientID: 2,
 					Weight:   9,
 					BoxID:    3,
+					PvzID:    4,
 				},
 			},
 			wantResp: nil,
@@ -132,6 +408,158 @@ func TestOrderHandler_ReceiveOrder(t *testing.T) {
 	}
 }
 
+// receiveBatchStream is a manifest sent by a courier
+type receiveBatchStream struct {
+	grpc.ServerStream
+	requests []*order_v1.ReceiveOrdersBatchRequest
+	response *order_v1.ReceiveOrdersBatchResponse
+}
+
+// Context is
+func (s *receiveBatchStream) Context() context.Context {
+	return context.Background()
+}
+
+// Recv is
+func (s *receiveBatchStream) Recv() (*order_v1.ReceiveOrdersBatchRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	request := s.requests[0]
+	s.requests = s.requests[1:]
+	return request, nil
+}
+
+// SendAndClose is
+func (s *receiveBatchStream) SendAndClose(response *order_v1.ReceiveOrdersBatchResponse) error {
+	s.response = response
+	return nil
+}
+
+// TestOrderHandler_ReceiveOrdersBatch is
+func TestOrderHandler_ReceiveOrdersBatch(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	validLine := func(orderID int64) *order_v1.OrderCreateRequest {
+		return &order_v1.OrderCreateRequest{
+			Order: &order_v1.Order{
+				OrderID:  orderID,
+				ClientID: 2,
+				Weight:   9,
+				BoxID:    3,
+				PvzID:    4,
+			},
+			ExpireTimeDuration: 30,
+		}
+	}
+	invalidLine := &order_v1.OrderCreateRequest{
+		Order: &order_v1.Order{
+			OrderID:  3,
+			ClientID: 2,
+			Weight:   9,
+			BoxID:    3,
+			PvzID:    4,
+		},
+	}
+	validRequest := func(orderID int64) orderModel.Request {
+		return orderModel.Request{
+			ExpireTimeDuration: 30,
+			OrderID:            orderID,
+			ClientID:           2,
+			Weight:             9,
+			BoxID:              3,
+			PVZID:              4,
+			Packaging:          []int64{3},
+		}
+	}
+	invalidMessage := "Key: 'Request.ExpireTimeDuration' Error:Field validation for 'ExpireTimeDuration' failed on the 'required' tag"
+
+	tests := []*struct {
+		description string
+		requests    []*order_v1.ReceiveOrdersBatchRequest
+		wantResp    *order_v1.ReceiveOrdersBatchResponse
+		wantErr     error
+		useCase     order.UseCase
+	}{
+		{
+			description: "Successfully received manifest with an invalid line",
+			requests: []*order_v1.ReceiveOrdersBatchRequest{
+				{Line: validLine(1)},
+				{Line: invalidLine},
+				{Line: validLine(2)},
+			},
+			wantResp: &order_v1.ReceiveOrdersBatchResponse{
+				Results: []*order_v1.ReceiveOrderResult{
+					{Line: 1, OrderID: 1, Code: orderModel.BatchCodeReceived},
+					{Line: 2, OrderID: 3, Code: orderModel.BatchCodeInvalid, Message: invalidMessage},
+					{Line: 3, OrderID: 2, Code: "already_exists", Message: "Order already exists"},
+				},
+				Received: 1,
+				Failed:   2,
+			},
+			wantErr: nil,
+			useCase: orderMock.NewUseCaseMock(ctrl).ReceiveOrdersBatchMock.
+				When(minimock.AnyContext, orderModel.BatchRequest{
+					Lines: []orderModel.BatchLine{
+						{Line: 1, Request: validRequest(1)},
+						{Line: 3, Request: validRequest(2)},
+					},
+				}).
+				Then(orderModel.BatchResponse{
+					Results: []orderModel.BatchLineResult{
+						{Line: 1, OrderID: 1, Code: orderModel.BatchCodeReceived},
+						{Line: 3, OrderID: 2, Code: "already_exists", Message: "Order already exists"},
+					},
+					Received: 1,
+					Failed:   1,
+				}, nil),
+		},
+		{
+			description: "Strict manifest with an invalid line is not processed",
+			requests: []*order_v1.ReceiveOrdersBatchRequest{
+				{Line: validLine(1), Strict: true},
+				{Line: invalidLine},
+			},
+			wantResp: &order_v1.ReceiveOrdersBatchResponse{
+				Results: []*order_v1.ReceiveOrderResult{
+					{Line: 1, OrderID: 1, Code: orderModel.BatchCodeNotProcessed},
+					{Line: 2, OrderID: 3, Code: orderModel.BatchCodeInvalid, Message: invalidMessage},
+				},
+				Received: 0,
+				Failed:   2,
+			},
+			wantErr: nil,
+			useCase: orderMock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Internal server error",
+			requests: []*order_v1.ReceiveOrdersBatchRequest{
+				{Line: validLine(1)},
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.Internal, "Failed to receive batch: assert.AnError general error for testing"),
+			useCase: orderMock.NewUseCaseMock(ctrl).ReceiveOrdersBatchMock.
+				When(minimock.AnyContext, orderModel.BatchRequest{
+					Lines: []orderModel.BatchLine{{Line: 1, Request: validRequest(1)}},
+				}).
+				Then(orderModel.BatchResponse{}, assert.AnError),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			stream := &receiveBatchStream{requests: tt.requests}
+			err := NewOrdersHandler(tt.useCase).ReceiveOrdersBatch(stream)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantResp, stream.response)
+		})
+	}
+}
+
 // TestOrderHandler_AcceptOrder is
 func TestOrderHandler_AcceptOrder(t *testing.T) {
 	t.Parallel()
@@ -150,6 +578,7 @@ func TestOrderHandler_AcceptOrder(t *testing.T) {
 			requestBody: order_v1.RequestWithClientID{
 				OrderID:  1,
 				ClientID: 2,
+				PvzID:    3,
 			},
 			wantResp: &abstract.MessageResponse{Message: "Successfully Accepted Order ID with Client ID"},
 			wantErr:  nil,
@@ -159,6 +588,7 @@ func TestOrderHandler_AcceptOrder(t *testing.T) {
 					orderModel.RequestWithClientID{
 						OrderID:  1,
 						ClientID: 2,
+						PVZID:    3,
 					}).Then(nil),
 		},
 		{
@@ -166,6 +596,7 @@ func TestOrderHandler_AcceptOrder(t *testing.T) {
 			requestBody: order_v1.RequestWithClientID{
 				OrderID:  1,
 				ClientID: 2,
+				PvzID:    3,
 			},
 			wantResp: nil,
 			wantErr:  status.Errorf(codes.NotFound, "Failed to find order: Order not found"),
@@ -175,13 +606,105 @@ func TestOrderHandler_AcceptOrder(t *testing.T) {
 					orderModel.RequestWithClientID{
 						OrderID:  1,
 						ClientID: 2,
+						PVZID:    3,
 					}).Then(errlst.ErrOrderNotFound),
 		},
+		{
+			description: "PVZ has no free cell for the returned order",
+			requestBody: order_v1.RequestWithClientID{
+				OrderID:  1,
+				ClientID: 2,
+				PvzID:    3,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.ResourceExhausted, "Failed to accept order: "+errlst.ErrPVZFull.Error()),
+			useCase: orderMock.NewUseCaseMock(ctrl).UpdateAcceptOrderMock.
+				When(
+					minimock.AnyContext,
+					orderModel.RequestWithClientID{
+						OrderID:  1,
+						ClientID: 2,
+						PVZID:    3,
+					}).Then(errlst.ErrPVZFull),
+		},
+		{
+			description: "Order belongs to another client",
+			requestBody: order_v1.RequestWithClientID{
+				OrderID:  1,
+				ClientID: 2,
+				PvzID:    3,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.PermissionDenied, "Failed to accept order: Order belongs to another client"),
+			useCase: orderMock.NewUseCaseMock(ctrl).UpdateAcceptOrderMock.
+				When(
+					minimock.AnyContext,
+					orderModel.RequestWithClientID{
+						OrderID:  1,
+						ClientID: 2,
+						PVZID:    3,
+					}).Then(errlst.ErrWrongClient),
+		},
+		{
+			description: "Order has not been issued yet",
+			requestBody: order_v1.RequestWithClientID{
+				OrderID:  1,
+				ClientID: 2,
+				PvzID:    3,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.FailedPrecondition, "Failed to accept order: Order has not been issued yet"),
+			useCase: orderMock.NewUseCaseMock(ctrl).UpdateAcceptOrderMock.
+				When(
+					minimock.AnyContext,
+					orderModel.RequestWithClientID{
+						OrderID:  1,
+						ClientID: 2,
+						PVZID:    3,
+					}).Then(errlst.ErrOrderNotIssued),
+		},
+		{
+			description: "Return window has elapsed",
+			requestBody: order_v1.RequestWithClientID{
+				OrderID:  1,
+				ClientID: 2,
+				PvzID:    3,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.FailedPrecondition, "Failed to accept order: Return window has elapsed"),
+			useCase: orderMock.NewUseCaseMock(ctrl).UpdateAcceptOrderMock.
+				When(
+					minimock.AnyContext,
+					orderModel.RequestWithClientID{
+						OrderID:  1,
+						ClientID: 2,
+						PVZID:    3,
+					}).Then(errlst.ErrReturnWindowElapsed),
+		},
+		{
+			description: "Order was already returned",
+			requestBody: order_v1.RequestWithClientID{
+				OrderID:  1,
+				ClientID: 2,
+				PvzID:    3,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.FailedPrecondition, "Failed to accept order: Invalid order status transition: returned_by_client -> returned_by_client"),
+			useCase: orderMock.NewUseCaseMock(ctrl).UpdateAcceptOrderMock.
+				When(
+					minimock.AnyContext,
+					orderModel.RequestWithClientID{
+						OrderID:  1,
+						ClientID: 2,
+						PVZID:    3,
+					}).Then(&orderModel.TransitionError{From: orderModel.StatusReturnedByClient, To: orderModel.StatusReturnedByClient}),
+		},
 		{
 			description: "Internal Server error",
 			requestBody: order_v1.RequestWithClientID{
 				OrderID:  1,
 				ClientID: 2,
+				PvzID:    3,
 			},
 			wantResp: nil,
 			wantErr:  status.Errorf(codes.Internal, "Failed to accept order: assert.AnError general error for testing"),
@@ -191,12 +714,14 @@ func TestOrderHandler_AcceptOrder(t *testing.T) {
 					orderModel.RequestWithClientID{
 						OrderID:  1,
 						ClientID: 2,
+						PVZID:    3,
 					}).Then(assert.AnError),
 		},
 		{
 			description: "Request validation failed",
 			requestBody: order_v1.RequestWithClientID{
 				OrderID: 1,
+				PvzID:   3,
 			},
 			wantResp: nil,
 			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ReadRequest: Key: 'RequestWithClientID.ClientID' Error:Field validation for 'ClientID' failed on the 'required' tag"),
@@ -215,77 +740,294 @@ func TestOrderHandler_AcceptOrder(t *testing.T) {
 	}
 }
 
-// TestOrderHandler_IssueOrder is
-func TestOrderHandler_IssueOrder(t *testing.T) {
+// TestOrderHandler_IssueOrder is
+func TestOrderHandler_IssueOrder(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	ctrl := minimock.NewController(t)
+
+	tests := []*struct {
+		description string
+		requestBody order_v1.IssueOrderRequest
+		wantResp    *order_v1.IssueOrderResponse
+		wantErr     error
+		useCase     order.UseCase
+	}{
+		{
+			description: "Successfully issued orders",
+			requestBody: order_v1.IssueOrderRequest{
+				OrderIDRequest: []*order_v1.OrderIDRequest{
+					{OrderID: 1},
+					{OrderID: 2},
+				},
+				PvzID: 3,
+			},
+			wantResp: &order_v1.IssueOrderResponse{
+				ClientID: 4,
+				Lines: []*order_v1.IssueOrderLine{
+					{
+						OrderID: 1, BoxID: 1, BoxName: "package", PackagingCost: 10, Weight: 2, WeightCost: 5, StorageFee: 3, Cost: 18,
+						PackagingCostAmount: &abstract.Money{Units: 1000, Currency: "RUB"},
+						WeightCostAmount:    &abstract.Money{Units: 500, Currency: "RUB"},
+						StorageFeeAmount:    &abstract.Money{Units: 300, Currency: "RUB"},
+						CostAmount:          &abstract.Money{Units: 1800, Currency: "RUB"},
+						Packaging: []*order_v1.PackagingLayer{
+							{BoxID: 3, BoxName: "textile", Cost: &abstract.Money{Units: 0, Currency: "RUB"}},
+							{BoxID: 1, BoxName: "package", Cost: &abstract.Money{Units: 1000, Currency: "RUB"}},
+						},
+						ChargeableWeight: 2,
+					},
+					{
+						OrderID: 2, BoxID: 3, BoxName: "textile", PackagingCost: 0, Weight: 7, WeightCost: 2.5, Cost: 2.5,
+						PackagingCostAmount: &abstract.Money{Units: 0, Currency: "RUB"},
+						WeightCostAmount:    &abstract.Money{Units: 250, Currency: "RUB"},
+						StorageFeeAmount:    &abstract.Money{Units: 0, Currency: "RUB"},
+						CostAmount:          &abstract.Money{Units: 250, Currency: "RUB"},
+						Packaging: []*order_v1.PackagingLayer{
+							{BoxID: 3, BoxName: "textile", Cost: &abstract.Money{Units: 0, Currency: "RUB"}},
+						},
+					},
+				},
+				TotalCost:       18.45,
+				Discount:        2.05,
+				TotalCostAmount: &abstract.Money{Units: 1845, Currency: "RUB"},
+				DiscountAmount:  &abstract.Money{Units: 205, Currency: "RUB"},
+				IssuedAt:        timestamppb.New(fixedTime),
+			},
+			wantErr: nil,
+			useCase: orderMock.NewUseCaseMock(ctrl).IssueOrdersMock.
+				When(
+					minimock.AnyContext, orderModel.RequestOrderIDs{
+						OrderIDs: []int64{1, 2},
+						PVZID:    3,
+					}).
+				Then(orderModel.IssueResponse{
+					ClientID: 4,
+					Lines: []orderModel.IssueLine{
+						{
+							OrderID: 1, BoxID: 1, BoxName: "package", PackagingCost: 1000, Weight: 2, WeightCost: 500, StorageFee: 300, Cost: 1800,
+							Packaging:        []orderModel.PackagingLine{{BoxID: 3, BoxName: "textile"}, {BoxID: 1, BoxName: "package", Cost: 1000}},
+							ChargeableWeight: 2,
+						},
+						{
+							OrderID: 2, BoxID: 3, BoxName: "textile", PackagingCost: 0, Weight: 7, WeightCost: 250, Cost: 250,
+							Packaging: []orderModel.PackagingLine{{BoxID: 3, BoxName: "textile"}},
+						},
+					},
+					TotalCost: 1845,
+					Discount:  205,
+					IssuedAt:  fixedTime,
+				}, nil),
+		},
+		{
+			description: "Order not found",
+			requestBody: order_v1.IssueOrderRequest{
+				OrderIDRequest: []*order_v1.OrderIDRequest{
+					{OrderID: 1},
+					{OrderID: 2},
+				},
+				PvzID: 3,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.NotFound, "Failed to find: Order not found"),
+			useCase: orderMock.NewUseCaseMock(ctrl).IssueOrdersMock.
+				When(
+					minimock.AnyContext, orderModel.RequestOrderIDs{
+						OrderIDs: []int64{1, 2},
+						PVZID:    3,
+					}).
+				Then(orderModel.IssueResponse{}, errlst.ErrOrderNotFound),
+		},
+		{
+			description: "Order already issued",
+			requestBody: order_v1.IssueOrderRequest{
+				OrderIDRequest: []*order_v1.OrderIDRequest{
+					{OrderID: 1},
+					{OrderID: 2},
+				},
+				PvzID: 3,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.FailedPrecondition, "Failed to issue: Invalid order status transition: issued -> issued"),
+			useCase: orderMock.NewUseCaseMock(ctrl).IssueOrdersMock.
+				When(
+					minimock.AnyContext, orderModel.RequestOrderIDs{
+						OrderIDs: []int64{1, 2},
+						PVZID:    3,
+					}).
+				Then(orderModel.IssueResponse{}, &orderModel.TransitionError{From: orderModel.StatusIssued, To: orderModel.StatusIssued}),
+		},
+		{
+			description: "Order storage period has expired",
+			requestBody: order_v1.IssueOrderRequest{
+				OrderIDRequest: []*order_v1.OrderIDRequest{
+					{OrderID: 1},
+					{OrderID: 2},
+				},
+				PvzID: 3,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.FailedPrecondition, "Failed to issue: Order storage period has expired"),
+			useCase: orderMock.NewUseCaseMock(ctrl).IssueOrdersMock.
+				When(
+					minimock.AnyContext, orderModel.RequestOrderIDs{
+						OrderIDs: []int64{1, 2},
+						PVZID:    3,
+					}).
+				Then(orderModel.IssueResponse{}, errlst.ErrOrderExpired),
+		},
+		{
+			description: "Orders belong to different clients",
+			requestBody: order_v1.IssueOrderRequest{
+				OrderIDRequest: []*order_v1.OrderIDRequest{
+					{OrderID: 1},
+					{OrderID: 2},
+				},
+				PvzID: 3,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.FailedPrecondition, "Failed to issue: Not all client ids are same"),
+			useCase: orderMock.NewUseCaseMock(ctrl).IssueOrdersMock.
+				When(
+					minimock.AnyContext, orderModel.RequestOrderIDs{
+						OrderIDs: []int64{1, 2},
+						PVZID:    3,
+					}).
+				Then(orderModel.IssueResponse{}, errlst.ErrClientIDNotFound),
+		},
+		{
+			description: "Internal Server error",
+			requestBody: order_v1.IssueOrderRequest{
+				OrderIDRequest: []*order_v1.OrderIDRequest{
+					{OrderID: 1},
+					{OrderID: 2},
+				},
+				PvzID: 3,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.Internal, "Failed to find: assert.AnError general error for testing"),
+			useCase: orderMock.NewUseCaseMock(ctrl).IssueOrdersMock.
+				When(
+					minimock.AnyContext, orderModel.RequestOrderIDs{
+						OrderIDs: []int64{1, 2},
+						PVZID:    3,
+					}).
+				Then(orderModel.IssueResponse{}, assert.AnError),
+		},
+		{
+			description: "Request validation failed",
+			requestBody: order_v1.IssueOrderRequest{
+				OrderIDRequest: []*order_v1.OrderIDRequest{},
+				PvzID:          3,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ReadRequest: Key: 'RequestOrderIDs.OrderIDs' Error:Field validation for 'OrderIDs' failed on the 'min' tag"),
+			useCase:  orderMock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			response, err := NewOrdersHandler(tt.useCase).IssueOrder(context.Background(), &tt.requestBody)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantResp, response)
+		})
+	}
+}
+
+// TestOrderHandler_QuoteIssue is
+func TestOrderHandler_QuoteIssue(t *testing.T) {
 	t.Parallel()
 
 	ctrl := minimock.NewController(t)
+	mockRequest := orderModel.RequestOrderIDs{
+		OrderIDs: []int64{1, 2, 3},
+		PVZID:    4,
+	}
 
 	tests := []*struct {
 		description string
 		requestBody order_v1.IssueOrderRequest
-		wantResp    *abstract.MessageResponse
+		wantResp    *order_v1.QuoteIssueResponse
 		wantErr     error
 		useCase     order.UseCase
 	}{
 		{
-			description: "Successfully accepted order ID with Client ID",
+			description: "Successfully quoted orders with problems",
 			requestBody: order_v1.IssueOrderRequest{
 				OrderIDRequest: []*order_v1.OrderIDRequest{
 					{OrderID: 1},
 					{OrderID: 2},
+					{OrderID: 3},
 				},
+				PvzID: 4,
 			},
-			wantResp: &abstract.MessageResponse{Message: "Successfully Issued All OrderIDs and Total additional cost:10"},
-			wantErr:  nil,
-			useCase: orderMock.NewUseCaseMock(ctrl).IssueOrdersMock.
-				When(
-					minimock.AnyContext, orderModel.RequestOrderIDs{
-						OrderIDs: []int64{1, 2},
-					}).
-				Then(10, nil),
-		},
-		{
-			description: "Order not found",
-			requestBody: order_v1.IssueOrderRequest{
-				OrderIDRequest: []*order_v1.OrderIDRequest{
-					{OrderID: 1},
-					{OrderID: 2},
+			wantResp: &order_v1.QuoteIssueResponse{
+				ClientID: 5,
+				Lines: []*order_v1.IssueOrderLine{
+					{
+						OrderID: 1, BoxID: 1, BoxName: "package", PackagingCost: 5, Weight: 2, Cost: 5,
+						PackagingCostAmount: &abstract.Money{Units: 500, Currency: "RUB"},
+						WeightCostAmount:    &abstract.Money{Units: 0, Currency: "RUB"},
+						StorageFeeAmount:    &abstract.Money{Units: 0, Currency: "RUB"},
+						CostAmount:          &abstract.Money{Units: 500, Currency: "RUB"},
+						Packaging: []*order_v1.PackagingLayer{
+							{BoxID: 1, BoxName: "package", Cost: &abstract.Money{Units: 500, Currency: "RUB"}},
+						},
+					},
+				},
+				TotalCost:       5,
+				TotalCostAmount: &abstract.Money{Units: 500, Currency: "RUB"},
+				DiscountAmount:  &abstract.Money{Units: 0, Currency: "RUB"},
+				Problems: []*order_v1.IssueProblem{
+					{OrderID: 2, Code: "expired", Message: "Order storage period has expired"},
+					{OrderID: 3, Code: "wrong_client", Message: "Not all client ids are same"},
 				},
 			},
-			wantResp: nil,
-			wantErr:  status.Errorf(codes.NotFound, "Failed to find: Order not found"),
-			useCase: orderMock.NewUseCaseMock(ctrl).IssueOrdersMock.
-				When(
-					minimock.AnyContext, orderModel.RequestOrderIDs{
-						OrderIDs: []int64{1, 2},
-					}).
-				Then(10, errlst.ErrOrderNotFound),
+			wantErr: nil,
+			useCase: orderMock.NewUseCaseMock(ctrl).QuoteIssueMock.When(minimock.AnyContext, mockRequest).
+				Then(orderModel.QuoteResponse{
+					ClientID: 5,
+					Lines: []orderModel.IssueLine{
+						{
+							OrderID: 1, BoxID: 1, BoxName: "package", PackagingCost: 500, Weight: 2, Cost: 500,
+							Packaging: []orderModel.PackagingLine{{BoxID: 1, BoxName: "package", Cost: 500}},
+						},
+					},
+					TotalCost: 500,
+					Problems: []orderModel.IssueProblem{
+						{OrderID: 2, Code: "expired", Message: "Order storage period has expired"},
+						{OrderID: 3, Code: "wrong_client", Message: "Not all client ids are same"},
+					},
+				}, nil),
 		},
 		{
-			description: "Internal Server error",
+			description: "Internal server error",
 			requestBody: order_v1.IssueOrderRequest{
 				OrderIDRequest: []*order_v1.OrderIDRequest{
 					{OrderID: 1},
 					{OrderID: 2},
+					{OrderID: 3},
 				},
+				PvzID: 4,
 			},
 			wantResp: nil,
-			wantErr:  status.Errorf(codes.Internal, "Failed to find: assert.AnError general error for testing"),
-			useCase: orderMock.NewUseCaseMock(ctrl).IssueOrdersMock.
-				When(
-					minimock.AnyContext, orderModel.RequestOrderIDs{
-						OrderIDs: []int64{1, 2},
-					}).
-				Then(10, assert.AnError),
+			wantErr:  status.Errorf(codes.Internal, "Failed to quote: assert.AnError general error for testing"),
+			useCase: orderMock.NewUseCaseMock(ctrl).QuoteIssueMock.When(minimock.AnyContext, mockRequest).
+				Then(orderModel.QuoteResponse{}, assert.AnError),
 		},
 		{
 			description: "Request validation failed",
 			requestBody: order_v1.IssueOrderRequest{
-				OrderIDRequest: []*order_v1.OrderIDRequest{},
+				OrderIDRequest: []*order_v1.OrderIDRequest{{OrderID: 1}},
 			},
 			wantResp: nil,
-			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ReadRequest: Key: 'RequestOrderIDs.OrderIDs' Error:Field validation for 'OrderIDs' failed on the 'min' tag"),
+			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ReadRequest: Key: 'RequestOrderIDs.PVZID' Error:Field validation for 'PVZID' failed on the 'required' tag"),
 			useCase:  orderMock.NewUseCaseMock(ctrl),
 		},
 	}
@@ -293,8 +1035,7 @@ func TestOrderHandler_IssueOrder(t *testing.T) {
 		tt := tt
 		t.Run(tt.description, func(t *testing.T) {
 			t.Parallel()
-
-			response, err := NewOrdersHandler(tt.useCase).IssueOrder(context.Background(), &tt.requestBody)
+			response, err := NewOrdersHandler(tt.useCase).QuoteIssue(context.Background(), &tt.requestBody)
 
 			assert.Equal(t, tt.wantErr, err)
 			assert.Equal(t, tt.wantResp, response)
@@ -312,25 +1053,29 @@ func TestOrderHandler_OrderList(t *testing.T) {
 
 	tests := []*struct {
 		description string
-		requestBody abstract.Page
+		requestBody order_v1.OrderListRequest
 		wantResp    *order_v1.OrderListResponse
 		wantErr     error
 		useCase     order.UseCase
 	}{
 		{
 			description: "Successfully got list of order",
-			requestBody: abstract.Page{
-				CurrentPage:  1,
-				ItemsPerPage: 10,
+			requestBody: order_v1.OrderListRequest{
+				PvzID: 1,
+				Page: &abstract.Page{
+					CurrentPage:  1,
+					ItemsPerPage: 10,
+				},
 			},
 			wantResp: &order_v1.OrderListResponse{
 				OrderAllInfo: []*order_v1.OrderAllInfo{
 					{
 						Order: &order_v1.Order{
-							OrderID:  1,
-							ClientID: 1,
-							Weight:   0,
-							BoxID:    0,
+							OrderID:    1,
+							ClientID:   1,
+							Weight:     0,
+							BoxID:      0,
+							Dimensions: &abstract.Dimensions{},
 						},
 						CreatedAt:  timestamppb.New(fixedTime),
 						UpdatedAt:  timestamppb.New(fixedTime),
@@ -351,9 +1096,12 @@ func TestOrderHandler_OrderList(t *testing.T) {
 			useCase: orderMock.NewUseCaseMock(ctrl).OrderListMock.
 				When(
 					minimock.AnyContext,
-					abstractModel.Page{
-						CurrentPage:  1,
-						ItemsPerPage: 10,
+					orderModel.ListRequest{
+						PVZID: 1,
+						Page: abstractModel.Page{
+							CurrentPage:  1,
+							ItemsPerPage: 10,
+						},
 					}).
 				Then(abstractModel.PaginatedResponse[orderModel.AllResponse]{
 					Items: []orderModel.AllResponse{
@@ -376,9 +1124,12 @@ func TestOrderHandler_OrderList(t *testing.T) {
 		},
 		{
 			description: "Empty order list",
-			requestBody: abstract.Page{
-				CurrentPage:  1,
-				ItemsPerPage: 10,
+			requestBody: order_v1.OrderListRequest{
+				PvzID: 1,
+				Page: &abstract.Page{
+					CurrentPage:  1,
+					ItemsPerPage: 10,
+				},
 			},
 			wantResp: &order_v1.OrderListResponse{
 				OrderAllInfo: []*order_v1.OrderAllInfo{},
@@ -391,31 +1142,117 @@ func TestOrderHandler_OrderList(t *testing.T) {
 				},
 			},
 			wantErr: nil,
-			useCase: orderMock.NewUseCaseMock(ctrl).OrderListMock.When(minimock.AnyContext, abstractModel.Page{
-				CurrentPage:  1,
-				ItemsPerPage: 10,
+			useCase: orderMock.NewUseCaseMock(ctrl).OrderListMock.When(minimock.AnyContext, orderModel.ListRequest{
+				PVZID: 1,
+				Page: abstractModel.Page{
+					CurrentPage:  1,
+					ItemsPerPage: 10,
+				},
 			}).Then(abstractModel.PaginatedResponse[orderModel.AllResponse]{}, nil),
 		},
+		{
+			description: "Filtered and sorted order list",
+			requestBody: order_v1.OrderListRequest{
+				PvzID: 1,
+				Page: &abstract.Page{
+					CurrentPage:  1,
+					ItemsPerPage: 10,
+				},
+				Filter: &order_v1.OrderFilter{
+					ClientID:      2,
+					Status:        "received",
+					CreatedFrom:   timestamppb.New(fixedTime),
+					MinWeight:     1,
+					MaxWeight:     5,
+					SortBy:        "weight",
+					SortDirection: "asc",
+				},
+			},
+			wantResp: &order_v1.OrderListResponse{
+				OrderAllInfo: []*order_v1.OrderAllInfo{},
+				Pagination: &abstract.Pagination{
+					Page: &abstract.Page{
+						CurrentPage:  1,
+						ItemsPerPage: 0,
+					},
+					TotalItems: 0,
+				},
+			},
+			wantErr: nil,
+			useCase: orderMock.NewUseCaseMock(ctrl).OrderListMock.When(minimock.AnyContext, orderModel.ListRequest{
+				PVZID: 1,
+				Page: abstractModel.Page{
+					CurrentPage:  1,
+					ItemsPerPage: 10,
+				},
+				Filter: orderModel.Filter{
+					ClientID:      2,
+					Status:        orderModel.StatusReceived,
+					CreatedFrom:   &fixedTime,
+					MinWeight:     1,
+					MaxWeight:     5,
+					SortBy:        "weight",
+					SortDirection: "asc",
+				},
+			}).Then(abstractModel.PaginatedResponse[orderModel.AllResponse]{CurrentPage: 1}, nil),
+		},
 		{
 			description: "Internal server error",
-			requestBody: abstract.Page{
-				CurrentPage:  1,
-				ItemsPerPage: 10,
+			requestBody: order_v1.OrderListRequest{
+				PvzID: 1,
+				Page: &abstract.Page{
+					CurrentPage:  1,
+					ItemsPerPage: 10,
+				},
 			},
 			wantResp: nil,
 			wantErr:  status.Errorf(codes.Internal, "Failed to get order list: assert.AnError general error for testing"),
-			useCase: orderMock.NewUseCaseMock(ctrl).OrderListMock.When(minimock.AnyContext, abstractModel.Page{
-				CurrentPage:  1,
-				ItemsPerPage: 10,
+			useCase: orderMock.NewUseCaseMock(ctrl).OrderListMock.When(minimock.AnyContext, orderModel.ListRequest{
+				PVZID: 1,
+				Page: abstractModel.Page{
+					CurrentPage:  1,
+					ItemsPerPage: 10,
+				},
 			}).Then(abstractModel.PaginatedResponse[orderModel.AllResponse]{}, assert.AnError),
 		},
 		{
 			description: "Request validation failed",
-			requestBody: abstract.Page{
-				CurrentPage: 1,
+			requestBody: order_v1.OrderListRequest{
+				PvzID: 1,
+				Page: &abstract.Page{
+					CurrentPage: 1,
+				},
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ReadRequest: Key: 'ListRequest.Page.ItemsPerPage' Error:Field validation for 'ItemsPerPage' failed on the 'required' tag"),
+			useCase:  orderMock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Unknown sort field",
+			requestBody: order_v1.OrderListRequest{
+				PvzID: 1,
+				Page: &abstract.Page{
+					CurrentPage:  1,
+					ItemsPerPage: 10,
+				},
+				Filter: &order_v1.OrderFilter{SortBy: "box_id; DROP TABLE orders"},
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ReadRequest: Key: 'ListRequest.Filter.SortBy' Error:Field validation for 'SortBy' failed on the 'oneof' tag"),
+			useCase:  orderMock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Invalid weight range",
+			requestBody: order_v1.OrderListRequest{
+				PvzID: 1,
+				Page: &abstract.Page{
+					CurrentPage:  1,
+					ItemsPerPage: 10,
+				},
+				Filter: &order_v1.OrderFilter{MinWeight: 5, MaxWeight: 1},
 			},
 			wantResp: nil,
-			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ReadRequest: Key: 'Page.ItemsPerPage' Error:Field validation for 'ItemsPerPage' failed on the 'required' tag"),
+			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ReadRequest: Key: 'ListRequest.Filter.MaxWeight' Error:Field validation for 'MaxWeight' failed on the 'gtefield' tag"),
 			useCase:  orderMock.NewUseCaseMock(ctrl),
 		},
 	}
@@ -441,16 +1278,19 @@ func TestOrderHandler_ReturnedOrders(t *testing.T) {
 
 	tests := []*struct {
 		description string
-		requestBody abstract.Page
+		requestBody order_v1.OrderListRequest
 		wantResp    *order_v1.ReturnedListResponse
 		wantErr     error
 		useCase     order.UseCase
 	}{
 		{
 			description: "Successfully got list of returned orders",
-			requestBody: abstract.Page{
-				CurrentPage:  1,
-				ItemsPerPage: 10,
+			requestBody: order_v1.OrderListRequest{
+				PvzID: 1,
+				Page: &abstract.Page{
+					CurrentPage:  1,
+					ItemsPerPage: 10,
+				},
 			},
 			wantResp: &order_v1.ReturnedListResponse{ReturnedResponse: []*order_v1.ReturnedResponse{
 				{
@@ -469,9 +1309,12 @@ func TestOrderHandler_ReturnedOrders(t *testing.T) {
 			wantErr: nil,
 			useCase: orderMock.NewUseCaseMock(ctrl).ReturnedOrdersMock.When(
 				minimock.AnyContext,
-				abstractModel.Page{
-					CurrentPage:  1,
-					ItemsPerPage: 10,
+				orderModel.ListRequest{
+					PVZID: 1,
+					Page: abstractModel.Page{
+						CurrentPage:  1,
+						ItemsPerPage: 10,
+					},
 				}).
 				Then(abstractModel.PaginatedResponse[orderModel.ReturnedResponse]{
 					Items: []orderModel.ReturnedResponse{
@@ -488,9 +1331,12 @@ func TestOrderHandler_ReturnedOrders(t *testing.T) {
 		},
 		{
 			description: "Empty returned order list",
-			requestBody: abstract.Page{
-				CurrentPage:  1,
-				ItemsPerPage: 10,
+			requestBody: order_v1.OrderListRequest{
+				PvzID: 1,
+				Page: &abstract.Page{
+					CurrentPage:  1,
+					ItemsPerPage: 10,
+				},
 			},
 			wantResp: &order_v1.ReturnedListResponse{ReturnedResponse: []*order_v1.ReturnedResponse{},
 				Pagination: &abstract.Pagination{
@@ -505,36 +1351,48 @@ func TestOrderHandler_ReturnedOrders(t *testing.T) {
 			useCase: orderMock.NewUseCaseMock(ctrl).ReturnedOrdersMock.
 				When(
 					minimock.AnyContext,
-					abstractModel.Page{
-						CurrentPage:  1,
-						ItemsPerPage: 10,
+					orderModel.ListRequest{
+						PVZID: 1,
+						Page: abstractModel.Page{
+							CurrentPage:  1,
+							ItemsPerPage: 10,
+						},
 					}).
 				Then(abstractModel.PaginatedResponse[orderModel.ReturnedResponse]{}, nil),
 		},
 		{
 			description: "Internal server error",
-			requestBody: abstract.Page{
-				CurrentPage:  1,
-				ItemsPerPage: 10,
+			requestBody: order_v1.OrderListRequest{
+				PvzID: 1,
+				Page: &abstract.Page{
+					CurrentPage:  1,
+					ItemsPerPage: 10,
+				},
 			},
 			wantResp: nil,
 			wantErr:  status.Errorf(codes.Internal, "Failed to get returned list: assert.AnError general error for testing"),
 			useCase: orderMock.NewUseCaseMock(ctrl).ReturnedOrdersMock.
 				When(
 					minimock.AnyContext,
-					abstractModel.Page{
-						CurrentPage:  1,
-						ItemsPerPage: 10,
+					orderModel.ListRequest{
+						PVZID: 1,
+						Page: abstractModel.Page{
+							CurrentPage:  1,
+							ItemsPerPage: 10,
+						},
 					}).
 				Then(abstractModel.PaginatedResponse[orderModel.ReturnedResponse]{}, assert.AnError),
 		},
 		{
 			description: "Request validation failed",
-			requestBody: abstract.Page{
-				CurrentPage: 1,
+			requestBody: order_v1.OrderListRequest{
+				PvzID: 1,
+				Page: &abstract.Page{
+					CurrentPage: 1,
+				},
 			},
 			wantResp: nil,
-			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ReadRequest: Key: 'Page.ItemsPerPage' Error:Field validation for 'ItemsPerPage' failed on the 'required' tag"),
+			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ReadRequest: Key: 'ListRequest.Page.ItemsPerPage' Error:Field validation for 'ItemsPerPage' failed on the 'required' tag"),
 			useCase:  orderMock.NewUseCaseMock(ctrl),
 		},
 	}
@@ -556,7 +1414,7 @@ func TestOrderHandler_TurnInOrder(t *testing.T) {
 	t.Parallel()
 
 	ctrl := minimock.NewController(t)
-	var mockOrderID int64 = 1
+	mockRequest := orderModel.IDRequest{OrderID: 1, PVZID: 2}
 
 	tests := []*struct {
 		description string
@@ -567,32 +1425,47 @@ func TestOrderHandler_TurnInOrder(t *testing.T) {
 	}{
 		{
 			description: "Successfully turn in with given order ID",
-			requestID:   order_v1.OrderIDRequest{OrderID: 1},
+			requestID:   order_v1.OrderIDRequest{OrderID: 1, PvzID: 2},
 			wantResp:    &abstract.MessageResponse{Message: "Successfully turn in with given order ID"},
 			wantErr:     nil,
-			useCase:     orderMock.NewUseCaseMock(ctrl).DeleteReturnedOrderMock.When(minimock.AnyContext, mockOrderID).Then(nil),
+			useCase:     orderMock.NewUseCaseMock(ctrl).DeleteReturnedOrderMock.When(minimock.AnyContext, mockRequest).Then(nil),
 		},
 		{
 			description: "Order not found",
-			requestID:   order_v1.OrderIDRequest{OrderID: 1},
+			requestID:   order_v1.OrderIDRequest{OrderID: 1, PvzID: 2},
 			wantResp:    nil,
 			wantErr:     status.Errorf(codes.NotFound, "Failed to find order: Order not found"),
-			useCase:     orderMock.NewUseCaseMock(ctrl).DeleteReturnedOrderMock.When(minimock.AnyContext, mockOrderID).Then(errlst.ErrOrderNotFound),
+			useCase:     orderMock.NewUseCaseMock(ctrl).DeleteReturnedOrderMock.When(minimock.AnyContext, mockRequest).Then(errlst.ErrOrderNotFound),
+		},
+		{
+			description: "Order is still with the client",
+			requestID:   order_v1.OrderIDRequest{OrderID: 1, PvzID: 2},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.FailedPrecondition, "Failed to turn in order: Invalid order status transition: issued -> handed_to_courier"),
+			useCase: orderMock.NewUseCaseMock(ctrl).DeleteReturnedOrderMock.When(minimock.AnyContext, mockRequest).
+				Then(&orderModel.TransitionError{From: orderModel.StatusIssued, To: orderModel.StatusHandedToCourier}),
 		},
 		{
 			description: "Internal server error",
-			requestID:   order_v1.OrderIDRequest{OrderID: 1},
+			requestID:   order_v1.OrderIDRequest{OrderID: 1, PvzID: 2},
 			wantResp:    nil,
 			wantErr:     status.Errorf(codes.Internal, "Failed to turn in order: assert.AnError general error for testing"),
-			useCase:     orderMock.NewUseCaseMock(ctrl).DeleteReturnedOrderMock.When(minimock.AnyContext, mockOrderID).Then(assert.AnError),
+			useCase:     orderMock.NewUseCaseMock(ctrl).DeleteReturnedOrderMock.When(minimock.AnyContext, mockRequest).Then(assert.AnError),
 		},
 		{
 			description: "Unable to parse orderID",
-			requestID:   order_v1.OrderIDRequest{OrderID: -1},
+			requestID:   order_v1.OrderIDRequest{OrderID: -1, PvzID: 2},
 			wantResp:    nil,
 			wantErr:     status.Errorf(codes.InvalidArgument, "invalid request id"),
 			useCase:     orderMock.NewUseCaseMock(ctrl),
 		},
+		{
+			description: "Request validation failed",
+			requestID:   order_v1.OrderIDRequest{OrderID: 1},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.InvalidArgument, "reqvalidator.ReadRequest: Key: 'IDRequest.PVZID' Error:Field validation for 'PVZID' failed on the 'required' tag"),
+			useCase:     orderMock.NewUseCaseMock(ctrl),
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -614,16 +1487,19 @@ func TestOrderHandler_UniqueClientList(t *testing.T) {
 
 	tests := []*struct {
 		description string
-		requestBody abstract.Page
+		requestBody order_v1.OrderListRequest
 		wantResp    *order_v1.UniqueClientListResponse
 		wantErr     error
 		useCase     order.UseCase
 	}{
 		{
 			description: "Successfully got list of unique clients",
-			requestBody: abstract.Page{
-				CurrentPage:  1,
-				ItemsPerPage: 10,
+			requestBody: order_v1.OrderListRequest{
+				PvzID: 1,
+				Page: &abstract.Page{
+					CurrentPage:  1,
+					ItemsPerPage: 10,
+				},
 			},
 			wantResp: &order_v1.UniqueClientListResponse{
 				ClientIDs: []int64{1, 2},
@@ -639,9 +1515,12 @@ func TestOrderHandler_UniqueClientList(t *testing.T) {
 			useCase: orderMock.NewUseCaseMock(ctrl).UniqueClientsListMock.
 				When(
 					minimock.AnyContext,
-					abstractModel.Page{
-						CurrentPage:  1,
-						ItemsPerPage: 10,
+					orderModel.ListRequest{
+						PVZID: 1,
+						Page: abstractModel.Page{
+							CurrentPage:  1,
+							ItemsPerPage: 10,
+						},
 					}).
 				Then(
 					abstractModel.PaginatedResponse[orderModel.ListUniqueClients]{
@@ -660,9 +1539,12 @@ func TestOrderHandler_UniqueClientList(t *testing.T) {
 		},
 		{
 			description: "Empty unique client list",
-			requestBody: abstract.Page{
-				CurrentPage:  1,
-				ItemsPerPage: 10,
+			requestBody: order_v1.OrderListRequest{
+				PvzID: 1,
+				Page: &abstract.Page{
+					CurrentPage:  1,
+					ItemsPerPage: 10,
+				},
 			},
 			wantResp: &order_v1.UniqueClientListResponse{ClientIDs: []int64{},
 				Pagination: &abstract.Pagination{
@@ -677,38 +1559,50 @@ func TestOrderHandler_UniqueClientList(t *testing.T) {
 			useCase: orderMock.NewUseCaseMock(ctrl).UniqueClientsListMock.
 				When(
 					minimock.AnyContext,
-					abstractModel.Page{
-						CurrentPage:  1,
-						ItemsPerPage: 10,
+					orderModel.ListRequest{
+						PVZID: 1,
+						Page: abstractModel.Page{
+							CurrentPage:  1,
+							ItemsPerPage: 10,
+						},
 					}).
 				Then(
 					abstractModel.PaginatedResponse[orderModel.ListUniqueClients]{}, nil),
 		},
 		{
 			description: "Internal server error",
-			requestBody: abstract.Page{
-				CurrentPage:  1,
-				ItemsPerPage: 10,
+			requestBody: order_v1.OrderListRequest{
+				PvzID: 1,
+				Page: &abstract.Page{
+					CurrentPage:  1,
+					ItemsPerPage: 10,
+				},
 			},
 			wantResp: nil,
 			wantErr:  status.Errorf(codes.Internal, "Failed to find in clients: assert.AnError general error for testing"),
 			useCase: orderMock.NewUseCaseMock(ctrl).UniqueClientsListMock.
 				When(
 					minimock.AnyContext,
-					abstractModel.Page{
-						CurrentPage:  1,
-						ItemsPerPage: 10,
+					orderModel.ListRequest{
+						PVZID: 1,
+						Page: abstractModel.Page{
+							CurrentPage:  1,
+							ItemsPerPage: 10,
+						},
 					}).
 				Then(
 					abstractModel.PaginatedResponse[orderModel.ListUniqueClients]{}, assert.AnError),
 		},
 		{
 			description: "Request validation failed",
-			requestBody: abstract.Page{
-				CurrentPage: 1,
+			requestBody: order_v1.OrderListRequest{
+				PvzID: 1,
+				Page: &abstract.Page{
+					CurrentPage: 1,
+				},
 			},
 			wantResp: nil,
-			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ReadRequest: Key: 'Page.ItemsPerPage' Error:Field validation for 'ItemsPerPage' failed on the 'required' tag"),
+			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ReadRequest: Key: 'ListRequest.Page.ItemsPerPage' Error:Field validation for 'ItemsPerPage' failed on the 'required' tag"),
 			useCase:  orderMock.NewUseCaseMock(ctrl),
 		},
 	}
@@ -724,3 +1618,192 @@ func TestOrderHandler_UniqueClientList(t *testing.T) {
 		})
 	}
 }
+
+// TestOrderHandler_GetOrderByID is
+func TestOrderHandler_GetOrderByID(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	ctrl := minimock.NewController(t)
+	mockRequest := orderModel.IDRequest{OrderID: 1, PVZID: 2}
+
+	tests := []*struct {
+		description string
+		requestID   order_v1.OrderIDRequest
+		wantResp    *order_v1.OrderDetails
+		wantErr     error
+		useCase     order.UseCase
+	}{
+		{
+			description: "Successfully got order",
+			requestID:   order_v1.OrderIDRequest{OrderID: 1, PvzID: 2},
+			wantResp: &order_v1.OrderDetails{
+				OrderAllInfo: &order_v1.OrderAllInfo{
+					Order: &order_v1.Order{
+						OrderID:    1,
+						ClientID:   3,
+						Weight:     5,
+						BoxID:      4,
+						PvzID:      2,
+						Dimensions: &abstract.Dimensions{Length: 30, Width: 20, Height: 10},
+					},
+					CreatedAt:  timestamppb.New(fixedTime),
+					UpdatedAt:  timestamppb.New(fixedTime),
+					AcceptedAt: nil,
+					IssuedAt:   timestamppb.New(fixedTime),
+					ExpiresAt:  timestamppb.New(fixedTime),
+				},
+				Status:     "issued",
+				ReturnedAt: nil,
+				Box: &box_v1.BoxAllInfo{
+					ID: 4,
+					Box: &box_v1.Box{
+						Name:       "package",
+						Cost:       5,
+						IsCheck:    true,
+						Weight:     10,
+						CostAmount: &abstract.Money{Units: 500, Currency: "RUB"},
+						Dimensions: &abstract.Dimensions{Length: 40, Width: 30, Height: 20},
+					},
+					CreatedAt: timestamppb.New(fixedTime),
+					UpdatedAt: timestamppb.New(fixedTime),
+				},
+			},
+			wantErr: nil,
+			useCase: orderMock.NewUseCaseMock(ctrl).GetOrderByIDMock.When(minimock.AnyContext, mockRequest).
+				Then(orderModel.DetailsResponse{
+					AllResponse: orderModel.AllResponse{
+						OrderID:    1,
+						ClientID:   3,
+						IssuedAt:   &fixedTime,
+						ExpiresAt:  &fixedTime,
+						Weight:     5,
+						BoxID:      4,
+						PVZID:      2,
+						Dimensions: abstractModel.Dimensions{Length: 30, Width: 20, Height: 10},
+						CreatedAt:  fixedTime,
+						UpdatedAt:  fixedTime,
+					},
+					Status: orderModel.StatusIssued,
+					Box: boxModel.AllResponse{
+						ID:         4,
+						Name:       "package",
+						Cost:       500,
+						IsCheck:    true,
+						Weight:     10,
+						Dimensions: abstractModel.Dimensions{Length: 40, Width: 30, Height: 20},
+						CreatedAt:  fixedTime,
+						UpdatedAt:  fixedTime,
+					},
+				}, nil),
+		},
+		{
+			description: "Order not found",
+			requestID:   order_v1.OrderIDRequest{OrderID: 1, PvzID: 2},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.NotFound, "Failed to find order: Order not found"),
+			useCase: orderMock.NewUseCaseMock(ctrl).GetOrderByIDMock.When(minimock.AnyContext, mockRequest).
+				Then(orderModel.DetailsResponse{}, errlst.ErrOrderNotFound),
+		},
+		{
+			description: "Internal server error",
+			requestID:   order_v1.OrderIDRequest{OrderID: 1, PvzID: 2},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.Internal, "Failed to get order: assert.AnError general error for testing"),
+			useCase: orderMock.NewUseCaseMock(ctrl).GetOrderByIDMock.When(minimock.AnyContext, mockRequest).
+				Then(orderModel.DetailsResponse{}, assert.AnError),
+		},
+		{
+			description: "Request validation failed",
+			requestID:   order_v1.OrderIDRequest{OrderID: 1},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.InvalidArgument, "reqvalidator.ReadRequest: Key: 'IDRequest.PVZID' Error:Field validation for 'PVZID' failed on the 'required' tag"),
+			useCase:     orderMock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewOrdersHandler(tt.useCase).GetOrderByID(context.Background(), &tt.requestID)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantResp, response)
+		})
+	}
+}
+
+// TestOrderHandler_GetOrderHistory is
+func TestOrderHandler_GetOrderHistory(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	received := orderModel.StatusReceived
+
+	ctrl := minimock.NewController(t)
+	mockRequest := orderModel.IDRequest{OrderID: 1, PVZID: 2}
+
+	tests := []*struct {
+		description string
+		requestID   order_v1.OrderIDRequest
+		wantResp    *order_v1.OrderHistoryResponse
+		wantErr     error
+		useCase     order.UseCase
+	}{
+		{
+			description: "Successfully got order history",
+			requestID:   order_v1.OrderIDRequest{OrderID: 1, PvzID: 2},
+			wantResp: &order_v1.OrderHistoryResponse{
+				OrderID: 1,
+				StatusChanges: []*order_v1.OrderStatusChange{
+					{FromStatus: "", ToStatus: "received", ChangedAt: timestamppb.New(fixedTime)},
+					{FromStatus: "received", ToStatus: "issued", ChangedAt: timestamppb.New(fixedTime)},
+				},
+			},
+			wantErr: nil,
+			useCase: orderMock.NewUseCaseMock(ctrl).GetOrderHistoryMock.When(minimock.AnyContext, mockRequest).
+				Then(orderModel.HistoryResponse{
+					OrderID: 1,
+					PVZID:   2,
+					StatusChanges: []orderModel.StatusChange{
+						{FromStatus: nil, ToStatus: orderModel.StatusReceived, ChangedAt: fixedTime},
+						{FromStatus: &received, ToStatus: orderModel.StatusIssued, ChangedAt: fixedTime},
+					},
+				}, nil),
+		},
+		{
+			description: "Order not found",
+			requestID:   order_v1.OrderIDRequest{OrderID: 1, PvzID: 2},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.NotFound, "Failed to find order: Order not found"),
+			useCase: orderMock.NewUseCaseMock(ctrl).GetOrderHistoryMock.When(minimock.AnyContext, mockRequest).
+				Then(orderModel.HistoryResponse{}, errlst.ErrOrderNotFound),
+		},
+		{
+			description: "Internal server error",
+			requestID:   order_v1.OrderIDRequest{OrderID: 1, PvzID: 2},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.Internal, "Failed to get order history: assert.AnError general error for testing"),
+			useCase: orderMock.NewUseCaseMock(ctrl).GetOrderHistoryMock.When(minimock.AnyContext, mockRequest).
+				Then(orderModel.HistoryResponse{}, assert.AnError),
+		},
+		{
+			description: "Request validation failed",
+			requestID:   order_v1.OrderIDRequest{PvzID: 2},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.InvalidArgument, "reqvalidator.ReadRequest: Key: 'IDRequest.OrderID' Error:Field validation for 'OrderID' failed on the 'required' tag"),
+			useCase:     orderMock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewOrdersHandler(tt.useCase).GetOrderHistory(context.Background(), &tt.requestID)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantResp, response)
+		})
+	}
+}
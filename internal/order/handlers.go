@@ -10,10 +10,14 @@ import (
 // Handlers is
 type Handlers interface {
 	ReceiveOrder(ctx context.Context, request *order_v1.OrderCreateRequest) (*abstract.MessageResponse, error)
-	IssueOrder(ctx context.Context, request *order_v1.IssueOrderRequest) (*abstract.MessageResponse, error)
-	ReturnedOrders(ctx context.Context, request *abstract.Page) (*order_v1.ReturnedListResponse, error)
+	ReceiveOrdersBatch(stream order_v1.OrderService_ReceiveOrdersBatchServer) error
+	IssueOrder(ctx context.Context, request *order_v1.IssueOrderRequest) (*order_v1.IssueOrderResponse, error)
+	QuoteIssue(ctx context.Context, request *order_v1.IssueOrderRequest) (*order_v1.QuoteIssueResponse, error)
+	ReturnedOrders(ctx context.Context, request *order_v1.OrderListRequest) (*order_v1.ReturnedListResponse, error)
 	AcceptOrder(ctx context.Context, request *order_v1.RequestWithClientID) (*abstract.MessageResponse, error)
 	TurnInOrder(ctx context.Context, request *order_v1.OrderIDRequest) (*abstract.MessageResponse, error)
-	OrderList(ctx context.Context, request *abstract.Page) (*order_v1.OrderListResponse, error)
-	UniqueClientList(ctx context.Context, request *abstract.Page) (*order_v1.UniqueClientListResponse, error)
+	OrderList(ctx context.Context, request *order_v1.OrderListRequest) (*order_v1.OrderListResponse, error)
+	UniqueClientList(ctx context.Context, request *order_v1.OrderListRequest) (*order_v1.UniqueClientListResponse, error)
+	GetOrderByID(ctx context.Context, request *order_v1.OrderIDRequest) (*order_v1.OrderDetails, error)
+	GetOrderHistory(ctx context.Context, request *order_v1.OrderIDRequest) (*order_v1.OrderHistoryResponse, error)
 }
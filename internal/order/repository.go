@@ -3,23 +3,26 @@ package order
 import (
 	"context"
 
-	"Homework-1/internal/model/abstract"
 	"Homework-1/internal/model/box"
 	"Homework-1/internal/model/order"
 )
 
 // Repository is
 type Repository interface {
-	UpdateAcceptOrder(ctx context.Context, acceptOrderData order.RequestWithClientIDData) error
 	CreateReceiveOrder(ctx context.Context, orderRequestData order.RequestData) error
-	UpdateIssueOrder(ctx context.Context, orderID int64, clientID int64) error
-	DeleteReturnOrder(ctx context.Context, orderID int64) error
-	CountReturnedOrders(ctx context.Context) (int64, error)
-	CountOrders(ctx context.Context) (int64, error)
-	CountUniqueClients(ctx context.Context) (int64, error)
-	ListOrders(ctx context.Context, orderPaginationData abstract.PageData) ([]order.AllResponseData, error)
-	ListReturnedOrders(ctx context.Context, orderPaginationData abstract.PageData) ([]order.ReturnedData, error)
-	ListUniqueClients(ctx context.Context, clientPaginationData abstract.PageData) ([]order.ListUniqueClientsData, error)
-	BoxDataByOrderID(ctx context.Context, orderID int64) (box.DataWithOrder, error)
-	GetClientID(ctx context.Context, orderID int64) (int64, error)
+	GetOrderState(ctx context.Context, orderID int64, pvzID int64) (order.StateData, error)
+	UpdateStatus(ctx context.Context, transitionData order.StatusTransitionData) error
+	ListOverdueOrders(ctx context.Context, limit int) ([]order.StateData, error)
+	CountReturnedOrders(ctx context.Context, listData order.ListRequestData) (int64, error)
+	CountOrders(ctx context.Context, listData order.ListRequestData) (int64, error)
+	CountUniqueClients(ctx context.Context, listData order.ListRequestData) (int64, error)
+	CountLiveOrders(ctx context.Context, pvzID int64) (int64, error)
+	CountLiveOrdersByBox(ctx context.Context, boxID int64) (int64, error)
+	ListExistingOrderIDs(ctx context.Context, orderIDs []int64) ([]int64, error)
+	ListOrders(ctx context.Context, orderPaginationData order.ListRequestData) ([]order.AllResponseData, error)
+	ListReturnedOrders(ctx context.Context, orderPaginationData order.ListRequestData) ([]order.ReturnedData, error)
+	ListUniqueClients(ctx context.Context, clientPaginationData order.ListRequestData) ([]order.ListUniqueClientsData, error)
+	GetOrderByID(ctx context.Context, orderID int64, pvzID int64) (order.DetailsData, error)
+	ListStatusHistory(ctx context.Context, orderID int64) ([]order.StatusChangeData, error)
+	ListPackaging(ctx context.Context, orderID int64) ([]box.AllData, error)
 }
@@ -6,7 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"time"
+	"strings"
+
+	"github.com/lib/pq"
 
 	"Homework-1/internal/connection"
 	"Homework-1/internal/model/abstract"
@@ -16,7 +18,27 @@ import (
 	"Homework-1/pkg/errlst"
 )
 
-const offsetLimitQuery = "OFFSET $1 LIMIT $2"
+// sortColumns is the whitelist of columns a list can be ordered by
+var sortColumns = map[string]string{
+	"created_at": "created_at",
+	"expires_at": "expires_at",
+	"weight":     "weight",
+	"order_id":   "order_id",
+	"client_id":  "client_id",
+}
+
+// statusTimestampColumn is the timestamp column stamped together with a status change
+var statusTimestampColumn = map[order.Status]string{
+	order.StatusIssued:           "issued_at",
+	order.StatusReturnedByClient: "accepted_at",
+	order.StatusHandedToCourier:  "returned_at",
+}
+
+// cellFreeingStatuses are the statuses of an order that has left its PVZ cell
+var cellFreeingStatuses = map[order.Status]bool{
+	order.StatusIssued:          true,
+	order.StatusHandedToCourier: true,
+}
 
 var (
 	_ orderInterface.Repository = (*OrdersRepository)(nil)
@@ -38,87 +60,85 @@ func NewOrdersPGRepository(psqlDB connection.DB) *OrdersRepository {
 func (o *OrdersRepository) CreateReceiveOrder(ctx context.Context, orderData order.RequestData) error {
 	log.Printf("[order][repository][CreateReceiveOrder]")
 
-	expiresAt := time.Now().AddDate(0, 0, orderData.ExpireTimeDuration)
-	_, err := o.psqlDB.Execute(ctx,
-		"INSERT INTO orders(order_id, client_id, expires_at, weight, box_id) VALUES ($1,$2,$3,$4,$5);",
+	result, err := o.psqlDB.Execute(ctx,
+		"WITH inserted AS (INSERT INTO orders(order_id, client_id, expires_at, weight, box_id, pvz_id, status, length, width, height, cell_id) "+
+			"SELECT $1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11 WHERE EXISTS (SELECT 1 FROM pvz WHERE id = $6 AND deleted_at IS NULL) RETURNING order_id) "+
+			"INSERT INTO order_status_history(order_id, to_status) SELECT order_id, $7 FROM inserted;",
 		orderData.OrderID,
 		orderData.ClientID,
-		expiresAt,
+		orderData.ExpiresAt,
 		orderData.Weight,
 		orderData.BoxID,
+		orderData.PVZID,
+		order.StatusReceived,
+		orderData.Length,
+		orderData.Width,
+		orderData.Height,
+		orderData.CellID,
 	)
 	if err != nil {
-		if errors.Is(err, errlst.ErrOrderAlreadyExists) {
+		if strings.Contains(err.Error(), errlst.ErrOrderAlreadyExists.Error()) {
 			return errlst.ErrOrderAlreadyExists
 		}
 
 		return fmt.Errorf("tx.ExecContext: %w", err)
 	}
 
-	return nil
-}
-
-// GetClientID is
-func (o *OrdersRepository) GetClientID(ctx context.Context, orderID int64) (int64, error) {
-	log.Printf("[order][repository][GetClientID]")
-	var clientID int64
-
-	err := o.psqlDB.Get(ctx, &clientID, "SELECT client_id FROM orders WHERE order_id = $1 AND returned_at IS NULL AND issued_at IS NULL", orderID)
+	rows, err := result.RowsAffected()
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return -1, errlst.ErrOrderNotFound
-		}
-
-		return -1, err
+		return fmt.Errorf("result.RowsAffected: %w", err)
 	}
 
-	return clientID, nil
-}
-
-// BoxDataByOrderID is
-func (o *OrdersRepository) BoxDataByOrderID(ctx context.Context, orderID int64) (box.DataWithOrder, error) {
-	log.Println("[order][repository][BoxDataByOrderID]")
-	var boxData box.DataWithOrder
+	if rows == 0 {
+		return errlst.ErrPVZNotFound
+	}
 
-	err := o.psqlDB.Get(ctx, &boxData, "SELECT cost, is_check, box.weight as weight, o.weight as order_weight FROM box join orders o on box.id = o.box_id WHERE o.order_id = $1", orderID)
+	_, err = o.psqlDB.Execute(ctx,
+		"INSERT INTO order_packaging(order_id, position, box_id, box_version_id) "+
+			"SELECT $1, p.position - 1, p.box_id, (SELECT MAX(v.id) FROM box_version v WHERE v.box_id = p.box_id) "+
+			"FROM unnest($2::BIGINT[]) WITH ORDINALITY AS p(box_id, position)",
+		orderData.OrderID,
+		pq.Array(orderData.Packaging),
+	)
 	if err != nil {
-		return box.DataWithOrder{}, errlst.ErrBoxNotFound
+		return fmt.Errorf("tx.ExecContext: %w", err)
 	}
 
-	return boxData, nil
+	return nil
 }
 
-// UpdateIssueOrder is
-func (o *OrdersRepository) UpdateIssueOrder(ctx context.Context, orderID int64, clientID int64) error {
-	log.Printf("[order][repository][UpdateIssueOrder]")
+// ListPackaging is, the layers go from the innermost one to the outermost one
+// and hold the box version the order was received with
+func (o *OrdersRepository) ListPackaging(ctx context.Context, orderID int64) ([]box.AllData, error) {
+	log.Println("[order][repository][ListPackaging]")
 
-	result, err := o.psqlDB.Execute(ctx, "UPDATE orders SET issued_at = $1, updated_at = $2 WHERE order_id=$3 AND client_id = $4 AND returned_at IS NULL AND issued_at IS NULL", time.Now(), time.Now(), orderID, clientID)
-	if err != nil {
-		return fmt.Errorf("tx.ExecContext: %w", err)
-	}
+	var packagingData []box.AllData
 
-	var rows int64
-
-	rows, err = result.RowsAffected()
+	err := o.psqlDB.Select(
+		ctx,
+		&packagingData,
+		"SELECT v.box_id AS id, v.name, v.cost, v.is_check, v.weight, v.length, v.width, v.height, v.created_at, v.created_at AS updated_at "+
+			"FROM order_packaging p JOIN box_version v ON v.id = p.box_version_id WHERE p.order_id = $1 ORDER BY p.position",
+		orderID,
+	)
 	if err != nil {
-		return fmt.Errorf("result.RowsAffected: %w", err)
+		return []box.AllData{}, err
 	}
 
-	if rows == 0 {
-		return errlst.ErrClientIDNotFound
-	}
-
-	return nil
+	return packagingData, nil
 }
 
 // CountReturnedOrders is
-func (o *OrdersRepository) CountReturnedOrders(ctx context.Context) (int64, error) {
+func (o *OrdersRepository) CountReturnedOrders(ctx context.Context, listData order.ListRequestData) (int64, error) {
 	var totalCount int64
 
+	whereQuery, args := filterQuery(listData.Filter, "pvz_id = $1 AND status = $2", []interface{}{listData.PVZID, order.StatusHandedToCourier})
+
 	err := o.psqlDB.Get(
 		ctx,
 		&totalCount,
-		"SELECT COUNT(order_id) FROM orders WHERE returned_at IS NOT NULL",
+		"SELECT COUNT(order_id) FROM orders WHERE "+whereQuery,
+		args...,
 	)
 	if err != nil {
 		return 0, err
@@ -128,19 +148,20 @@ func (o *OrdersRepository) CountReturnedOrders(ctx context.Context) (int64, erro
 }
 
 // ListReturnedOrders is
-func (o *OrdersRepository) ListReturnedOrders(ctx context.Context, orderPaginationData abstract.PageData) ([]order.ReturnedData, error) {
+func (o *OrdersRepository) ListReturnedOrders(ctx context.Context, orderPaginationData order.ListRequestData) ([]order.ReturnedData, error) {
 	log.Printf("[order][repository][ListReturnedOrders]")
-	offset := (orderPaginationData.CurrentPage - 1) * orderPaginationData.ItemsPerPage
 
 	var orderReturnedListData []order.ReturnedData
 
+	whereQuery, args := filterQuery(orderPaginationData.Filter, "pvz_id = $1 AND status = $2", []interface{}{orderPaginationData.PVZID, order.StatusHandedToCourier})
+	pageQuery, args := paginationQuery(orderPaginationData.PageData, args)
+
 	err := o.psqlDB.Select(
 		ctx,
 		&orderReturnedListData,
-		"SELECT order_id, client_id, returned_at FROM orders WHERE returned_at IS NOT NULL "+
-			offsetLimitQuery,
-		offset,
-		orderPaginationData.ItemsPerPage,
+		"SELECT order_id, client_id, returned_at FROM orders WHERE "+whereQuery+
+			orderByQuery(orderPaginationData.Filter, "returned_at DESC, order_id DESC")+pageQuery,
+		args...,
 	)
 	if err != nil {
 		return []order.ReturnedData{}, err
@@ -149,32 +170,85 @@ func (o *OrdersRepository) ListReturnedOrders(ctx context.Context, orderPaginati
 	return orderReturnedListData, nil
 }
 
-// UpdateAcceptOrder is
-func (o *OrdersRepository) UpdateAcceptOrder(ctx context.Context, acceptOrderData order.RequestWithClientIDData) error {
-	log.Printf("[order][repository][UpdateAcceptOrder]")
+// GetOrderState is, the box name is the one of the outer packaging layer as it was when the order was received,
+// so renaming the box later does not change the return window of the order
+func (o *OrdersRepository) GetOrderState(ctx context.Context, orderID int64, pvzID int64) (order.StateData, error) {
+	log.Printf("[order][repository][GetOrderState]")
+	var stateData order.StateData
 
-	result, err := o.psqlDB.Execute(ctx, "UPDATE orders SET accepted_at = $1, updated_at = $2 WHERE order_id=$3 AND client_id = $4 AND returned_at IS NULL AND accepted_at IS NULL", time.Now(), time.Now(), acceptOrderData.OrderID, acceptOrderData.ClientID)
+	err := o.psqlDB.Get(
+		ctx,
+		&stateData,
+		"SELECT o.order_id, o.pvz_id, o.client_id, o.box_id, v.name AS box_name, o.weight, o.length, o.width, o.height, o.status, o.expires_at, o.issued_at, o.created_at "+
+			"FROM orders o "+
+			"JOIN LATERAL (SELECT box_version_id FROM order_packaging WHERE order_id = o.order_id ORDER BY position DESC LIMIT 1) p ON TRUE "+
+			"JOIN box_version v ON v.id = p.box_version_id "+
+			"WHERE o.order_id = $1 AND o.pvz_id = $2 FOR UPDATE OF o",
+		orderID,
+		pvzID,
+	)
 	if err != nil {
-		return fmt.Errorf("o.psqlDB.ExecContext: %w", err)
-	}
+		if errors.Is(err, sql.ErrNoRows) {
+			return order.StateData{}, errlst.ErrOrderNotFound
+		}
 
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("result.RowsAffected: %w", err)
+		return order.StateData{}, err
 	}
 
-	if rows == 0 {
-		return errlst.ErrOrderNotFound
+	return stateData, nil
+}
+
+// ListOverdueOrders is, rows locked by another sweeper are skipped
+func (o *OrdersRepository) ListOverdueOrders(ctx context.Context, limit int) ([]order.StateData, error) {
+	log.Printf("[order][repository][ListOverdueOrders]")
+
+	var stateData []order.StateData
+
+	err := o.psqlDB.Select(
+		ctx,
+		&stateData,
+		"SELECT order_id, pvz_id, client_id, box_id, status, expires_at, issued_at FROM orders WHERE status = $1 AND expires_at < NOW() "+
+			"ORDER BY expires_at LIMIT $2 FOR UPDATE SKIP LOCKED",
+		order.StatusReceived,
+		limit,
+	)
+	if err != nil {
+		return []order.StateData{}, err
 	}
 
-	return nil
+	return stateData, nil
 }
 
-// DeleteReturnOrder is
-func (o *OrdersRepository) DeleteReturnOrder(ctx context.Context, orderID int64) error {
-	log.Printf("[order][repository][DeleteReturnOrder]")
+// UpdateStatus is, the cell of the transition is taken when it is set
+func (o *OrdersRepository) UpdateStatus(ctx context.Context, transitionData order.StatusTransitionData) error {
+	log.Printf("[order][repository][UpdateStatus]")
 
-	result, err := o.psqlDB.Execute(ctx, "UPDATE orders SET returned_at = $1, updated_at = $2  WHERE order_id=$3 AND returned_at IS NULL", time.Now(), time.Now(), orderID)
+	args := []interface{}{
+		transitionData.ToStatus,
+		transitionData.OrderID,
+		transitionData.PVZID,
+		transitionData.FromStatus,
+	}
+
+	setTimestamp := ""
+	if column, ok := statusTimestampColumn[transitionData.ToStatus]; ok {
+		setTimestamp = column + " = NOW(), "
+	}
+	switch {
+	case cellFreeingStatuses[transitionData.ToStatus]:
+		setTimestamp += "cell_id = NULL, "
+	case transitionData.CellID != nil:
+		args = append(args, *transitionData.CellID)
+		setTimestamp += fmt.Sprintf("cell_id = $%d, ", len(args))
+	}
+
+	result, err := o.psqlDB.Execute(
+		ctx,
+		"WITH updated AS (UPDATE orders SET status = $1, "+setTimestamp+"updated_at = NOW() "+
+			"WHERE order_id = $2 AND pvz_id = $3 AND status = $4 RETURNING order_id) "+
+			"INSERT INTO order_status_history(order_id, from_status, to_status) SELECT order_id, $4, $1 FROM updated",
+		args...,
+	)
 	if err != nil {
 		return fmt.Errorf("o.psqlDB.ExecContext: %w", err)
 	}
@@ -192,13 +266,16 @@ func (o *OrdersRepository) DeleteReturnOrder(ctx context.Context, orderID int64)
 }
 
 // CountOrders is
-func (o *OrdersRepository) CountOrders(ctx context.Context) (int64, error) {
+func (o *OrdersRepository) CountOrders(ctx context.Context, listData order.ListRequestData) (int64, error) {
 	var totalCount int64
 
+	whereQuery, args := filterQuery(listData.Filter, "pvz_id = $1 AND status <> $2", []interface{}{listData.PVZID, order.StatusHandedToCourier})
+
 	err := o.psqlDB.Get(
 		ctx,
 		&totalCount,
-		"SELECT COUNT(order_id) FROM orders WHERE returned_at IS NULL",
+		"SELECT COUNT(order_id) FROM orders WHERE "+whereQuery,
+		args...,
 	)
 	if err != nil {
 		return 0, err
@@ -208,19 +285,20 @@ func (o *OrdersRepository) CountOrders(ctx context.Context) (int64, error) {
 }
 
 // ListOrders is
-func (o *OrdersRepository) ListOrders(ctx context.Context, orderPaginationData abstract.PageData) ([]order.AllResponseData, error) {
-	log.Printf("[order][repository][DeleteReturnOrder]")
-	offset := (orderPaginationData.CurrentPage - 1) * orderPaginationData.ItemsPerPage
+func (o *OrdersRepository) ListOrders(ctx context.Context, orderPaginationData order.ListRequestData) ([]order.AllResponseData, error) {
+	log.Printf("[order][repository][ListOrders]")
 
 	var orderListData []order.AllResponseData
 
+	whereQuery, args := filterQuery(orderPaginationData.Filter, "pvz_id = $1 AND status <> $2", []interface{}{orderPaginationData.PVZID, order.StatusHandedToCourier})
+	pageQuery, args := paginationQuery(orderPaginationData.PageData, args)
+
 	err := o.psqlDB.Select(
 		ctx,
 		&orderListData,
-		"SELECT order_id, box_id, client_id, accepted_at, issued_at, expires_at, created_at, updated_at FROM orders WHERE returned_at IS NULL ORDER BY created_at DESC "+
-			"OFFSET $1 LIMIT $2",
-		offset,
-		orderPaginationData.ItemsPerPage,
+		"SELECT order_id, box_id, pvz_id, client_id, weight, length, width, height, "+packagingColumn("orders")+", accepted_at, issued_at, expires_at, created_at, updated_at FROM orders WHERE "+whereQuery+
+			orderByQuery(orderPaginationData.Filter, "created_at DESC")+pageQuery,
+		args...,
 	)
 	if err != nil {
 		return []order.AllResponseData{}, err
@@ -230,13 +308,16 @@ func (o *OrdersRepository) ListOrders(ctx context.Context, orderPaginationData a
 }
 
 // CountUniqueClients is
-func (o *OrdersRepository) CountUniqueClients(ctx context.Context) (int64, error) {
+func (o *OrdersRepository) CountUniqueClients(ctx context.Context, listData order.ListRequestData) (int64, error) {
 	var totalCount int64
 
+	whereQuery, args := filterQuery(listData.Filter, "pvz_id = $1", []interface{}{listData.PVZID})
+
 	err := o.psqlDB.Get(
 		ctx,
 		&totalCount,
-		"SELECT COUNT(DISTINCT client_id) FROM orders",
+		"SELECT COUNT(DISTINCT client_id) FROM orders WHERE "+whereQuery,
+		args...,
 	)
 	if err != nil {
 		return 0, err
@@ -246,18 +327,19 @@ func (o *OrdersRepository) CountUniqueClients(ctx context.Context) (int64, error
 }
 
 // ListUniqueClients is
-func (o *OrdersRepository) ListUniqueClients(ctx context.Context, clientPaginationData abstract.PageData) ([]order.ListUniqueClientsData, error) {
+func (o *OrdersRepository) ListUniqueClients(ctx context.Context, clientPaginationData order.ListRequestData) ([]order.ListUniqueClientsData, error) {
 	log.Printf("[order][repository][UniqueClientsList]")
-	offset := (clientPaginationData.CurrentPage - 1) * clientPaginationData.ItemsPerPage
 
 	var clientListData []order.ListUniqueClientsData
 
+	whereQuery, args := filterQuery(clientPaginationData.Filter, "pvz_id = $1", []interface{}{clientPaginationData.PVZID})
+	pageQuery, args := paginationQuery(clientPaginationData.PageData, args)
+
 	err := o.psqlDB.Select(
 		ctx,
 		&clientListData,
-		"SELECT DISTINCT ON (client_id) client_id FROM orders OFFSET $1 LIMIT $2",
-		offset,
-		clientPaginationData.ItemsPerPage,
+		"SELECT DISTINCT ON (client_id) client_id FROM orders WHERE "+whereQuery+pageQuery,
+		args...,
 	)
 	if err != nil {
 		return []order.ListUniqueClientsData{}, err
@@ -265,3 +347,179 @@ func (o *OrdersRepository) ListUniqueClients(ctx context.Context, clientPaginati
 
 	return clientListData, nil
 }
+
+// CountLiveOrders is
+func (o *OrdersRepository) CountLiveOrders(ctx context.Context, pvzID int64) (int64, error) {
+	var totalCount int64
+
+	err := o.psqlDB.Get(
+		ctx,
+		&totalCount,
+		"SELECT COUNT(order_id) FROM orders WHERE pvz_id = $1 AND status IN ($2, $3, $4)",
+		pvzID,
+		order.StatusReceived,
+		order.StatusReturnedByClient,
+		order.StatusExpired,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return totalCount, nil
+}
+
+// CountLiveOrdersByBox is, an order uses the box when any of its packaging layers is that box
+func (o *OrdersRepository) CountLiveOrdersByBox(ctx context.Context, boxID int64) (int64, error) {
+	var totalCount int64
+
+	err := o.psqlDB.Get(
+		ctx,
+		&totalCount,
+		"SELECT COUNT(DISTINCT o.order_id) FROM orders o JOIN order_packaging p ON p.order_id = o.order_id "+
+			"WHERE p.box_id = $1 AND o.status IN ($2, $3, $4)",
+		boxID,
+		order.StatusReceived,
+		order.StatusReturnedByClient,
+		order.StatusExpired,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return totalCount, nil
+}
+
+// ListExistingOrderIDs is, the ids of the given orders that are already in the storage
+func (o *OrdersRepository) ListExistingOrderIDs(ctx context.Context, orderIDs []int64) ([]int64, error) {
+	log.Printf("[order][repository][ListExistingOrderIDs]")
+	var existing []int64
+
+	err := o.psqlDB.Select(ctx, &existing, "SELECT order_id FROM orders WHERE order_id = ANY($1)", pq.Array(orderIDs))
+	if err != nil {
+		return nil, fmt.Errorf("o.psqlDB.Select: %w", err)
+	}
+
+	return existing, nil
+}
+
+// GetOrderByID is, the box details are the ones of the outer packaging layer as it was when the order was received,
+// a box version never changes, so the details stay right in the cache after the box is updated
+func (o *OrdersRepository) GetOrderByID(ctx context.Context, orderID int64, pvzID int64) (order.DetailsData, error) {
+	log.Printf("[order][repository][GetOrderByID]")
+	var detailsData order.DetailsData
+
+	err := o.psqlDB.Get(
+		ctx,
+		&detailsData,
+		"SELECT o.order_id, o.box_id, o.pvz_id, o.client_id, o.weight, o.length, o.width, o.height, "+packagingColumn("o")+", o.status, o.accepted_at, o.issued_at, o.returned_at, o.expires_at, o.created_at, o.updated_at, "+
+			"v.name AS box_name, v.cost AS box_cost, v.is_check AS box_is_check, v.weight AS box_weight, v.length AS box_length, v.width AS box_width, v.height AS box_height, v.created_at AS box_created_at, v.created_at AS box_updated_at, "+
+			"c.code AS cell_code FROM orders o "+
+			"JOIN LATERAL (SELECT box_version_id FROM order_packaging WHERE order_id = o.order_id ORDER BY position DESC LIMIT 1) p ON TRUE "+
+			"JOIN box_version v ON v.id = p.box_version_id "+
+			"LEFT JOIN pvz_cell c ON c.id = o.cell_id WHERE o.order_id = $1 AND o.pvz_id = $2",
+		orderID,
+		pvzID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return order.DetailsData{}, errlst.ErrOrderNotFound
+		}
+
+		return order.DetailsData{}, err
+	}
+
+	return detailsData, nil
+}
+
+// ListStatusHistory is
+func (o *OrdersRepository) ListStatusHistory(ctx context.Context, orderID int64) ([]order.StatusChangeData, error) {
+	log.Printf("[order][repository][ListStatusHistory]")
+
+	var statusChangesData []order.StatusChangeData
+
+	err := o.psqlDB.Select(
+		ctx,
+		&statusChangesData,
+		"SELECT from_status, to_status, created_at FROM order_status_history WHERE order_id = $1 ORDER BY created_at, id",
+		orderID,
+	)
+	if err != nil {
+		return []order.StatusChangeData{}, err
+	}
+
+	return statusChangesData, nil
+}
+
+// packagingColumn is the box ids of the order packaging layers from the innermost one
+func packagingColumn(ordersTable string) string {
+	return "ARRAY(SELECT p.box_id FROM order_packaging p WHERE p.order_id = " + ordersTable + ".order_id ORDER BY p.position) AS packaging"
+}
+
+// filterQuery joins the base condition with the set filter fields, the values are appended to args as parameters
+func filterQuery(filter order.FilterData, baseQuery string, args []interface{}) (string, []interface{}) {
+	conditions := []string{baseQuery}
+	addCondition := func(condition string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.ClientID != 0 {
+		addCondition("client_id = $%d", filter.ClientID)
+	}
+	if filter.BoxID != 0 {
+		addCondition("EXISTS (SELECT 1 FROM order_packaging p WHERE p.order_id = orders.order_id AND p.box_id = $%d)", filter.BoxID)
+	}
+	// a received order whose storage period is over is listed as expired, the same way order.Status.At reports it
+	switch filter.Status {
+	case "":
+	case order.StatusExpired:
+		addCondition("(status = $%d OR (status = 'received' AND expires_at < NOW()))", filter.Status)
+	case order.StatusReceived:
+		addCondition("status = $%d AND (expires_at IS NULL OR expires_at >= NOW())", filter.Status)
+	default:
+		addCondition("status = $%d", filter.Status)
+	}
+	if filter.CreatedFrom != nil {
+		addCondition("created_at >= $%d", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		addCondition("created_at <= $%d", *filter.CreatedTo)
+	}
+	if filter.ExpiresFrom != nil {
+		addCondition("expires_at >= $%d", *filter.ExpiresFrom)
+	}
+	if filter.ExpiresTo != nil {
+		addCondition("expires_at <= $%d", *filter.ExpiresTo)
+	}
+	if filter.MinWeight > 0 {
+		addCondition("weight >= $%d", filter.MinWeight)
+	}
+	if filter.MaxWeight > 0 {
+		addCondition("weight <= $%d", filter.MaxWeight)
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// orderByQuery is
+func orderByQuery(filter order.FilterData, defaultOrder string) string {
+	column, ok := sortColumns[filter.SortBy]
+	if !ok {
+		return " ORDER BY " + defaultOrder
+	}
+
+	direction := "DESC"
+	if filter.SortDirection == "asc" {
+		direction = "ASC"
+	}
+
+	return " ORDER BY " + column + " " + direction + ", order_id " + direction
+}
+
+// paginationQuery is
+func paginationQuery(page abstract.PageData, args []interface{}) (string, []interface{}) {
+	offset := (page.CurrentPage - 1) * page.ItemsPerPage
+	args = append(args, offset, page.ItemsPerPage)
+
+	return fmt.Sprintf(" OFFSET $%d LIMIT $%d", len(args)-1, len(args)), args
+}
@@ -0,0 +1,93 @@
+package sweeper
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"Homework-1/internal/config"
+	"Homework-1/internal/kafka"
+	kafkaModel "Homework-1/internal/model/kafka"
+	"Homework-1/internal/order"
+	"Homework-1/pkg/constants"
+)
+
+// OrderExpiredEvent is
+const OrderExpiredEvent = "order.expired"
+
+// Sweeper is a background worker that moves orders past expires_at to the expired status
+type Sweeper struct {
+	useCase   order.UseCase
+	producer  kafka.Producer
+	topic     string
+	interval  time.Duration
+	batchSize int
+}
+
+// NewSweeper is
+func NewSweeper(useCase order.UseCase, producer kafka.Producer, topic string, cfg config.ExpirySweeper) *Sweeper {
+	interval := constants.ExpirySweepInterval
+	if cfg.IntervalSeconds > 0 {
+		interval = time.Duration(cfg.IntervalSeconds) * time.Second
+	}
+
+	batchSize := constants.ExpirySweepBatchSize
+	if cfg.BatchSize > 0 {
+		batchSize = cfg.BatchSize
+	}
+
+	return &Sweeper{
+		useCase:   useCase,
+		producer:  producer,
+		topic:     topic,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Run blocks until ctx is done
+func (s *Sweeper) Run(ctx context.Context) {
+	log.Printf("[order][sweeper][Run] Expiry sweeper is started, interval %v, batch size %d\n", s.interval, s.batchSize)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("[order][sweeper][Run] Expiry sweeper is stopped")
+			return
+		case <-ticker.C:
+			s.Sweep(ctx)
+		}
+	}
+}
+
+// Sweep expires overdue orders batch by batch until a batch comes back not full
+func (s *Sweeper) Sweep(ctx context.Context) {
+	for ctx.Err() == nil {
+		transitions, err := s.useCase.ExpireOverdueOrders(ctx, s.batchSize)
+		if err != nil {
+			log.Printf("[order][sweeper][Sweep] s.useCase.ExpireOverdueOrders: %v", err)
+			return
+		}
+
+		for _, transitionData := range transitions {
+			err = s.producer.SendEvent(s.topic, kafkaModel.OrderEvent{
+				Type:       OrderExpiredEvent,
+				OrderID:    transitionData.OrderID,
+				PVZID:      transitionData.PVZID,
+				FromStatus: string(transitionData.FromStatus),
+				ToStatus:   string(transitionData.ToStatus),
+				Timestamp:  time.Now(),
+			})
+			if err != nil {
+				log.Printf("[order][sweeper][Sweep] s.producer.SendEvent: %v", err)
+			}
+		}
+
+		if len(transitions) < s.batchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package sweeper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"Homework-1/internal/config"
+	kafkaMock "Homework-1/internal/kafka/mock"
+	kafkaModel "Homework-1/internal/model/kafka"
+	orderModel "Homework-1/internal/model/order"
+	orderMock "Homework-1/internal/order/mock"
+)
+
+// TestSweeper_Sweep is
+func TestSweeper_Sweep(t *testing.T) {
+	t.Parallel()
+
+	expired := func(orderID int64) orderModel.StatusTransitionData {
+		return orderModel.StatusTransitionData{
+			OrderID:    orderID,
+			PVZID:      1,
+			FromStatus: orderModel.StatusReceived,
+			ToStatus:   orderModel.StatusExpired,
+		}
+	}
+
+	tests := []*struct {
+		description string
+		batches     [][]orderModel.StatusTransitionData
+		batchErr    error
+		wantCalls   uint64
+		wantEvents  []int64
+	}{
+		{
+			description: "Nothing to expire",
+			batches:     [][]orderModel.StatusTransitionData{{}},
+			wantCalls:   1,
+			wantEvents:  nil,
+		},
+		{
+			description: "Full batch is followed by the next one",
+			batches: [][]orderModel.StatusTransitionData{
+				{expired(1), expired(2)},
+				{expired(3)},
+			},
+			wantCalls:  2,
+			wantEvents: []int64{1, 2, 3},
+		},
+		{
+			description: "Use case error stops the sweep",
+			batches:     [][]orderModel.StatusTransitionData{nil},
+			batchErr:    assert.AnError,
+			wantCalls:   1,
+			wantEvents:  nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctrl := minimock.NewController(t)
+
+			call := 0
+			useCase := orderMock.NewUseCaseMock(ctrl).ExpireOverdueOrdersMock.
+				Set(func(_ context.Context, batchSize int) ([]orderModel.StatusTransitionData, error) {
+					assert.Equal(t, 2, batchSize)
+					batch := tt.batches[call]
+					call++
+					return batch, tt.batchErr
+				})
+
+			var events []int64
+			producer := kafkaMock.NewProducerMock(ctrl)
+			if tt.wantEvents != nil {
+				producer.SendEventMock.Set(func(topic string, event kafkaModel.OrderEvent) error {
+					assert.Equal(t, "order_events", topic)
+					assert.Equal(t, OrderExpiredEvent, event.Type)
+					events = append(events, event.OrderID)
+					return nil
+				})
+			}
+
+			NewSweeper(useCase, producer, "order_events", config.ExpirySweeper{BatchSize: 2}).Sweep(context.Background())
+
+			assert.Equal(t, tt.wantCalls, useCase.ExpireOverdueOrdersAfterCounter())
+			assert.Equal(t, tt.wantEvents, events)
+		})
+	}
+}
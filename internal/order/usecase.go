@@ -13,10 +13,15 @@ import (
 // UseCase is
 type UseCase interface {
 	CreateReceiveOrder(ctx context.Context, request orderModel.Request) error
-	IssueOrders(ctx context.Context, request orderModel.RequestOrderIDs) (float64, error)
-	ReturnedOrders(ctx context.Context, request abstract.Page) (abstract.PaginatedResponse[orderModel.ReturnedResponse], error)
+	ReceiveOrdersBatch(ctx context.Context, request orderModel.BatchRequest) (orderModel.BatchResponse, error)
+	IssueOrders(ctx context.Context, request orderModel.RequestOrderIDs) (orderModel.IssueResponse, error)
+	QuoteIssue(ctx context.Context, request orderModel.RequestOrderIDs) (orderModel.QuoteResponse, error)
+	ReturnedOrders(ctx context.Context, request orderModel.ListRequest) (abstract.PaginatedResponse[orderModel.ReturnedResponse], error)
 	UpdateAcceptOrder(ctx context.Context, request orderModel.RequestWithClientID) error
-	DeleteReturnedOrder(ctx context.Context, orderID int64) error
-	OrderList(ctx context.Context, request abstract.Page) (abstract.PaginatedResponse[orderModel.AllResponse], error)
-	UniqueClientsList(ctx context.Context, request abstract.Page) (abstract.PaginatedResponse[orderModel.ListUniqueClients], error)
+	DeleteReturnedOrder(ctx context.Context, request orderModel.IDRequest) error
+	OrderList(ctx context.Context, request orderModel.ListRequest) (abstract.PaginatedResponse[orderModel.AllResponse], error)
+	UniqueClientsList(ctx context.Context, request orderModel.ListRequest) (abstract.PaginatedResponse[orderModel.ListUniqueClients], error)
+	GetOrderByID(ctx context.Context, request orderModel.IDRequest) (orderModel.DetailsResponse, error)
+	GetOrderHistory(ctx context.Context, request orderModel.IDRequest) (orderModel.HistoryResponse, error)
+	ExpireOverdueOrders(ctx context.Context, batchSize int) ([]orderModel.StatusTransitionData, error)
 }
@@ -2,25 +2,36 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"log"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	"Homework-1/internal/cache"
+	"Homework-1/internal/config"
 	"Homework-1/internal/database"
 	"Homework-1/internal/model/abstract"
 	"Homework-1/internal/model/box"
 	"Homework-1/internal/model/order"
+	pricingModel "Homework-1/internal/model/pricing"
+	pvzModel "Homework-1/internal/model/pvz"
+	"Homework-1/internal/pricing"
 	"Homework-1/pkg/constants"
+	"Homework-1/pkg/errlst"
 	"Homework-1/pkg/tracing"
 )
 
+// errQuoteRollback is returned from the QuoteIssue transaction so that it is never committed
+var errQuoteRollback = errors.New("quote is rolled back")
+
 var (
 	totalIssuedOrders = promauto.NewCounter(
 		prometheus.CounterOpts{
@@ -32,13 +43,20 @@ var (
 
 // OrderUseCase is
 type OrderUseCase struct {
-	repo  database.Datastore
-	cache cache.Store
+	repo         database.Datastore
+	cache        cache.Store
+	returnPolicy config.ReturnPolicy
+	volumetric   config.Volumetric
 }
 
 // NewOrderUseCase is
-func NewOrderUseCase(repo database.Datastore, cache cache.Store) *OrderUseCase {
-	return &OrderUseCase{repo: repo, cache: cache}
+func NewOrderUseCase(
+	repo database.Datastore,
+	cache cache.Store,
+	returnPolicy config.ReturnPolicy,
+	volumetric config.Volumetric,
+) *OrderUseCase {
+	return &OrderUseCase{repo: repo, cache: cache, returnPolicy: returnPolicy, volumetric: volumetric}
 }
 
 // CreateReceiveOrder is
@@ -48,121 +66,600 @@ func (o *OrderUseCase) CreateReceiveOrder(ctx context.Context, request order.Req
 	ctx, span := tracer.Start(ctx, "[CreateReceiveOrder]")
 	defer span.End()
 
-	cacheArgument := abstract.CacheArgument{
-		ObjectType: "box",
-		ObjectID:   request.BoxID,
-	}
-
-	var response box.AllResponse
-
-	boxValue, redErr := o.cache.Get(ctx, cacheArgument)
-	if redErr == nil && json.Unmarshal(boxValue, &response) == nil && !((response.IsCheck && response.Weight > request.Weight) || !response.IsCheck) {
-		err := o.repo.OrderRepo().CreateReceiveOrder(ctx, request.ToStorage())
+	packaging := make([]box.AllResponse, len(request.Packaging))
+	for index, boxID := range request.Packaging {
+		boxResponse, err := o.packagingBox(ctx, span, boxID)
 		if err != nil {
 			tracing.ErrorTracer(span, err)
 			return err
 		}
-
-		span.SetStatus(codes.Ok, "Successfully created a order")
-		return nil
+		packaging[index] = boxResponse
 	}
 
 	if err := o.repo.WithTransaction(ctx, func(db database.Datastore) error {
-		boxData, err := db.BoxRepo().GetBox(ctx, request.BoxID)
+		tariff, err := activeTariff(ctx, db)
 		if err != nil {
-			tracing.ErrorTracer(span, err)
 			return err
 		}
-		response = boxData.ToServer()
 
-		if !((response.IsCheck && response.Weight > request.Weight) || !response.IsCheck) {
-			tracing.ErrorTracer(span, errors.New("invalid weight request"))
-			return err
-		}
-		err = db.OrderRepo().CreateReceiveOrder(ctx, request.ToStorage())
+		err = o.checkReceive(request, packaging, tariff, func(innerID int64, outerID int64) (bool, error) {
+			return db.BoxRepo().CanWrap(ctx, innerID, outerID)
+		})
 		if err != nil {
-			tracing.ErrorTracer(span, err)
 			return err
 		}
 
-		return nil
+		return receiveOrder(ctx, db, request, make(map[int64]pvzModel.Schedule))
 	}); err != nil {
 		tracing.ErrorTracer(span, err)
 		return err
 	}
 
+	span.SetStatus(codes.Ok, "Successfully created a order")
+	return nil
+}
+
+// packagingBox is the cached box or, on a miss, the box read from the database that is cached for
+// constants.BoxReceiveTimeDuration only. Nothing is written back once the order is received, so a box that
+// UpdateBox dropped from the cache in the meantime is not put back stale. A deleted or archived box is
+// still refused, receiveOrder checks the boxes again under a lock
+func (o *OrderUseCase) packagingBox(ctx context.Context, span trace.Span, boxID int64) (box.AllResponse, error) {
+	cacheArgument := abstract.CacheArgument{ObjectType: "box", ObjectID: boxID}
+
+	var response box.AllResponse
+
+	cachedValue, err := o.cache.Get(ctx, cacheArgument)
+	if err == nil && json.Unmarshal(cachedValue, &response) == nil {
+		return response, nil
+	}
+
+	boxData, err := o.repo.BoxRepo().GetBox(ctx, boxID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return box.AllResponse{}, errlst.ErrBoxNotFound
+		}
+
+		return box.AllResponse{}, err
+	}
+
+	response = boxData.ToServer()
+
 	marshaledData, err := json.Marshal(response)
+	if err == nil {
+		err = o.cache.Set(ctx, cacheArgument, marshaledData, constants.BoxReceiveTimeDuration)
+	}
 	if err != nil {
+		log.Printf("[order][usecase][packagingBox] o.cache.Set: %v", err)
 		tracing.ErrorTracer(span, err)
-		log.Printf("[order][usecase][CreateReceiveOrder] json.Marshal: %v", err)
-		return nil
 	}
 
-	if err = o.cache.Set(ctx, cacheArgument, marshaledData, constants.BoxTimeDuration); err != nil {
+	return response, nil
+}
+
+// ReceiveOrdersBatch receives a manifest with a single lookup of its boxes, wrap rules and tariff.
+// Lines are inserted in chunks of constants.ReceiveBatchChunkSize and a failed chunk is retried line by line
+// so that only the failed lines are skipped, a strict batch is inserted in one transaction and nothing is received
+// when any line fails
+func (o *OrderUseCase) ReceiveOrdersBatch(ctx context.Context, request order.BatchRequest) (order.BatchResponse, error) {
+	log.Println("[order][useCase][ReceiveOrdersBatch]")
+	tracer := otel.Tracer("[order][useCase]")
+	ctx, span := tracer.Start(ctx, "[ReceiveOrdersBatch]")
+	defer span.End()
+
+	boxIDs := lo.Uniq(lo.FlatMap(request.Lines, func(item order.BatchLine, _ int) []int64 {
+		return item.Request.Packaging
+	}))
+
+	boxesData, err := o.repo.BoxRepo().GetBoxes(ctx, boxIDs)
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return order.BatchResponse{}, err
+	}
+
+	wrapRules, err := o.repo.BoxRepo().ListWrapRules(ctx, boxIDs)
+	if err != nil {
 		tracing.ErrorTracer(span, err)
-		log.Printf("[order][usecase][CreateReceiveOrder] o.cache.Set: %v", err)
+		return order.BatchResponse{}, err
+	}
+
+	tariff, err := activeTariff(ctx, o.repo)
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return order.BatchResponse{}, err
+	}
+
+	boxes := lo.SliceToMap(boxesData, func(item box.AllData) (int64, box.AllResponse) {
+		return item.ID, item.ToServer()
+	})
+	wraps := lo.SliceToMap(wrapRules, func(item box.WrapRuleData) (box.WrapRuleData, bool) {
+		return item, true
+	})
+	canWrap := func(innerID int64, outerID int64) (bool, error) {
+		return wraps[box.WrapRuleData{InnerBoxID: innerID, OuterBoxID: outerID}], nil
+	}
+
+	results := make([]order.BatchLineResult, len(request.Lines))
+	accepted := make([]int, 0, len(request.Lines))
+	schedules := make(map[int64]pvzModel.Schedule)
+	seenOrderIDs := make(map[int64]bool, len(request.Lines))
+
+	for index, line := range request.Lines {
+		results[index] = order.BatchLineResult{Line: line.Line, OrderID: line.Request.OrderID}
+
+		if seenOrderIDs[line.Request.OrderID] {
+			results[index].Code = order.BatchCodeDuplicateLine
+			results[index].Message = "Order is listed in the manifest more than once"
+			continue
+		}
+		seenOrderIDs[line.Request.OrderID] = true
+
+		packaging, err := batchPackaging(line.Request.Packaging, boxes)
+		if err == nil {
+			err = o.checkReceive(line.Request, packaging, tariff, canWrap)
+		}
+		if err != nil {
+			results[index].Code, results[index].Message = receiveResultCode(err), err.Error()
+			continue
+		}
+
+		accepted = append(accepted, index)
+	}
+
+	if request.DryRun {
+		err = o.checkNotReceived(ctx, request.Lines, accepted, results)
+		if err != nil {
+			tracing.ErrorTracer(span, err)
+			return order.BatchResponse{}, err
+		}
+
+		span.SetStatus(codes.Ok, "Successfully checked batch of Orders")
+		return batchResponse(results), nil
+	}
+
+	if request.Strict && len(accepted) < len(request.Lines) {
+		for _, index := range accepted {
+			results[index].Code = order.BatchCodeNotProcessed
+		}
+
+		span.SetStatus(codes.Ok, "Strict batch was not received")
+		return batchResponse(results), nil
+	}
+
+	chunkSize := constants.ReceiveBatchChunkSize
+	if request.Strict {
+		chunkSize = len(accepted)
+	}
+
+	for _, chunk := range lo.Chunk(accepted, max(chunkSize, 1)) {
+		failedIndex := -1
+		err := o.repo.WithTransaction(ctx, func(db database.Datastore) error {
+			for _, index := range chunk {
+				if err := receiveOrder(ctx, db, request.Lines[index].Request, schedules); err != nil {
+					failedIndex = index
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err == nil {
+			for _, index := range chunk {
+				results[index].Code = order.BatchCodeReceived
+			}
+			continue
+		}
+
+		tracing.ErrorTracer(span, err)
+
+		if request.Strict {
+			if failedIndex < 0 {
+				return order.BatchResponse{}, err
+			}
+
+			for _, index := range chunk {
+				results[index].Code = order.BatchCodeNotProcessed
+			}
+			results[failedIndex].Code, results[failedIndex].Message = receiveResultCode(err), err.Error()
+			break
+		}
+
+		for _, index := range chunk {
+			err := o.repo.WithTransaction(ctx, func(db database.Datastore) error {
+				return receiveOrder(ctx, db, request.Lines[index].Request, schedules)
+			})
+			if err != nil {
+				results[index].Code, results[index].Message = receiveResultCode(err), err.Error()
+				continue
+			}
+
+			results[index].Code = order.BatchCodeReceived
+		}
+	}
+
+	span.SetStatus(codes.Ok, "Successfully received batch of Orders")
+	return batchResponse(results), nil
+}
+
+// receiveOrder stores the order in a free cell of its PVZ, the cell stays taken until the order is issued or turned in.
+// The order expires at the end of its storage days counted by the PVZ schedule, schedules keeps the ones already read.
+// The PVZ and the packaging boxes are locked first, so they can not be deleted or archived while the order is received
+func receiveOrder(ctx context.Context, db database.Datastore, request order.Request, schedules map[int64]pvzModel.Schedule) error {
+	if err := db.PvzRepo().ShareLivePVZ(ctx, request.PVZID); err != nil {
+		return err
+	}
+
+	if err := db.BoxRepo().ShareLiveBoxes(ctx, request.Packaging); err != nil {
+		return err
+	}
+
+	schedule, ok := schedules[request.PVZID]
+	if !ok {
+		scheduleData, err := db.PvzRepo().GetSchedule(ctx, request.PVZID)
+		if err != nil {
+			return err
+		}
+
+		schedule = scheduleData.ToServer()
+		schedules[request.PVZID] = schedule
+	}
+
+	expiresAt, err := schedule.ExpiresAt(time.Now(), request.ExpireTimeDuration)
+	if err != nil {
+		return err
+	}
+
+	cellID, err := db.PvzRepo().FindFreeCell(ctx, request.PVZID, request.Weight)
+	if err != nil {
+		return err
+	}
+
+	orderData := request.ToStorage()
+	orderData.CellID = cellID
+	orderData.ExpiresAt = expiresAt
+
+	return db.OrderRepo().CreateReceiveOrder(ctx, orderData)
+}
+
+// checkNotReceived marks the accepted lines of a dry run as valid unless their orders are already received
+func (o *OrderUseCase) checkNotReceived(
+	ctx context.Context,
+	lines []order.BatchLine,
+	accepted []int,
+	results []order.BatchLineResult,
+) error {
+	orderIDs := lo.Map(accepted, func(index int, _ int) int64 {
+		return lines[index].Request.OrderID
+	})
+
+	existingIDs, err := o.repo.OrderRepo().ListExistingOrderIDs(ctx, orderIDs)
+	if err != nil {
+		return err
+	}
+
+	existing := lo.SliceToMap(existingIDs, func(orderID int64) (int64, bool) {
+		return orderID, true
+	})
+
+	for _, index := range accepted {
+		if existing[lines[index].Request.OrderID] {
+			results[index].Code, results[index].Message = receiveResultCode(errlst.ErrOrderAlreadyExists), "Order already exists"
+			continue
+		}
+
+		results[index].Code = order.BatchCodeValid
 	}
 
-	span.SetStatus(codes.Ok, "Successfully created a order")
 	return nil
 }
 
+// checkReceive is the part of receiving an order that needs its packaging,
+// canWrap tells whether the outer box can wrap the inner one
+func (o *OrderUseCase) checkReceive(
+	request order.Request,
+	packaging []box.AllResponse,
+	tariff pricingModel.AllResponse,
+	canWrap func(innerID int64, outerID int64) (bool, error),
+) error {
+	for _, layer := range packaging {
+		if layer.ArchivedAt != nil {
+			return errlst.ErrBoxArchived
+		}
+	}
+
+	for index := 1; index < len(request.Packaging); index++ {
+		ok, err := canWrap(request.Packaging[index-1], request.Packaging[index])
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return errlst.ErrPackagingNotCompatible
+		}
+	}
+
+	return pricing.ValidateOrder(tariff, pricingModel.Item{
+		Packaging:         packagingLayers(packaging),
+		Weight:            request.Weight,
+		Dimensions:        request.Dimensions,
+		VolumetricDivisor: o.volumetric.WeightDivisor(),
+	})
+}
+
+// batchPackaging is
+func batchPackaging(boxIDs []int64, boxes map[int64]box.AllResponse) ([]box.AllResponse, error) {
+	packaging := make([]box.AllResponse, len(boxIDs))
+	for index, boxID := range boxIDs {
+		boxData, ok := boxes[boxID]
+		if !ok {
+			return nil, errlst.ErrBoxNotFound
+		}
+		packaging[index] = boxData
+	}
+
+	return packaging, nil
+}
+
+// batchResponse is
+func batchResponse(results []order.BatchLineResult) order.BatchResponse {
+	response := order.BatchResponse{Results: results}
+	for _, result := range results {
+		switch result.Code {
+		case order.BatchCodeReceived:
+			response.Received++
+		case order.BatchCodeValid:
+			response.Valid++
+		default:
+			response.Failed++
+		}
+	}
+
+	return response
+}
+
+// receiveResultCode is
+func receiveResultCode(err error) string {
+	switch {
+	case errors.Is(err, errlst.ErrBoxNotFound):
+		return "box_not_found"
+	case errors.Is(err, errlst.ErrBoxArchived):
+		return "box_archived"
+	case errors.Is(err, errlst.ErrPackagingNotCompatible):
+		return "packaging_not_compatible"
+	case errors.Is(err, errlst.ErrOrderDoesNotFit):
+		return "does_not_fit"
+	case errors.Is(err, errlst.ErrInvalidBoxLimit), errors.Is(err, errlst.ErrWeightNotPriced):
+		return "rejected_by_tariff"
+	case errors.Is(err, errlst.ErrPVZNotFound):
+		return "pvz_not_found"
+	case errors.Is(err, errlst.ErrPVZFull):
+		return "pvz_full"
+	case errors.Is(err, errlst.ErrOrderAlreadyExists):
+		return "already_exists"
+	default:
+		return "internal"
+	}
+}
+
 // IssueOrders is
-func (o *OrderUseCase) IssueOrders(ctx context.Context, request order.RequestOrderIDs) (float64, error) {
+func (o *OrderUseCase) IssueOrders(ctx context.Context, request order.RequestOrderIDs) (order.IssueResponse, error) {
 	log.Println("[order][useCase][IssueOrders]")
 	tracer := otel.Tracer("[order][useCase]")
 	ctx, span := tracer.Start(ctx, "[IssueOrders]")
 	defer span.End()
 
-	uniqueOrderIDs := make(map[int64]bool)
+	uniqueOrderIDs := lo.Uniq(request.OrderIDs)
 
-	for _, orderID := range request.OrderIDs {
-		uniqueOrderIDs[orderID] = true
-	}
-
-	var totalCost float64
+	var response order.IssueResponse
 	if err := o.repo.WithTransaction(ctx, func(db database.Datastore) error {
-		clientID, err := db.OrderRepo().GetClientID(ctx, request.OrderIDs[0])
+		tariff, err := activeTariff(ctx, db)
 		if err != nil {
 			tracing.ErrorTracer(span, err)
 			return err
 		}
 
-		for orderID := range uniqueOrderIDs {
-			err = db.OrderRepo().UpdateIssueOrder(ctx, orderID, clientID)
+		for _, orderID := range uniqueOrderIDs {
+			orderState, err := db.OrderRepo().GetOrderState(ctx, orderID, request.PVZID)
 			if err != nil {
 				tracing.ErrorTracer(span, err)
 				return err
 			}
 
-			boxDataWithOrderWeight, err := db.OrderRepo().BoxDataByOrderID(ctx, orderID)
+			if response.ClientID == 0 {
+				response.ClientID = orderState.ClientID
+			}
+
+			line, err := o.issueOrder(ctx, db, tariff, orderState, response.ClientID)
 			if err != nil {
 				tracing.ErrorTracer(span, err)
 				return err
 			}
 
-			if boxDataWithOrderWeight.IsCheck && boxDataWithOrderWeight.Weight > boxDataWithOrderWeight.OrderWeight {
-				totalCost += boxDataWithOrderWeight.Cost
-			}
-
+			response.TotalCost += line.Cost
+			response.Lines = append(response.Lines, line)
 		}
 
+		response.Discount = pricing.Discount(tariff, response.TotalCost, len(response.Lines))
+		response.TotalCost -= response.Discount
+
 		return nil
 	}); err != nil {
 		tracing.ErrorTracer(span, err)
-		return 0, err
+		return order.IssueResponse{}, err
+	}
+
+	response.IssuedAt = time.Now()
+
+	for _, orderID := range uniqueOrderIDs {
+		o.invalidateOrder(ctx, span, orderID)
 	}
 
 	totalIssuedOrders.Add(float64(len(uniqueOrderIDs)))
 
 	span.SetStatus(codes.Ok, "Successfully issued Orders")
-	return totalCost, nil
+	return response, nil
+}
+
+// QuoteIssue runs IssueOrders in a transaction that is always rolled back,
+// orders that can not be issued are reported as problems instead of failing the quote
+func (o *OrderUseCase) QuoteIssue(ctx context.Context, request order.RequestOrderIDs) (order.QuoteResponse, error) {
+	log.Println("[order][useCase][QuoteIssue]")
+	tracer := otel.Tracer("[order][useCase]")
+	ctx, span := tracer.Start(ctx, "[QuoteIssue]")
+	defer span.End()
+
+	var response order.QuoteResponse
+	err := o.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		tariff, err := activeTariff(ctx, db)
+		if err != nil {
+			tracing.ErrorTracer(span, err)
+			return err
+		}
+
+		for _, orderID := range lo.Uniq(request.OrderIDs) {
+			orderState, err := db.OrderRepo().GetOrderState(ctx, orderID, request.PVZID)
+			if err != nil {
+				if problemCode, ok := issueProblemCode(err); ok {
+					response.Problems = append(response.Problems, order.IssueProblem{OrderID: orderID, Code: problemCode, Message: err.Error()})
+					continue
+				}
+
+				tracing.ErrorTracer(span, err)
+				return err
+			}
+
+			if response.ClientID == 0 {
+				response.ClientID = orderState.ClientID
+			}
+
+			line, err := o.issueOrder(ctx, db, tariff, orderState, response.ClientID)
+			if err != nil {
+				if problemCode, ok := issueProblemCode(err); ok {
+					response.Problems = append(response.Problems, order.IssueProblem{OrderID: orderID, Code: problemCode, Message: err.Error()})
+					continue
+				}
+
+				tracing.ErrorTracer(span, err)
+				return err
+			}
+
+			response.TotalCost += line.Cost
+			response.Lines = append(response.Lines, line)
+		}
+
+		response.Discount = pricing.Discount(tariff, response.TotalCost, len(response.Lines))
+		response.TotalCost -= response.Discount
+
+		return errQuoteRollback
+	})
+	if !errors.Is(err, errQuoteRollback) {
+		tracing.ErrorTracer(span, err)
+		return order.QuoteResponse{}, err
+	}
+
+	span.SetStatus(codes.Ok, "Successfully quoted Orders")
+	return response, nil
+}
+
+// issueOrder is the issue step of a single order shared by IssueOrders and QuoteIssue
+func (o *OrderUseCase) issueOrder(
+	ctx context.Context,
+	db database.Datastore,
+	tariff pricingModel.AllResponse,
+	orderState order.StateData,
+	clientID int64,
+) (order.IssueLine, error) {
+	if orderState.ClientID != clientID {
+		return order.IssueLine{}, errlst.ErrClientIDNotFound
+	}
+
+	if orderState.Status.At(orderState.ExpiresAt, time.Now()) == order.StatusExpired {
+		return order.IssueLine{}, errlst.ErrOrderExpired
+	}
+
+	if err := o.changeStatus(ctx, db, &orderState, order.StatusIssued); err != nil {
+		return order.IssueLine{}, err
+	}
+
+	packagingData, err := db.OrderRepo().ListPackaging(ctx, orderState.OrderID)
+	if err != nil {
+		return order.IssueLine{}, err
+	}
+
+	packaging := lo.Map(packagingData, func(item box.AllData, _ int) box.AllResponse {
+		return item.ToServer()
+	})
+
+	cost := pricing.OrderCost(tariff, pricingModel.Item{
+		Packaging:         packagingLayers(packaging),
+		Weight:            orderState.Weight,
+		Dimensions:        orderState.Dimensions,
+		VolumetricDivisor: o.volumetric.WeightDivisor(),
+		StoredDays:        pricing.StoredDays(orderState.CreatedAt, time.Now()),
+	})
+
+	return order.IssueLine{
+		OrderID:       orderState.OrderID,
+		BoxID:         orderState.BoxID,
+		BoxName:       orderState.BoxName,
+		Weight:        orderState.Weight,
+		PackagingCost: cost.Packaging,
+		WeightCost:    cost.Weight,
+		StorageFee:    cost.Storage,
+		Cost:          cost.Total(),
+		Packaging: lo.Map(packaging, func(item box.AllResponse, index int) order.PackagingLine {
+			return order.PackagingLine{BoxID: item.ID, BoxName: item.Name, Cost: cost.Layers[index]}
+		}),
+		ChargeableWeight: cost.ChargeableWeight,
+	}, nil
+}
+
+// packagingLayers is
+func packagingLayers(packaging []box.AllResponse) []pricingModel.Layer {
+	return lo.Map(packaging, func(item box.AllResponse, _ int) pricingModel.Layer {
+		return pricingModel.Layer{
+			BoxID:      item.ID,
+			Cost:       item.Cost,
+			IsCheck:    item.IsCheck,
+			Weight:     item.Weight,
+			Dimensions: item.Dimensions,
+		}
+	})
+}
+
+// activeTariff is, without an active tariff orders are charged for their box only
+func activeTariff(ctx context.Context, db database.Datastore) (pricingModel.AllResponse, error) {
+	tariffData, err := db.PricingRepo().GetActiveTariff(ctx)
+	if err != nil {
+		if errors.Is(err, errlst.ErrTariffNotFound) {
+			return pricingModel.AllResponse{}, nil
+		}
+
+		return pricingModel.AllResponse{}, err
+	}
+
+	return tariffData.ToServer(), nil
+}
+
+// issueProblemCode is
+func issueProblemCode(err error) (string, bool) {
+	switch {
+	case errors.Is(err, errlst.ErrOrderNotFound):
+		return "not_found", true
+	case errors.Is(err, errlst.ErrClientIDNotFound):
+		return "wrong_client", true
+	case errors.Is(err, errlst.ErrOrderExpired):
+		return "expired", true
+	case errors.Is(err, errlst.ErrInvalidStatusTransition):
+		return "invalid_status", true
+	default:
+		return "", false
+	}
 }
 
 // ReturnedOrders is
 func (o *OrderUseCase) ReturnedOrders(
 	ctx context.Context,
-	request abstract.Page,
+	request order.ListRequest,
 ) (abstract.PaginatedResponse[order.ReturnedResponse], error) {
 	log.Println("[order][useCase][ReturnedOrders]")
 	tracer := otel.Tracer("[order][useCase]")
@@ -176,7 +673,7 @@ func (o *OrderUseCase) ReturnedOrders(
 
 	err = o.repo.WithTransaction(ctx, func(db database.Datastore) error {
 		var count int64
-		count, err = db.OrderRepo().CountReturnedOrders(ctx)
+		count, err = db.OrderRepo().CountReturnedOrders(ctx, request.ToStorage())
 		if err != nil {
 			tracing.ErrorTracer(span, err)
 			return err
@@ -220,46 +717,82 @@ func (o *OrderUseCase) UpdateAcceptOrder(ctx context.Context, request order.Requ
 	defer span.End()
 
 	if err := o.repo.WithTransaction(ctx, func(db database.Datastore) error {
-		err := db.OrderRepo().UpdateAcceptOrder(ctx, request.ToStorage())
+		orderState, err := db.OrderRepo().GetOrderState(ctx, request.OrderID, request.PVZID)
 		if err != nil {
 			tracing.ErrorTracer(span, err)
 			return err
 		}
 
+		if orderState.ClientID != request.ClientID {
+			tracing.ErrorTracer(span, errlst.ErrWrongClient)
+			return errlst.ErrWrongClient
+		}
+
+		if orderState.IssuedAt == nil || orderState.Status == order.StatusReceived || orderState.Status == order.StatusExpired {
+			tracing.ErrorTracer(span, errlst.ErrOrderNotIssued)
+			return errlst.ErrOrderNotIssued
+		}
+
+		if orderState.Status == order.StatusIssued && time.Since(*orderState.IssuedAt) > o.returnPolicy.Window(orderState.BoxName) {
+			tracing.ErrorTracer(span, errlst.ErrReturnWindowElapsed)
+			return errlst.ErrReturnWindowElapsed
+		}
+
+		if err = o.changeStatus(ctx, db, &orderState, order.StatusReturnedByClient); err != nil {
+			tracing.ErrorTracer(span, err)
+			return err
+		}
+
 		return nil
 	}); err != nil {
 		tracing.ErrorTracer(span, err)
 		return err
 	}
+	o.invalidateOrder(ctx, span, request.OrderID)
+
 	span.SetStatus(codes.Ok, "Successfully accept Order")
 	return nil
 }
 
 // DeleteReturnedOrder is
-func (o *OrderUseCase) DeleteReturnedOrder(ctx context.Context, orderID int64) error {
+func (o *OrderUseCase) DeleteReturnedOrder(ctx context.Context, request order.IDRequest) error {
 	log.Println("[order][useCase][DeleteReturnedOrder]")
 	tracer := otel.Tracer("[order][useCase]")
 	ctx, span := tracer.Start(ctx, "[DeleteReturnedOrder]")
 	defer span.End()
 
 	if err := o.repo.WithTransaction(ctx, func(db database.Datastore) error {
-		err := db.OrderRepo().DeleteReturnOrder(ctx, orderID)
+		orderState, err := db.OrderRepo().GetOrderState(ctx, request.OrderID, request.PVZID)
 		if err != nil {
 			tracing.ErrorTracer(span, err)
 			return err
 		}
 
+		if orderState.Status.At(orderState.ExpiresAt, time.Now()) == order.StatusExpired && orderState.Status != order.StatusExpired {
+			if err = o.changeStatus(ctx, db, &orderState, order.StatusExpired); err != nil {
+				tracing.ErrorTracer(span, err)
+				return err
+			}
+		}
+
+		if err = o.changeStatus(ctx, db, &orderState, order.StatusHandedToCourier); err != nil {
+			tracing.ErrorTracer(span, err)
+			return err
+		}
+
 		return nil
 	}); err != nil {
 		tracing.ErrorTracer(span, err)
 		return err
 	}
+	o.invalidateOrder(ctx, span, request.OrderID)
+
 	span.SetStatus(codes.Ok, "Successfully deleted Order")
 	return nil
 }
 
 // OrderList is
-func (o *OrderUseCase) OrderList(ctx context.Context, request abstract.Page) (abstract.PaginatedResponse[order.AllResponse], error) {
+func (o *OrderUseCase) OrderList(ctx context.Context, request order.ListRequest) (abstract.PaginatedResponse[order.AllResponse], error) {
 	log.Println("[order][useCase][OrderList]")
 	tracer := otel.Tracer("[order][useCase]")
 	ctx, span := tracer.Start(ctx, "[OrderList]")
@@ -271,7 +804,7 @@ func (o *OrderUseCase) OrderList(ctx context.Context, request abstract.Page) (ab
 
 	err = o.repo.WithTransaction(ctx, func(db database.Datastore) error {
 		var count int64
-		count, err = db.OrderRepo().CountOrders(ctx)
+		count, err = db.OrderRepo().CountOrders(ctx, request.ToStorage())
 		if err != nil {
 			tracing.ErrorTracer(span, err)
 			return err
@@ -310,7 +843,7 @@ func (o *OrderUseCase) OrderList(ctx context.Context, request abstract.Page) (ab
 // UniqueClientsList is
 func (o *OrderUseCase) UniqueClientsList(
 	ctx context.Context,
-	request abstract.Page,
+	request order.ListRequest,
 ) (abstract.PaginatedResponse[order.ListUniqueClients], error) {
 	log.Println("[order][useCase][UniqueClientsList]")
 	tracer := otel.Tracer("[order][useCase]")
@@ -324,7 +857,7 @@ func (o *OrderUseCase) UniqueClientsList(
 
 	err = o.repo.WithTransaction(ctx, func(db database.Datastore) error {
 		var count int64
-		count, err = db.OrderRepo().CountUniqueClients(ctx)
+		count, err = db.OrderRepo().CountUniqueClients(ctx, request.ToStorage())
 		if err != nil {
 			tracing.ErrorTracer(span, err)
 			return err
@@ -360,3 +893,192 @@ func (o *OrderUseCase) UniqueClientsList(
 
 	return uniqueClientListResponse, nil
 }
+
+// GetOrderByID is
+func (o *OrderUseCase) GetOrderByID(ctx context.Context, request order.IDRequest) (order.DetailsResponse, error) {
+	log.Println("[order][useCase][GetOrderByID]")
+	tracer := otel.Tracer("[order][useCase]")
+	ctx, span := tracer.Start(ctx, "[GetOrderByID]")
+	defer span.End()
+
+	cacheArgument := abstract.CacheArgument{
+		ObjectType: "order",
+		ObjectID:   request.OrderID,
+	}
+
+	var response order.DetailsResponse
+
+	cachedValue, err := o.cache.Get(ctx, cacheArgument)
+	if err == nil && json.Unmarshal(cachedValue, &response) == nil && response.PVZID == request.PVZID {
+		response.Status = response.Status.At(response.ExpiresAt, time.Now())
+		span.SetStatus(codes.Ok, "Successfully got order")
+		return response, nil
+	}
+
+	detailsData, err := o.repo.OrderRepo().GetOrderByID(ctx, request.OrderID, request.PVZID)
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return order.DetailsResponse{}, err
+	}
+
+	response = detailsData.ToServer()
+
+	marshaledData, err := json.Marshal(response)
+	if err != nil {
+		log.Printf("[order][usecase][GetOrderByID] json.Marshal: %v", err)
+		tracing.ErrorTracer(span, err)
+	} else if err = o.cache.Set(ctx, cacheArgument, marshaledData, constants.OrderTimeDuration); err != nil {
+		log.Printf("[order][usecase][GetOrderByID] o.cache.Set: %v", err)
+		tracing.ErrorTracer(span, err)
+	}
+
+	response.Status = response.Status.At(response.ExpiresAt, time.Now())
+
+	span.SetStatus(codes.Ok, "Successfully got order")
+	return response, nil
+}
+
+// GetOrderHistory is
+func (o *OrderUseCase) GetOrderHistory(ctx context.Context, request order.IDRequest) (order.HistoryResponse, error) {
+	log.Println("[order][useCase][GetOrderHistory]")
+	tracer := otel.Tracer("[order][useCase]")
+	ctx, span := tracer.Start(ctx, "[GetOrderHistory]")
+	defer span.End()
+
+	cacheArgument := abstract.CacheArgument{
+		ObjectType: "order_history",
+		ObjectID:   request.OrderID,
+	}
+
+	var response order.HistoryResponse
+
+	cachedValue, err := o.cache.Get(ctx, cacheArgument)
+	if err == nil && json.Unmarshal(cachedValue, &response) == nil && response.PVZID == request.PVZID {
+		span.SetStatus(codes.Ok, "Successfully got order history")
+		return response, nil
+	}
+
+	var statusChangesData []order.StatusChangeData
+
+	err = o.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		if _, err = db.OrderRepo().GetOrderByID(ctx, request.OrderID, request.PVZID); err != nil {
+			tracing.ErrorTracer(span, err)
+			return err
+		}
+
+		statusChangesData, err = db.OrderRepo().ListStatusHistory(ctx, request.OrderID)
+		if err != nil {
+			tracing.ErrorTracer(span, err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return order.HistoryResponse{}, err
+	}
+
+	response = order.HistoryResponse{
+		OrderID: request.OrderID,
+		PVZID:   request.PVZID,
+		StatusChanges: lo.Map(
+			statusChangesData,
+			func(item order.StatusChangeData, _ int) order.StatusChange {
+				return item.ToServer()
+			},
+		),
+	}
+
+	marshaledData, err := json.Marshal(response)
+	if err != nil {
+		log.Printf("[order][usecase][GetOrderHistory] json.Marshal: %v", err)
+		tracing.ErrorTracer(span, err)
+	} else if err = o.cache.Set(ctx, cacheArgument, marshaledData, constants.OrderTimeDuration); err != nil {
+		log.Printf("[order][usecase][GetOrderHistory] o.cache.Set: %v", err)
+		tracing.ErrorTracer(span, err)
+	}
+
+	span.SetStatus(codes.Ok, "Successfully got order history")
+	return response, nil
+}
+
+// ExpireOverdueOrders is
+func (o *OrderUseCase) ExpireOverdueOrders(ctx context.Context, batchSize int) ([]order.StatusTransitionData, error) {
+	log.Println("[order][useCase][ExpireOverdueOrders]")
+	tracer := otel.Tracer("[order][useCase]")
+	ctx, span := tracer.Start(ctx, "[ExpireOverdueOrders]")
+	defer span.End()
+
+	var transitions []order.StatusTransitionData
+
+	if err := o.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		overdueOrders, err := db.OrderRepo().ListOverdueOrders(ctx, batchSize)
+		if err != nil {
+			tracing.ErrorTracer(span, err)
+			return err
+		}
+
+		for _, orderState := range overdueOrders {
+			transitionData, err := orderState.TransitionTo(order.StatusExpired)
+			if err != nil {
+				tracing.ErrorTracer(span, err)
+				return err
+			}
+
+			if err = db.OrderRepo().UpdateStatus(ctx, transitionData); err != nil {
+				tracing.ErrorTracer(span, err)
+				return err
+			}
+
+			transitions = append(transitions, transitionData)
+		}
+
+		return nil
+	}); err != nil {
+		tracing.ErrorTracer(span, err)
+		return nil, err
+	}
+
+	for _, transitionData := range transitions {
+		o.invalidateOrder(ctx, span, transitionData.OrderID)
+	}
+
+	span.SetStatus(codes.Ok, "Successfully expired overdue orders")
+	return transitions, nil
+}
+
+// invalidateOrder drops the cached order and its history after a status change
+func (o *OrderUseCase) invalidateOrder(ctx context.Context, span trace.Span, orderID int64) {
+	for _, objectType := range []string{"order", "order_history"} {
+		err := o.cache.Del(ctx, abstract.CacheArgument{ObjectType: objectType, ObjectID: orderID})
+		if err != nil {
+			log.Printf("[order][usecase][invalidateOrder] o.cache.Del: %v", err)
+			tracing.ErrorTracer(span, err)
+		}
+	}
+}
+
+// changeStatus checks the transition against the order lifecycle and stores it with its history record.
+// An order returned by its client is back in the PVZ, so it takes a free cell like a received one
+func (o *OrderUseCase) changeStatus(ctx context.Context, db database.Datastore, orderState *order.StateData, to order.Status) error {
+	transitionData, err := orderState.TransitionTo(to)
+	if err != nil {
+		return err
+	}
+
+	if to == order.StatusReturnedByClient {
+		transitionData.CellID, err = db.PvzRepo().FindFreeCell(ctx, orderState.PVZID, orderState.Weight)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = db.OrderRepo().UpdateStatus(ctx, transitionData); err != nil {
+		return err
+	}
+
+	orderState.Status = to
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abstractModel "Homework-1/internal/model/abstract"
+	pricingModel "Homework-1/internal/model/pricing"
+	pricingUseCase "Homework-1/internal/pricing"
+	"Homework-1/pkg/api/abstract"
+	"Homework-1/pkg/api/pricing_v1"
+	"Homework-1/pkg/errlst"
+	"Homework-1/pkg/reqvalidator"
+	"Homework-1/pkg/tracing"
+)
+
+var (
+	_ pricingUseCase.Handlers = (*PricingHandler)(nil)
+)
+
+// PricingHandler is
+type PricingHandler struct {
+	useCase pricingUseCase.UseCase
+	pricing_v1.UnimplementedTariffServiceServer
+}
+
+// NewPricingHandler is
+func NewPricingHandler(useCase pricingUseCase.UseCase) *PricingHandler {
+	return &PricingHandler{
+		useCase: useCase,
+	}
+}
+
+// CreateTariff is
+func (p *PricingHandler) CreateTariff(ctx context.Context, request *pricing_v1.TariffCreateRequest) (*abstract.MessageResponse, error) {
+	log.Printf("[pricing_v1][delivery][CreateTariff]")
+	tracer := otel.Tracer("[pricing_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[CreateTariff]")
+	defer span.End()
+
+	tariffReq := pricingModel.FromGRPC(request.GetTariff())
+
+	err := reqvalidator.ValidateRequest(tariffReq)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("reqvalidator.ValidateRequest %v", err))
+	}
+
+	id, err := p.useCase.CreateTariff(ctx, tariffReq)
+	if err != nil {
+		if strings.Contains(err.Error(), errlst.ErrTariffAlreadyExists.Error()) {
+			tracing.EventErrorTracer(span, err, "Tariff already exists")
+			return nil, status.Errorf(grpcCodes.AlreadyExists, "Failed to create: %v", err)
+		}
+
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, "Failed to create: %v", err)
+	}
+
+	span.SetStatus(codes.Ok, "Tariff created successfully")
+	return &abstract.MessageResponse{Message: strconv.FormatInt(id, 10)}, nil
+}
+
+// DeleteTariff is
+func (p *PricingHandler) DeleteTariff(ctx context.Context, request *pricing_v1.TariffIDRequest) (*abstract.MessageResponse, error) {
+	log.Printf("[pricing_v1][delivery][DeleteTariff]")
+	tracer := otel.Tracer("[pricing_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[DeleteTariff]")
+	defer span.End()
+
+	if request.TariffID < 0 {
+		tracing.EventErrorTracer(span, errors.New("invalid request id"), "bad request")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, "invalid request id")
+	}
+
+	err := p.useCase.DeleteTariffByID(ctx, request.TariffID)
+	if err != nil {
+		if errors.Is(err, errlst.ErrTariffNotFound) {
+			tracing.EventErrorTracer(span, err, "Tariff not found")
+			return nil, status.Errorf(grpcCodes.NotFound, "Error: %v", err)
+		}
+		if errors.Is(err, errlst.ErrTariffActive) {
+			tracing.EventErrorTracer(span, err, "Tariff is active")
+			return nil, status.Errorf(grpcCodes.FailedPrecondition, "Failed to delete: %v", err)
+		}
+
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, "Failed to delete: %v", err)
+	}
+
+	span.SetStatus(codes.Ok, "Successfully deleted tariff by ID")
+	return &abstract.MessageResponse{Message: "Successfully Deleted Tariff\n"}, nil
+}
+
+// ActivateTariff is
+func (p *PricingHandler) ActivateTariff(ctx context.Context, request *pricing_v1.TariffIDRequest) (*abstract.MessageResponse, error) {
+	log.Printf("[pricing_v1][delivery][ActivateTariff]")
+	tracer := otel.Tracer("[pricing_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[ActivateTariff]")
+	defer span.End()
+
+	if request.TariffID < 0 {
+		tracing.EventErrorTracer(span, errors.New("invalid request id"), "bad request")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, "invalid request id")
+	}
+
+	err := p.useCase.ActivateTariff(ctx, request.TariffID)
+	if err != nil {
+		if errors.Is(err, errlst.ErrTariffNotFound) {
+			tracing.EventErrorTracer(span, err, "Tariff not found")
+			return nil, status.Errorf(grpcCodes.NotFound, "Error: %v", err)
+		}
+
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, "Failed to activate: %v", err)
+	}
+
+	span.SetStatus(codes.Ok, "Successfully activated tariff")
+	return &abstract.MessageResponse{Message: "Successfully Activated Tariff\n"}, nil
+}
+
+// ListTariffs is
+func (p *PricingHandler) ListTariffs(ctx context.Context, request *abstract.Page) (*pricing_v1.TariffListResponse, error) {
+	log.Printf("[pricing_v1][delivery][ListTariffs]")
+	tracer := otel.Tracer("[pricing_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[ListTariffs]")
+	defer span.End()
+	page := abstractModel.PageFromGRPC(request)
+
+	err := reqvalidator.ValidateRequest(page)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("reqvalidator.ValidateRequest %v", err))
+	}
+
+	listOfTariffs, err := p.useCase.ListTariffs(ctx, page)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "unable to get list of tariffs")
+		return nil, status.Errorf(grpcCodes.Internal, "Failed to get list of tariffs: %v", err)
+	}
+
+	span.SetStatus(codes.Ok, "Successfully received tariff list")
+	return pricingModel.ListToGRPC(listOfTariffs), nil
+}
+
+// GetTariffByID is
+func (p *PricingHandler) GetTariffByID(ctx context.Context, request *pricing_v1.TariffIDRequest) (*pricing_v1.TariffAllInfo, error) {
+	log.Printf("[pricing_v1][delivery][GetTariffByID]")
+	tracer := otel.Tracer("[pricing_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[GetTariffByID]")
+	defer span.End()
+
+	if request == nil || request.TariffID < 0 {
+		tracing.EventErrorTracer(span, errors.New("invalid request id"), "bad request")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, "invalid request id")
+	}
+
+	tariffResponse, err := p.useCase.GetTariff(ctx, request.TariffID)
+	if err != nil {
+		if errors.Is(err, errlst.ErrTariffNotFound) {
+			tracing.EventErrorTracer(span, err, "Tariff not found")
+			return nil, status.Errorf(grpcCodes.NotFound, fmt.Sprintf("Error: %v", err))
+		}
+		tracing.EventErrorTracer(span, err, "internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to get: %v", err))
+	}
+
+	span.SetStatus(codes.Ok, "Successfully received tariff info by ID")
+	return pricingModel.InfoToGRPC(tariffResponse), nil
+}
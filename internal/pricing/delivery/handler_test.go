@@ -0,0 +1,431 @@
+package delivery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	abstractModel "Homework-1/internal/model/abstract"
+	pricingModel "Homework-1/internal/model/pricing"
+	"Homework-1/internal/pricing"
+	pricingMock "Homework-1/internal/pricing/mock"
+	"Homework-1/pkg/api/abstract"
+	"Homework-1/pkg/api/pricing_v1"
+	"Homework-1/pkg/errlst"
+)
+
+// TestPricingHandler_CreateTariff is
+func TestPricingHandler_CreateTariff(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+
+	tariffRequest := pricingModel.Request{
+		Name:              "summer",
+		WeightBrackets:    []pricingModel.WeightBracket{{MinWeight: 0, MaxWeight: 10, Cost: 500}},
+		BoxSurcharges:     []pricingModel.BoxSurcharge{{BoxID: 2, Surcharge: 300}},
+		FreeStorageDays:   3,
+		StorageFeePerDay:  150,
+		DiscountPercent:   10,
+		DiscountMinOrders: 3,
+		Currency:          "RUB",
+	}
+
+	tests := []*struct {
+		description string
+		requestBody pricing_v1.TariffCreateRequest
+		wantResp    *abstract.MessageResponse
+		wantErr     error
+		useCase     pricing.UseCase
+	}{
+		{
+			description: "Successfully created tariff",
+			requestBody: pricing_v1.TariffCreateRequest{
+				Tariff: &pricing_v1.Tariff{
+					Name:              "summer",
+					WeightBrackets:    []*pricing_v1.WeightBracket{{MinWeight: 0, MaxWeight: 10, Cost: 5}},
+					BoxSurcharges:     []*pricing_v1.BoxSurcharge{{BoxID: 2, Surcharge: 3}},
+					FreeStorageDays:   3,
+					StorageFeePerDay:  1.5,
+					DiscountPercent:   10,
+					DiscountMinOrders: 3,
+				},
+			},
+			wantResp: &abstract.MessageResponse{Message: "2"},
+			wantErr:  nil,
+			useCase: pricingMock.NewUseCaseMock(ctrl).CreateTariffMock.
+				When(minimock.AnyContext, tariffRequest).
+				Then(2, nil),
+		},
+		{
+			description: "Tariff already exists",
+			requestBody: pricing_v1.TariffCreateRequest{
+				Tariff: &pricing_v1.Tariff{
+					Name:              "summer",
+					WeightBrackets:    []*pricing_v1.WeightBracket{{MinWeight: 0, MaxWeight: 10, Cost: 5}},
+					BoxSurcharges:     []*pricing_v1.BoxSurcharge{{BoxID: 2, Surcharge: 3}},
+					FreeStorageDays:   3,
+					StorageFeePerDay:  1.5,
+					DiscountPercent:   10,
+					DiscountMinOrders: 3,
+				},
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.AlreadyExists, "Failed to create: pq: duplicate key value violates unique constraint \"tariff_name_key\""),
+			useCase: pricingMock.NewUseCaseMock(ctrl).CreateTariffMock.
+				When(minimock.AnyContext, tariffRequest).
+				Then(-1, errlst.ErrTariffAlreadyExists),
+		},
+		{
+			description: "Internal server error",
+			requestBody: pricing_v1.TariffCreateRequest{
+				Tariff: &pricing_v1.Tariff{
+					Name:              "summer",
+					WeightBrackets:    []*pricing_v1.WeightBracket{{MinWeight: 0, MaxWeight: 10, Cost: 5}},
+					BoxSurcharges:     []*pricing_v1.BoxSurcharge{{BoxID: 2, Surcharge: 3}},
+					FreeStorageDays:   3,
+					StorageFeePerDay:  1.5,
+					DiscountPercent:   10,
+					DiscountMinOrders: 3,
+				},
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.Internal, "Failed to create: assert.AnError general error for testing"),
+			useCase: pricingMock.NewUseCaseMock(ctrl).CreateTariffMock.
+				When(minimock.AnyContext, tariffRequest).
+				Then(-1, assert.AnError),
+		},
+		{
+			description: "Weight bracket is empty",
+			requestBody: pricing_v1.TariffCreateRequest{
+				Tariff: &pricing_v1.Tariff{
+					Name:           "summer",
+					WeightBrackets: []*pricing_v1.WeightBracket{{MinWeight: 10, MaxWeight: 10, Cost: 5}},
+				},
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ValidateRequest Key: 'Request.WeightBrackets[0].MaxWeight' Error:Field validation for 'MaxWeight' failed on the 'gtfield' tag"),
+			useCase:  pricingMock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Discount is over 100 percent",
+			requestBody: pricing_v1.TariffCreateRequest{
+				Tariff: &pricing_v1.Tariff{
+					Name:            "summer",
+					DiscountPercent: 120,
+				},
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ValidateRequest Key: 'Request.DiscountPercent' Error:Field validation for 'DiscountPercent' failed on the 'lte' tag"),
+			useCase:  pricingMock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Surcharge in another currency",
+			requestBody: pricing_v1.TariffCreateRequest{
+				Tariff: &pricing_v1.Tariff{
+					Name: "summer",
+					BoxSurcharges: []*pricing_v1.BoxSurcharge{
+						{BoxID: 2, SurchargeAmount: &abstract.Money{Units: 300, Currency: "USD"}},
+					},
+				},
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ValidateRequest Key: 'Request.Currency' Error:Field validation for 'Currency' failed on the 'eq' tag"),
+			useCase:  pricingMock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewPricingHandler(tt.useCase).CreateTariff(context.Background(), &tt.requestBody)
+
+			assert.Equal(t, tt.wantResp, response)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+// TestPricingHandler_DeleteTariff is
+func TestPricingHandler_DeleteTariff(t *testing.T) {
+	t.Parallel()
+	ctrl := minimock.NewController(t)
+	tests := []*struct {
+		description string
+		requestID   pricing_v1.TariffIDRequest
+		wantResp    *abstract.MessageResponse
+		wantErr     error
+		useCase     pricing.UseCase
+	}{
+		{
+			description: "Successfully deleted tariff",
+			requestID:   pricing_v1.TariffIDRequest{TariffID: 2},
+			wantResp:    &abstract.MessageResponse{Message: "Successfully Deleted Tariff\n"},
+			wantErr:     nil,
+			useCase:     pricingMock.NewUseCaseMock(ctrl).DeleteTariffByIDMock.When(minimock.AnyContext, 2).Then(nil),
+		},
+		{
+			description: "Tariff not found",
+			requestID:   pricing_v1.TariffIDRequest{TariffID: 2},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.NotFound, "Error: Tariff not found"),
+			useCase:     pricingMock.NewUseCaseMock(ctrl).DeleteTariffByIDMock.When(minimock.AnyContext, 2).Then(errlst.ErrTariffNotFound),
+		},
+		{
+			description: "Tariff is active",
+			requestID:   pricing_v1.TariffIDRequest{TariffID: 1},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.FailedPrecondition, "Failed to delete: Active tariff can not be deleted"),
+			useCase:     pricingMock.NewUseCaseMock(ctrl).DeleteTariffByIDMock.When(minimock.AnyContext, 1).Then(errlst.ErrTariffActive),
+		},
+		{
+			description: "Unable to parse tariffID",
+			requestID:   pricing_v1.TariffIDRequest{TariffID: -1},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.InvalidArgument, "invalid request id"),
+			useCase:     pricingMock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewPricingHandler(tt.useCase).DeleteTariff(context.Background(), &tt.requestID)
+
+			assert.Equal(t, tt.wantResp, response)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+// TestPricingHandler_ActivateTariff is
+func TestPricingHandler_ActivateTariff(t *testing.T) {
+	t.Parallel()
+	ctrl := minimock.NewController(t)
+	tests := []*struct {
+		description string
+		requestID   pricing_v1.TariffIDRequest
+		wantResp    *abstract.MessageResponse
+		wantErr     error
+		useCase     pricing.UseCase
+	}{
+		{
+			description: "Successfully activated tariff",
+			requestID:   pricing_v1.TariffIDRequest{TariffID: 2},
+			wantResp:    &abstract.MessageResponse{Message: "Successfully Activated Tariff\n"},
+			wantErr:     nil,
+			useCase:     pricingMock.NewUseCaseMock(ctrl).ActivateTariffMock.When(minimock.AnyContext, 2).Then(nil),
+		},
+		{
+			description: "Tariff not found",
+			requestID:   pricing_v1.TariffIDRequest{TariffID: 2},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.NotFound, "Error: Tariff not found"),
+			useCase:     pricingMock.NewUseCaseMock(ctrl).ActivateTariffMock.When(minimock.AnyContext, 2).Then(errlst.ErrTariffNotFound),
+		},
+		{
+			description: "Internal server error",
+			requestID:   pricing_v1.TariffIDRequest{TariffID: 2},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.Internal, "Failed to activate: assert.AnError general error for testing"),
+			useCase:     pricingMock.NewUseCaseMock(ctrl).ActivateTariffMock.When(minimock.AnyContext, 2).Then(assert.AnError),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewPricingHandler(tt.useCase).ActivateTariff(context.Background(), &tt.requestID)
+
+			assert.Equal(t, tt.wantResp, response)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+// TestPricingHandler_ListTariffs is
+func TestPricingHandler_ListTariffs(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	fixedTime := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	tests := []*struct {
+		description string
+		requestBody abstract.Page
+		wantResp    *pricing_v1.TariffListResponse
+		wantErr     error
+		useCase     pricing.UseCase
+	}{
+		{
+			description: "Successfully got list of tariffs",
+			requestBody: abstract.Page{
+				CurrentPage:  1,
+				ItemsPerPage: 10,
+			},
+			wantResp: &pricing_v1.TariffListResponse{
+				TariffAllInfo: []*pricing_v1.TariffAllInfo{
+					{
+						ID: 1,
+						Tariff: &pricing_v1.Tariff{
+							Name:                   "default",
+							WeightBrackets:         []*pricing_v1.WeightBracket{},
+							BoxSurcharges:          []*pricing_v1.BoxSurcharge{},
+							StorageFeePerDayAmount: &abstract.Money{Units: 0, Currency: "RUB"},
+						},
+						IsActive:  true,
+						CreatedAt: timestamppb.New(fixedTime),
+						UpdatedAt: timestamppb.New(fixedTime),
+					},
+				},
+				Pagination: &abstract.Pagination{
+					Page: &abstract.Page{
+						CurrentPage:  1,
+						ItemsPerPage: 1,
+					},
+					TotalItems: 1,
+				},
+			},
+			wantErr: nil,
+			useCase: pricingMock.NewUseCaseMock(ctrl).ListTariffsMock.
+				When(
+					minimock.AnyContext,
+					abstractModel.Page{
+						CurrentPage:  1,
+						ItemsPerPage: 10,
+					}).
+				Then(
+					abstractModel.PaginatedResponse[pricingModel.AllResponse]{
+						Items: []pricingModel.AllResponse{
+							{
+								ID:        1,
+								Name:      "default",
+								IsActive:  true,
+								CreatedAt: fixedTime,
+								UpdatedAt: fixedTime,
+							},
+						},
+						CurrentPage:  1,
+						ItemsPerPage: 1,
+						TotalItems:   1,
+					}, nil),
+		},
+		{
+			description: "Internal server error",
+			requestBody: abstract.Page{
+				CurrentPage:  1,
+				ItemsPerPage: 10,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.Internal, "Failed to get list of tariffs: assert.AnError general error for testing"),
+			useCase: pricingMock.NewUseCaseMock(ctrl).ListTariffsMock.
+				When(
+					minimock.AnyContext, abstractModel.Page{
+						CurrentPage:  1,
+						ItemsPerPage: 10,
+					}).Then(
+				abstractModel.PaginatedResponse[pricingModel.AllResponse]{},
+				assert.AnError),
+		},
+		{
+			description: "Request validation failed",
+			requestBody: abstract.Page{
+				CurrentPage: 1,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.InvalidArgument, "reqvalidator.ValidateRequest Key: 'Page.ItemsPerPage' Error:Field validation for 'ItemsPerPage' failed on the 'required' tag"),
+			useCase:  pricingMock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewPricingHandler(tt.useCase).ListTariffs(context.Background(), &tt.requestBody)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantResp, response)
+		})
+	}
+}
+
+// TestPricingHandler_GetTariffByID is
+func TestPricingHandler_GetTariffByID(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	fixedTime := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	tests := []*struct {
+		description string
+		requestID   pricing_v1.TariffIDRequest
+		wantResp    *pricing_v1.TariffAllInfo
+		wantErr     error
+		useCase     pricing.UseCase
+	}{
+		{
+			description: "Successfully got tariff",
+			requestID:   pricing_v1.TariffIDRequest{TariffID: 2},
+			wantResp: &pricing_v1.TariffAllInfo{
+				ID: 2,
+				Tariff: &pricing_v1.Tariff{
+					Name: "summer",
+					WeightBrackets: []*pricing_v1.WeightBracket{
+						{MinWeight: 0, MaxWeight: 10, Cost: 5, CostAmount: &abstract.Money{Units: 500, Currency: "RUB"}},
+					},
+					BoxSurcharges: []*pricing_v1.BoxSurcharge{
+						{BoxID: 2, Surcharge: 3, SurchargeAmount: &abstract.Money{Units: 300, Currency: "RUB"}},
+					},
+					FreeStorageDays:        3,
+					StorageFeePerDay:       1.5,
+					StorageFeePerDayAmount: &abstract.Money{Units: 150, Currency: "RUB"},
+				},
+				CreatedAt: timestamppb.New(fixedTime),
+				UpdatedAt: timestamppb.New(fixedTime),
+			},
+			wantErr: nil,
+			useCase: pricingMock.NewUseCaseMock(ctrl).GetTariffMock.
+				When(minimock.AnyContext, 2).
+				Then(pricingModel.AllResponse{
+					ID:               2,
+					Name:             "summer",
+					WeightBrackets:   []pricingModel.WeightBracket{{MinWeight: 0, MaxWeight: 10, Cost: 500}},
+					BoxSurcharges:    []pricingModel.BoxSurcharge{{BoxID: 2, Surcharge: 300}},
+					FreeStorageDays:  3,
+					StorageFeePerDay: 150,
+					CreatedAt:        fixedTime,
+					UpdatedAt:        fixedTime,
+				}, nil),
+		},
+		{
+			description: "Tariff not found",
+			requestID:   pricing_v1.TariffIDRequest{TariffID: 2},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.NotFound, "Error: Tariff not found"),
+			useCase: pricingMock.NewUseCaseMock(ctrl).GetTariffMock.
+				When(minimock.AnyContext, 2).
+				Then(pricingModel.AllResponse{}, errlst.ErrTariffNotFound),
+		},
+		{
+			description: "Unable to parse tariffID",
+			requestID:   pricing_v1.TariffIDRequest{TariffID: -1},
+			wantResp:    nil,
+			wantErr:     status.Errorf(codes.InvalidArgument, "invalid request id"),
+			useCase:     pricingMock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewPricingHandler(tt.useCase).GetTariffByID(context.Background(), &tt.requestID)
+
+			assert.Equal(t, tt.wantResp, response)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
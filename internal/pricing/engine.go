@@ -0,0 +1,135 @@
+package pricing
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	abstractModel "Homework-1/internal/model/abstract"
+	pricingModel "Homework-1/internal/model/pricing"
+	"Homework-1/pkg/errlst"
+	"Homework-1/pkg/money"
+)
+
+// ValidateOrder checks that the order fits every packaging layer and is covered by the tariff weight brackets,
+// a tariff without brackets accepts any weight
+func ValidateOrder(tariff pricingModel.AllResponse, item pricingModel.Item) error {
+	content := item.Dimensions
+	for _, layer := range item.Packaging {
+		if layer.IsCheck && item.Weight >= layer.Weight {
+			return errlst.ErrInvalidBoxLimit
+		}
+
+		if err := fits(content, layer); err != nil {
+			return err
+		}
+
+		if !layer.Dimensions.IsZero() {
+			content = layer.Dimensions
+		}
+	}
+
+	if len(tariff.WeightBrackets) > 0 {
+		if _, ok := weightBracket(tariff.WeightBrackets, ChargeableWeight(item)); !ok {
+			return errlst.ErrWeightNotPriced
+		}
+	}
+
+	return nil
+}
+
+// OrderCost is, every packaging layer is charged on its own and
+// the box of a layer is charged only when its weight limit is checked
+func OrderCost(tariff pricingModel.AllResponse, item pricingModel.Item) pricingModel.Cost {
+	cost := pricingModel.Cost{Layers: make([]money.Amount, len(item.Packaging))}
+
+	for index, layer := range item.Packaging {
+		if layer.IsCheck {
+			cost.Layers[index] = layer.Cost
+		}
+
+		for _, boxSurcharge := range tariff.BoxSurcharges {
+			if boxSurcharge.BoxID == layer.BoxID {
+				cost.Layers[index] += boxSurcharge.Surcharge
+			}
+		}
+
+		cost.Packaging += cost.Layers[index]
+	}
+
+	cost.ChargeableWeight = ChargeableWeight(item)
+	if bracket, ok := weightBracket(tariff.WeightBrackets, cost.ChargeableWeight); ok {
+		cost.Weight = bracket.Cost
+	}
+
+	if overdueDays := item.StoredDays - tariff.FreeStorageDays; overdueDays > 0 {
+		cost.Storage = tariff.StorageFeePerDay.Times(overdueDays)
+	}
+
+	return cost
+}
+
+// VolumetricWeight is the weight a parcel of the given size is charged as
+func VolumetricWeight(dimensions abstractModel.Dimensions, divisor float64) float64 {
+	if divisor <= 0 {
+		return 0
+	}
+
+	return dimensions.Volume() / divisor
+}
+
+// ChargeableWeight is the larger of the weight of the order and its volumetric weight
+func ChargeableWeight(item pricingModel.Item) float64 {
+	return math.Max(item.Weight, VolumetricWeight(item.Dimensions, item.VolumetricDivisor))
+}
+
+// Discount is the amount taken off the total of orders issued together
+func Discount(tariff pricingModel.AllResponse, total money.Amount, orders int) money.Amount {
+	if tariff.DiscountPercent <= 0 || int64(orders) < tariff.DiscountMinOrders {
+		return 0
+	}
+
+	return total.Percent(tariff.DiscountPercent)
+}
+
+// StoredDays is the number of full days an order has spent in the PVZ
+func StoredDays(createdAt time.Time, now time.Time) int64 {
+	if createdAt.IsZero() || now.Before(createdAt) {
+		return 0
+	}
+
+	return int64(now.Sub(createdAt) / (24 * time.Hour))
+}
+
+// fits is, the content is the order itself or the box of the layer inside,
+// sizes that are unknown on either side are not checked
+func fits(content abstractModel.Dimensions, layer pricingModel.Layer) error {
+	sizes := []struct {
+		name    string
+		content float64
+		box     float64
+	}{
+		{name: "length", content: content.Length, box: layer.Dimensions.Length},
+		{name: "width", content: content.Width, box: layer.Dimensions.Width},
+		{name: "height", content: content.Height, box: layer.Dimensions.Height},
+	}
+
+	for _, size := range sizes {
+		if size.content > 0 && size.box > 0 && size.content > size.box {
+			return fmt.Errorf("%w %d: %s %v exceeds %v", errlst.ErrOrderDoesNotFit, layer.BoxID, size.name, size.content, size.box)
+		}
+	}
+
+	return nil
+}
+
+// weightBracket is the first bracket with MinWeight <= weight < MaxWeight
+func weightBracket(brackets []pricingModel.WeightBracket, weight float64) (pricingModel.WeightBracket, bool) {
+	for _, bracket := range brackets {
+		if bracket.MinWeight <= weight && weight < bracket.MaxWeight {
+			return bracket, true
+		}
+	}
+
+	return pricingModel.WeightBracket{}, false
+}
@@ -0,0 +1,237 @@
+package pricing
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	abstractModel "Homework-1/internal/model/abstract"
+	pricingModel "Homework-1/internal/model/pricing"
+	"Homework-1/pkg/errlst"
+	"Homework-1/pkg/money"
+)
+
+// TestValidateOrder is
+func TestValidateOrder(t *testing.T) {
+	t.Parallel()
+
+	bracketTariff := pricingModel.AllResponse{
+		WeightBrackets: []pricingModel.WeightBracket{
+			{MinWeight: 0, MaxWeight: 5, Cost: 1},
+			{MinWeight: 5, MaxWeight: 20, Cost: 3},
+		},
+	}
+
+	tests := []*struct {
+		description string
+		tariff      pricingModel.AllResponse
+		item        pricingModel.Item
+		wantErr     error
+	}{
+		{
+			description: "Order fits checked box",
+			item:        pricingModel.Item{Packaging: []pricingModel.Layer{{IsCheck: true, Weight: 10}}, Weight: 9},
+			wantErr:     nil,
+		},
+		{
+			description: "Order is as heavy as checked box limit",
+			item:        pricingModel.Item{Packaging: []pricingModel.Layer{{IsCheck: true, Weight: 10}}, Weight: 10},
+			wantErr:     errlst.ErrInvalidBoxLimit,
+		},
+		{
+			description: "Order is too heavy for an inner layer",
+			item: pricingModel.Item{
+				Packaging: []pricingModel.Layer{{IsCheck: true, Weight: 10}, {IsCheck: true, Weight: 30}},
+				Weight:    15,
+			},
+			wantErr: errlst.ErrInvalidBoxLimit,
+		},
+		{
+			description: "Unchecked box accepts any weight",
+			item:        pricingModel.Item{Packaging: []pricingModel.Layer{{IsCheck: false, Weight: 0}}, Weight: 100},
+			wantErr:     nil,
+		},
+		{
+			description: "Weight is covered by a bracket",
+			tariff:      bracketTariff,
+			item:        pricingModel.Item{Weight: 5},
+			wantErr:     nil,
+		},
+		{
+			description: "Weight is outside of the brackets",
+			tariff:      bracketTariff,
+			item:        pricingModel.Item{Weight: 20},
+			wantErr:     errlst.ErrWeightNotPriced,
+		},
+		{
+			description: "Order fits the box dimensions",
+			item: pricingModel.Item{
+				Packaging:  []pricingModel.Layer{{BoxID: 3, Dimensions: abstractModel.Dimensions{Length: 40, Width: 30, Height: 40}}},
+				Dimensions: abstractModel.Dimensions{Length: 40, Width: 20, Height: 30},
+			},
+			wantErr: nil,
+		},
+		{
+			description: "Order is too high for the box",
+			item: pricingModel.Item{
+				Packaging:  []pricingModel.Layer{{BoxID: 3, Dimensions: abstractModel.Dimensions{Length: 40, Width: 30, Height: 40}}},
+				Dimensions: abstractModel.Dimensions{Length: 30, Width: 20, Height: 50},
+			},
+			wantErr: fmt.Errorf("%w 3: height 50 exceeds 40", errlst.ErrOrderDoesNotFit),
+		},
+		{
+			description: "Inner box is too long for the outer one",
+			item: pricingModel.Item{
+				Packaging: []pricingModel.Layer{
+					{BoxID: 1, Dimensions: abstractModel.Dimensions{Length: 40, Width: 30, Height: 20}},
+					{BoxID: 2, Dimensions: abstractModel.Dimensions{Length: 30, Width: 30, Height: 30}},
+				},
+				Dimensions: abstractModel.Dimensions{Length: 10, Width: 10, Height: 10},
+			},
+			wantErr: fmt.Errorf("%w 2: length 40 exceeds 30", errlst.ErrOrderDoesNotFit),
+		},
+		{
+			description: "Unknown sizes are not checked",
+			item: pricingModel.Item{
+				Packaging:  []pricingModel.Layer{{BoxID: 3, Dimensions: abstractModel.Dimensions{Length: 40}}},
+				Dimensions: abstractModel.Dimensions{Width: 100, Height: 100},
+			},
+			wantErr: nil,
+		},
+		{
+			description: "Volumetric weight is outside of the brackets",
+			tariff:      bracketTariff,
+			item: pricingModel.Item{
+				Weight:            1,
+				Dimensions:        abstractModel.Dimensions{Length: 50, Width: 50, Height: 50},
+				VolumetricDivisor: 5000,
+			},
+			wantErr: errlst.ErrWeightNotPriced,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.wantErr, ValidateOrder(tt.tariff, tt.item))
+		})
+	}
+}
+
+// TestOrderCost is
+func TestOrderCost(t *testing.T) {
+	t.Parallel()
+
+	tariff := pricingModel.AllResponse{
+		WeightBrackets: []pricingModel.WeightBracket{
+			{MinWeight: 0, MaxWeight: 5, Cost: 100},
+			{MinWeight: 5, MaxWeight: 20, Cost: 300},
+		},
+		BoxSurcharges:    []pricingModel.BoxSurcharge{{BoxID: 2, Surcharge: 400}},
+		FreeStorageDays:  3,
+		StorageFeePerDay: 150,
+	}
+
+	tests := []*struct {
+		description string
+		tariff      pricingModel.AllResponse
+		item        pricingModel.Item
+		wantCost    pricingModel.Cost
+	}{
+		{
+			description: "Empty tariff charges checked box only",
+			item:        pricingModel.Item{Packaging: []pricingModel.Layer{{BoxID: 1, Cost: 500, IsCheck: true, Weight: 10}}, Weight: 2},
+			wantCost:    pricingModel.Cost{Layers: []money.Amount{500}, Packaging: 500, ChargeableWeight: 2},
+		},
+		{
+			description: "Empty tariff does not charge unchecked box",
+			item:        pricingModel.Item{Packaging: []pricingModel.Layer{{BoxID: 3, Cost: 100}}, Weight: 2},
+			wantCost:    pricingModel.Cost{Layers: []money.Amount{0}, ChargeableWeight: 2},
+		},
+		{
+			description: "All rules applied",
+			tariff:      tariff,
+			item: pricingModel.Item{
+				Packaging:  []pricingModel.Layer{{BoxID: 2, Cost: 2000, IsCheck: true, Weight: 30}},
+				Weight:     7,
+				StoredDays: 5,
+			},
+			wantCost: pricingModel.Cost{Layers: []money.Amount{2400}, Packaging: 2400, Weight: 300, Storage: 300, ChargeableWeight: 7},
+		},
+		{
+			description: "Every packaging layer is charged",
+			tariff:      tariff,
+			item: pricingModel.Item{
+				Packaging: []pricingModel.Layer{{BoxID: 3, Cost: 100}, {BoxID: 1, Cost: 500, IsCheck: true, Weight: 10}, {BoxID: 2, Cost: 2000, IsCheck: true, Weight: 30}},
+				Weight:    2,
+			},
+			wantCost: pricingModel.Cost{Layers: []money.Amount{0, 500, 2400}, Packaging: 2900, Weight: 100, ChargeableWeight: 2},
+		},
+		{
+			description: "Stored within free days",
+			tariff:      tariff,
+			item: pricingModel.Item{
+				Packaging:  []pricingModel.Layer{{BoxID: 1, Cost: 500, IsCheck: true, Weight: 10}},
+				Weight:     2,
+				StoredDays: 3,
+			},
+			wantCost: pricingModel.Cost{Layers: []money.Amount{500}, Packaging: 500, Weight: 100, ChargeableWeight: 2},
+		},
+		{
+			description: "Bulky order is charged by its volumetric weight",
+			tariff:      tariff,
+			item: pricingModel.Item{
+				Packaging:         []pricingModel.Layer{{BoxID: 3, Cost: 100}},
+				Weight:            2,
+				Dimensions:        abstractModel.Dimensions{Length: 40, Width: 30, Height: 25},
+				VolumetricDivisor: 5000,
+			},
+			wantCost: pricingModel.Cost{Layers: []money.Amount{0}, Weight: 300, ChargeableWeight: 6},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.wantCost, OrderCost(tt.tariff, tt.item))
+		})
+	}
+}
+
+// TestDiscount is
+func TestDiscount(t *testing.T) {
+	t.Parallel()
+
+	tariff := pricingModel.AllResponse{DiscountPercent: 10, DiscountMinOrders: 3}
+
+	assert.Equal(t, money.Amount(0), Discount(pricingModel.AllResponse{}, 10000, 5))
+	assert.Equal(t, money.Amount(0), Discount(tariff, 10000, 2))
+	assert.Equal(t, money.Amount(1000), Discount(tariff, 10000, 3))
+	assert.Equal(t, money.Amount(334), Discount(tariff, 3335, 3))
+}
+
+// TestVolumetricWeight is
+func TestVolumetricWeight(t *testing.T) {
+	t.Parallel()
+
+	dimensions := abstractModel.Dimensions{Length: 40, Width: 30, Height: 25}
+
+	assert.Equal(t, 6.0, VolumetricWeight(dimensions, 5000))
+	assert.Equal(t, 0.0, VolumetricWeight(dimensions, 0))
+	assert.Equal(t, 0.0, VolumetricWeight(abstractModel.Dimensions{}, 5000))
+}
+
+// TestStoredDays is
+func TestStoredDays(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, int64(0), StoredDays(createdAt, createdAt.Add(23*time.Hour)))
+	assert.Equal(t, int64(2), StoredDays(createdAt, createdAt.Add(50*time.Hour)))
+	assert.Equal(t, int64(0), StoredDays(time.Time{}, createdAt))
+}
@@ -0,0 +1,17 @@
+package pricing
+
+import (
+	"context"
+
+	"Homework-1/pkg/api/abstract"
+	"Homework-1/pkg/api/pricing_v1"
+)
+
+// Handlers is
+type Handlers interface {
+	CreateTariff(context.Context, *pricing_v1.TariffCreateRequest) (*abstract.MessageResponse, error)
+	DeleteTariff(context.Context, *pricing_v1.TariffIDRequest) (*abstract.MessageResponse, error)
+	ActivateTariff(context.Context, *pricing_v1.TariffIDRequest) (*abstract.MessageResponse, error)
+	ListTariffs(context.Context, *abstract.Page) (*pricing_v1.TariffListResponse, error)
+	GetTariffByID(context.Context, *pricing_v1.TariffIDRequest) (*pricing_v1.TariffAllInfo, error)
+}
@@ -0,0 +1,19 @@
+package pricing
+
+import (
+	"context"
+
+	"Homework-1/internal/model/abstract"
+	"Homework-1/internal/model/pricing"
+)
+
+// Repository is
+type Repository interface {
+	CreateTariff(ctx context.Context, tariff pricing.Data) (int64, error)
+	DeleteTariffByID(ctx context.Context, id int64) error
+	ActivateTariff(ctx context.Context, id int64) error
+	ListTariffs(ctx context.Context, tariffPagination abstract.PageData) ([]pricing.AllData, error)
+	CountTariffs(ctx context.Context) (int64, error)
+	GetTariff(ctx context.Context, id int64) (pricing.AllData, error)
+	GetActiveTariff(ctx context.Context) (pricing.AllData, error)
+}
@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"Homework-1/internal/connection"
+	"Homework-1/internal/model/abstract"
+	"Homework-1/internal/model/pricing"
+	"Homework-1/pkg/errlst"
+)
+
+// PricingRepository is
+type PricingRepository struct {
+	psqlDB connection.DB
+}
+
+// NewPricingPGRepository is
+func NewPricingPGRepository(psqlDB connection.DB) *PricingRepository {
+	return &PricingRepository{
+		psqlDB: psqlDB,
+	}
+}
+
+// CreateTariff is, it has to run in a transaction as the rules are stored in separate tables
+func (p *PricingRepository) CreateTariff(ctx context.Context, tariff pricing.Data) (int64, error) {
+	log.Println("[pricing][repository][CreateTariff]")
+
+	var id int64
+	row := p.psqlDB.QueryRow(
+		ctx,
+		"INSERT INTO tariff(name, free_storage_days, storage_fee_per_day, discount_percent, discount_min_orders) "+
+			"VALUES ($1,$2,$3,$4,$5) RETURNING ID;",
+		tariff.Name,
+		tariff.FreeStorageDays,
+		tariff.StorageFeePerDay,
+		tariff.DiscountPercent,
+		tariff.DiscountMinOrders,
+	)
+
+	err := row.Scan(&id)
+	if err != nil {
+		return -1, fmt.Errorf("p.psqlDB.QueryRow: %w", err)
+	}
+
+	for _, bracket := range tariff.WeightBrackets {
+		_, err = p.psqlDB.Execute(
+			ctx,
+			"INSERT INTO tariff_weight_bracket(tariff_id, min_weight, max_weight, cost) VALUES ($1,$2,$3,$4)",
+			id,
+			bracket.MinWeight,
+			bracket.MaxWeight,
+			bracket.Cost,
+		)
+		if err != nil {
+			return -1, fmt.Errorf("p.psqlDB.Execute: %w", err)
+		}
+	}
+
+	for _, boxSurcharge := range tariff.BoxSurcharges {
+		_, err = p.psqlDB.Execute(
+			ctx,
+			"INSERT INTO tariff_box_surcharge(tariff_id, box_id, surcharge) VALUES ($1,$2,$3)",
+			id,
+			boxSurcharge.BoxID,
+			boxSurcharge.Surcharge,
+		)
+		if err != nil {
+			return -1, fmt.Errorf("p.psqlDB.Execute: %w", err)
+		}
+	}
+
+	return id, nil
+}
+
+// DeleteTariffByID is, the active tariff is never deleted
+func (p *PricingRepository) DeleteTariffByID(ctx context.Context, id int64) error {
+	log.Println("[pricing][repository][DeleteTariffByID]")
+
+	result, err := p.psqlDB.Execute(
+		ctx,
+		"UPDATE tariff SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL AND NOT is_active",
+		time.Now(),
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.ExecContext: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	if rows == 0 {
+		return errlst.ErrTariffNotFound
+	}
+
+	return nil
+}
+
+// ActivateTariff is, the previously active tariff is switched off first to keep the single active index satisfied
+func (p *PricingRepository) ActivateTariff(ctx context.Context, id int64) error {
+	log.Println("[pricing][repository][ActivateTariff]")
+
+	_, err := p.psqlDB.Execute(
+		ctx,
+		"UPDATE tariff SET is_active = FALSE, updated_at = $1 WHERE is_active AND id <> $2",
+		time.Now(),
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.ExecContext: %w", err)
+	}
+
+	result, err := p.psqlDB.Execute(
+		ctx,
+		"UPDATE tariff SET is_active = TRUE, updated_at = $1 WHERE id = $2 AND deleted_at IS NULL",
+		time.Now(),
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.ExecContext: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	if rows == 0 {
+		return errlst.ErrTariffNotFound
+	}
+
+	return nil
+}
+
+// CountTariffs is
+func (p *PricingRepository) CountTariffs(ctx context.Context) (int64, error) {
+	log.Println("[pricing][repository][CountTariffs]")
+	var totalCount int64
+
+	err := p.psqlDB.Get(
+		ctx,
+		&totalCount,
+		"SELECT COUNT(*) FROM tariff WHERE deleted_at IS NULL",
+	)
+	if err != nil {
+		return 0, fmt.Errorf("p.psqlDB.Get: %w", err)
+	}
+
+	return totalCount, nil
+}
+
+// ListTariffs is
+func (p *PricingRepository) ListTariffs(ctx context.Context, tariffPagination abstract.PageData) ([]pricing.AllData, error) {
+	log.Println("[pricing][repository][ListTariffs]")
+	offset := (tariffPagination.CurrentPage - 1) * tariffPagination.ItemsPerPage
+	var tariffAllData []pricing.AllData
+
+	err := p.psqlDB.Select(
+		ctx,
+		&tariffAllData,
+		"SELECT "+tariffColumns+" FROM tariff WHERE deleted_at IS NULL "+
+			"ORDER BY created_at DESC OFFSET $1 LIMIT $2",
+		offset,
+		tariffPagination.ItemsPerPage,
+	)
+	if err != nil {
+		return []pricing.AllData{}, fmt.Errorf("p.psqlDB.Select: %w", err)
+	}
+
+	for i := range tariffAllData {
+		if err = p.tariffRules(ctx, &tariffAllData[i]); err != nil {
+			return []pricing.AllData{}, err
+		}
+	}
+
+	return tariffAllData, nil
+}
+
+// GetTariff is
+func (p *PricingRepository) GetTariff(ctx context.Context, id int64) (pricing.AllData, error) {
+	log.Println("[pricing][repository][GetTariff]")
+
+	return p.getTariff(ctx, "id = $1", id)
+}
+
+// GetActiveTariff is
+func (p *PricingRepository) GetActiveTariff(ctx context.Context) (pricing.AllData, error) {
+	log.Println("[pricing][repository][GetActiveTariff]")
+
+	return p.getTariff(ctx, "is_active")
+}
+
+const tariffColumns = "id, name, is_active, free_storage_days, storage_fee_per_day, discount_percent, discount_min_orders, created_at, updated_at"
+
+// getTariff is
+func (p *PricingRepository) getTariff(ctx context.Context, condition string, args ...interface{}) (pricing.AllData, error) {
+	var tariffData pricing.AllData
+
+	err := p.psqlDB.Get(
+		ctx,
+		&tariffData,
+		"SELECT "+tariffColumns+" FROM tariff WHERE deleted_at IS NULL AND "+condition,
+		args...,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return pricing.AllData{}, errlst.ErrTariffNotFound
+		}
+
+		return pricing.AllData{}, fmt.Errorf("p.psqlDB.Get: %w", err)
+	}
+
+	if err = p.tariffRules(ctx, &tariffData); err != nil {
+		return pricing.AllData{}, err
+	}
+
+	return tariffData, nil
+}
+
+// tariffRules loads the weight brackets and box surcharges of the tariff
+func (p *PricingRepository) tariffRules(ctx context.Context, tariffData *pricing.AllData) error {
+	err := p.psqlDB.Select(
+		ctx,
+		&tariffData.WeightBrackets,
+		"SELECT min_weight, max_weight, cost FROM tariff_weight_bracket WHERE tariff_id = $1 ORDER BY min_weight",
+		tariffData.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.Select: %w", err)
+	}
+
+	err = p.psqlDB.Select(
+		ctx,
+		&tariffData.BoxSurcharges,
+		"SELECT box_id, surcharge FROM tariff_box_surcharge WHERE tariff_id = $1 ORDER BY box_id",
+		tariffData.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.Select: %w", err)
+	}
+
+	return nil
+}
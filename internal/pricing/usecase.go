@@ -0,0 +1,20 @@
+// Package pricing ...
+//
+//go:generate minimock -g -i UseCase -o ./mock/usecase_mock.go -n UseCaseMock
+package pricing
+
+import (
+	"context"
+
+	"Homework-1/internal/model/abstract"
+	pricingModel "Homework-1/internal/model/pricing"
+)
+
+// UseCase is
+type UseCase interface {
+	CreateTariff(ctx context.Context, request pricingModel.Request) (int64, error)
+	DeleteTariffByID(ctx context.Context, tariffID int64) error
+	ActivateTariff(ctx context.Context, tariffID int64) error
+	ListTariffs(ctx context.Context, tariffPagination abstract.Page) (abstract.PaginatedResponse[pricingModel.AllResponse], error)
+	GetTariff(ctx context.Context, tariffID int64) (pricingModel.AllResponse, error)
+}
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+
+	"Homework-1/internal/database"
+	"Homework-1/internal/model/abstract"
+	"Homework-1/internal/model/pricing"
+	"Homework-1/pkg/errlst"
+	"Homework-1/pkg/tracing"
+)
+
+// PricingUseCase is
+type PricingUseCase struct {
+	repo database.Datastore
+}
+
+// NewPricingUseCase is
+func NewPricingUseCase(repo database.Datastore) *PricingUseCase {
+	return &PricingUseCase{repo: repo}
+}
+
+// CreateTariff is, a new tariff is inactive until ActivateTariff is called
+func (p *PricingUseCase) CreateTariff(ctx context.Context, request pricing.Request) (int64, error) {
+	log.Println("[pricing][useCase][CreateTariff]")
+	tracer := otel.Tracer("[pricing][useCase]")
+	ctx, span := tracer.Start(ctx, "[CreateTariff]")
+	defer span.End()
+
+	var id int64
+	err := p.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		var err error
+		id, err = db.PricingRepo().CreateTariff(ctx, request.ToStorage())
+		return err
+	})
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return -1, err
+	}
+
+	span.SetStatus(codes.Ok, "Tariff created successfully")
+	return id, nil
+}
+
+// DeleteTariffByID is
+func (p *PricingUseCase) DeleteTariffByID(ctx context.Context, tariffID int64) error {
+	log.Println("[pricing][useCase][DeleteTariffByID]")
+	tracer := otel.Tracer("[pricing][useCase]")
+	ctx, span := tracer.Start(ctx, "[DeleteTariffByID]")
+	defer span.End()
+
+	err := p.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		tariffData, err := db.PricingRepo().GetTariff(ctx, tariffID)
+		if err != nil {
+			return err
+		}
+
+		if tariffData.IsActive {
+			return errlst.ErrTariffActive
+		}
+
+		return db.PricingRepo().DeleteTariffByID(ctx, tariffID)
+	})
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Successfully deleted tariff by ID")
+	return nil
+}
+
+// ActivateTariff is
+func (p *PricingUseCase) ActivateTariff(ctx context.Context, tariffID int64) error {
+	log.Println("[pricing][useCase][ActivateTariff]")
+	tracer := otel.Tracer("[pricing][useCase]")
+	ctx, span := tracer.Start(ctx, "[ActivateTariff]")
+	defer span.End()
+
+	err := p.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		return db.PricingRepo().ActivateTariff(ctx, tariffID)
+	})
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Successfully activated tariff")
+	return nil
+}
+
+// ListTariffs is
+func (p *PricingUseCase) ListTariffs(ctx context.Context, tariffPage abstract.Page) (abstract.PaginatedResponse[pricing.AllResponse], error) {
+	log.Println("[pricing][useCase][ListTariffs]")
+	tracer := otel.Tracer("[pricing][useCase]")
+	ctx, span := tracer.Start(ctx, "[ListTariffs]")
+	defer span.End()
+
+	var tariffAllData []pricing.AllData
+	var err error
+	var tariffListResponse abstract.PaginatedResponse[pricing.AllResponse]
+
+	err = p.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		var count int64
+		count, err = db.PricingRepo().CountTariffs(ctx)
+		if err != nil {
+			tracing.ErrorTracer(span, err)
+			return err
+		}
+
+		tariffListResponse.TotalItems = count
+
+		tariffAllData, err = db.PricingRepo().ListTariffs(ctx, tariffPage.ToStorage())
+		if err != nil {
+			tracing.ErrorTracer(span, err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return abstract.PaginatedResponse[pricing.AllResponse]{}, err
+	}
+
+	tariffList := lo.Map(
+		tariffAllData,
+		func(item pricing.AllData, _ int) pricing.AllResponse {
+			return item.ToServer()
+		},
+	)
+
+	tariffListResponse.Items = tariffList
+	tariffListResponse.CurrentPage = tariffPage.CurrentPage
+	tariffListResponse.ItemsPerPage = int64(len(tariffList))
+
+	span.SetStatus(codes.Ok, "Successfully got list of tariffs")
+	return tariffListResponse, nil
+}
+
+// GetTariff is
+func (p *PricingUseCase) GetTariff(ctx context.Context, tariffID int64) (pricing.AllResponse, error) {
+	log.Println("[pricing][useCase][GetTariff]")
+	tracer := otel.Tracer("[pricing][useCase]")
+	ctx, span := tracer.Start(ctx, "[GetTariff]")
+	defer span.End()
+
+	var tariffData pricing.AllData
+	err := p.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		var err error
+		tariffData, err = db.PricingRepo().GetTariff(ctx, tariffID)
+		return err
+	})
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return pricing.AllResponse{}, err
+	}
+
+	span.SetStatus(codes.Ok, "Successfully got tariff")
+	return tariffData.ToServer(), nil
+}
@@ -5,10 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
+	"google.golang.org/grpc"
 	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	abstractModel "Homework-1/internal/model/abstract"
@@ -16,6 +20,7 @@ import (
 	PVZUseCase "Homework-1/internal/pvz"
 	"Homework-1/pkg/api/abstract"
 	"Homework-1/pkg/api/pvz_v1"
+	"Homework-1/pkg/constants"
 	"Homework-1/pkg/errlst"
 	"Homework-1/pkg/reqvalidator"
 	"Homework-1/pkg/tracing"
@@ -46,6 +51,9 @@ func (p *PVZHandler) CreatePVZ(ctx context.Context, request *pvz_v1.PVZCreateReq
 	pvzReq := pvzModel.FromCreateGRPC(request.Pvz)
 
 	err := reqvalidator.ValidateRequest(pvzReq)
+	if err == nil {
+		err = pvzReq.Schedule.CheckHours()
+	}
 	if err != nil {
 		tracing.EventErrorTracer(span, err, "Validation failed")
 		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("Failed to read request body: %v", err))
@@ -89,6 +97,8 @@ func (p *PVZHandler) GetPVZByID(ctx context.Context, request *pvz_v1.PVZIDReques
 		tracing.EventErrorTracer(span, err, "internal server error")
 		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to get: %v", err))
 	}
+	setETag(ctx, pvzResponse.Version)
+
 	span.SetStatus(codes.Ok, "Successfully received pvz info by ID")
 	return pvzModel.InfoToGRPC(pvzResponse), nil
 }
@@ -126,7 +136,17 @@ func (p *PVZHandler) UpdatePVZ(ctx context.Context, request *pvz_v1.UpdateReques
 
 	updatePVZRequest := pvzModel.FromUpdateGRPC(request)
 
-	err := reqvalidator.ValidateRequest(updatePVZRequest)
+	var err error
+	updatePVZRequest.Version, err = expectedVersion(ctx, request.Version)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("Failed to read request body: %v", err))
+	}
+
+	err = reqvalidator.ValidateRequest(updatePVZRequest)
+	if err == nil && updatePVZRequest.Schedule != nil {
+		err = updatePVZRequest.Schedule.CheckHours()
+	}
 	if err != nil {
 		tracing.EventErrorTracer(span, err, "Validation failed")
 		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("Failed to read request body: %v", err))
@@ -138,17 +158,23 @@ func (p *PVZHandler) UpdatePVZ(ctx context.Context, request *pvz_v1.UpdateReques
 			tracing.EventErrorTracer(span, err, "PVZ not found")
 			return nil, status.Errorf(grpcCodes.NotFound, fmt.Sprintf("Error: %v", err))
 		}
+		if errors.Is(err, errlst.ErrPVZVersionMismatch) {
+			tracing.EventErrorTracer(span, err, "PVZ version mismatch")
+			return nil, status.Errorf(grpcCodes.Aborted, fmt.Sprintf("Error: %v", err))
+		}
 
 		tracing.EventErrorTracer(span, err, "internal server error")
 		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to update: %v", err))
 	}
 
+	setETag(ctx, updatePVZRequest.Version+1)
+
 	span.SetStatus(codes.Ok, "Successfully updated PVZ")
 	return &abstract.MessageResponse{Message: "Successfully Updated PVZ"}, nil
 }
 
 // DeletePVZ is
-func (p *PVZHandler) DeletePVZ(ctx context.Context, request *pvz_v1.PVZIDRequest) (*abstract.MessageResponse, error) {
+func (p *PVZHandler) DeletePVZ(ctx context.Context, request *pvz_v1.DeletePVZRequest) (*abstract.MessageResponse, error) {
 	log.Printf("[pvz][delivery][DeletePVZByID]")
 	tracer := otel.Tracer("[pvz_v1][delivery]")
 	ctx, span := tracer.Start(ctx, "[DeletePVZ]")
@@ -159,12 +185,32 @@ func (p *PVZHandler) DeletePVZ(ctx context.Context, request *pvz_v1.PVZIDRequest
 		return nil, status.Errorf(grpcCodes.InvalidArgument, "invalid request id")
 	}
 
-	err := p.useCase.DeletePVZByID(ctx, request.PvzID)
+	deleteRequest := pvzModel.FromDeleteGRPC(request)
+
+	var err error
+	deleteRequest.Version, err = expectedVersion(ctx, request.Version)
+	if err == nil {
+		err = reqvalidator.ValidateRequest(deleteRequest)
+	}
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("Failed to read request body: %v", err))
+	}
+
+	err = p.useCase.DeletePVZByID(ctx, deleteRequest)
 	if err != nil {
 		if errors.Is(err, errlst.ErrPVZNotFound) {
 			tracing.EventErrorTracer(span, err, "PVZ not found")
 			return nil, status.Errorf(grpcCodes.NotFound, fmt.Sprintf("Error: %v", err))
 		}
+		if errors.Is(err, errlst.ErrPVZHasLiveOrders) {
+			tracing.EventErrorTracer(span, err, "PVZ has live orders")
+			return nil, status.Errorf(grpcCodes.FailedPrecondition, fmt.Sprintf("Error: %v", err))
+		}
+		if errors.Is(err, errlst.ErrPVZVersionMismatch) {
+			tracing.EventErrorTracer(span, err, "PVZ version mismatch")
+			return nil, status.Errorf(grpcCodes.Aborted, fmt.Sprintf("Error: %v", err))
+		}
 		tracing.EventErrorTracer(span, err, "Internal server error")
 		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to delete: %v", err))
 	}
@@ -172,3 +218,258 @@ func (p *PVZHandler) DeletePVZ(ctx context.Context, request *pvz_v1.PVZIDRequest
 	span.SetStatus(codes.Ok, "Successfully deleted PVZ by ID")
 	return &abstract.MessageResponse{Message: "Successfully Deleted PVZ\n"}, nil
 }
+
+// ListDeletedPVZ is
+func (p *PVZHandler) ListDeletedPVZ(ctx context.Context, request *abstract.Page) (*pvz_v1.ListResponse, error) {
+	log.Printf("[pvz][delivery][ListDeletedPVZ]")
+	tracer := otel.Tracer("[pvz_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[ListDeletedPVZ]")
+	defer span.End()
+	page := abstractModel.PageFromGRPC(request)
+
+	err := reqvalidator.ValidateRequest(page)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("Failed to read request body: %v", err))
+	}
+
+	listPVZ, err := p.useCase.ListDeletedPVZ(ctx, page)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "unable to get list of deleted PVZ")
+		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to get list of deleted PVZ: %v", err))
+	}
+
+	span.SetStatus(codes.Ok, "Successfully received deleted pvz list")
+	return pvzModel.ListToGRPC(listPVZ), nil
+}
+
+// RestorePVZ is
+func (p *PVZHandler) RestorePVZ(ctx context.Context, request *pvz_v1.PVZIDRequest) (*abstract.MessageResponse, error) {
+	log.Printf("[pvz][delivery][RestorePVZ]")
+	tracer := otel.Tracer("[pvz_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[RestorePVZ]")
+	defer span.End()
+
+	if request.PvzID <= 0 {
+		tracing.EventErrorTracer(span, errors.New("invalid request id"), "bad request")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, "invalid request id")
+	}
+
+	err := p.useCase.RestorePVZ(ctx, request.PvzID)
+	if err != nil {
+		if errors.Is(err, errlst.ErrPVZNotFound) {
+			tracing.EventErrorTracer(span, err, "Deleted PVZ not found")
+			return nil, status.Errorf(grpcCodes.NotFound, fmt.Sprintf("Error: %v", err))
+		}
+		if errors.Is(err, errlst.ErrPVZAlreadyExists) {
+			tracing.EventErrorTracer(span, err, "PVZ already exists")
+			return nil, status.Errorf(grpcCodes.AlreadyExists, fmt.Sprintf("Failed to restore: %v", err))
+		}
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to restore: %v", err))
+	}
+
+	span.SetStatus(codes.Ok, "Successfully restored PVZ")
+	return &abstract.MessageResponse{Message: "Successfully Restored PVZ"}, nil
+}
+
+// PurgePVZ is
+func (p *PVZHandler) PurgePVZ(ctx context.Context, request *pvz_v1.PVZIDRequest) (*abstract.MessageResponse, error) {
+	log.Printf("[pvz][delivery][PurgePVZ]")
+	tracer := otel.Tracer("[pvz_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[PurgePVZ]")
+	defer span.End()
+
+	if request.PvzID <= 0 {
+		tracing.EventErrorTracer(span, errors.New("invalid request id"), "bad request")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, "invalid request id")
+	}
+
+	err := p.useCase.PurgePVZ(ctx, request.PvzID)
+	if err != nil {
+		if errors.Is(err, errlst.ErrPVZNotFound) {
+			tracing.EventErrorTracer(span, err, "Deleted PVZ not found")
+			return nil, status.Errorf(grpcCodes.NotFound, fmt.Sprintf("Error: %v", err))
+		}
+		if errors.Is(err, errlst.ErrPVZHasDependents) {
+			tracing.EventErrorTracer(span, err, "PVZ has dependents")
+			return nil, status.Errorf(grpcCodes.FailedPrecondition, fmt.Sprintf("Error: %v", err))
+		}
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to purge: %v", err))
+	}
+
+	span.SetStatus(codes.Ok, "Successfully purged PVZ")
+	return &abstract.MessageResponse{Message: "Successfully Purged PVZ"}, nil
+}
+
+// CreatePVZCell is
+func (p *PVZHandler) CreatePVZCell(ctx context.Context, request *pvz_v1.CellCreateRequest) (*abstract.MessageResponse, error) {
+	log.Printf("[pvz][delivery][CreatePVZCell]")
+	tracer := otel.Tracer("[pvz_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[CreatePVZCell]")
+	defer span.End()
+
+	cellReq := pvzModel.FromCellCreateGRPC(request)
+
+	err := reqvalidator.ValidateRequest(cellReq)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("Failed to read request body: %v", err))
+	}
+
+	err = p.useCase.CreateCell(ctx, cellReq)
+	if err != nil {
+		if errors.Is(err, errlst.ErrPVZNotFound) {
+			tracing.EventErrorTracer(span, err, "PVZ not found")
+			return nil, status.Errorf(grpcCodes.NotFound, fmt.Sprintf("Error: %v", err))
+		}
+		if strings.Contains(err.Error(), errlst.ErrPVZCellAlreadyExists.Error()) {
+			tracing.EventErrorTracer(span, err, "PVZ cell already exists")
+			return nil, status.Errorf(grpcCodes.AlreadyExists, fmt.Sprintf("Failed to create: %v", err))
+		}
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to create: %v", err))
+	}
+
+	span.SetStatus(codes.Ok, "PVZ cell created successfully")
+	return &abstract.MessageResponse{Message: "Successfully Created PVZ Cell"}, nil
+}
+
+// DeletePVZCell is
+func (p *PVZHandler) DeletePVZCell(ctx context.Context, request *pvz_v1.CellIDRequest) (*abstract.MessageResponse, error) {
+	log.Printf("[pvz][delivery][DeletePVZCell]")
+	tracer := otel.Tracer("[pvz_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[DeletePVZCell]")
+	defer span.End()
+
+	cellReq := pvzModel.FromCellIDGRPC(request)
+
+	err := reqvalidator.ValidateRequest(cellReq)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("Failed to read request body: %v", err))
+	}
+
+	err = p.useCase.DeleteCell(ctx, cellReq)
+	if err != nil {
+		if errors.Is(err, errlst.ErrPVZCellNotFound) {
+			tracing.EventErrorTracer(span, err, "PVZ cell not found")
+			return nil, status.Errorf(grpcCodes.NotFound, fmt.Sprintf("Error: %v", err))
+		}
+		if errors.Is(err, errlst.ErrPVZCellInUse) {
+			tracing.EventErrorTracer(span, err, "PVZ cell holds orders")
+			return nil, status.Errorf(grpcCodes.FailedPrecondition, fmt.Sprintf("Error: %v", err))
+		}
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to delete: %v", err))
+	}
+
+	span.SetStatus(codes.Ok, "Successfully deleted PVZ cell")
+	return &abstract.MessageResponse{Message: "Successfully Deleted PVZ Cell"}, nil
+}
+
+// PVZOccupancy is
+func (p *PVZHandler) PVZOccupancy(ctx context.Context, request *pvz_v1.PVZIDRequest) (*pvz_v1.OccupancyResponse, error) {
+	log.Printf("[pvz][delivery][PVZOccupancy]")
+	tracer := otel.Tracer("[pvz_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[PVZOccupancy]")
+	defer span.End()
+
+	if request.PvzID < 0 {
+		tracing.EventErrorTracer(span, errors.New("invalid request id"), "bad request")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, "invalid request id")
+	}
+
+	occupancy, err := p.useCase.GetOccupancy(ctx, request.PvzID)
+	if err != nil {
+		if errors.Is(err, errlst.ErrPVZNotFound) {
+			tracing.EventErrorTracer(span, err, "PVZ not found")
+			return nil, status.Errorf(grpcCodes.NotFound, fmt.Sprintf("Error: %v", err))
+		}
+
+		tracing.EventErrorTracer(span, err, "internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to get occupancy: %v", err))
+	}
+
+	span.SetStatus(codes.Ok, "Successfully received pvz occupancy")
+	return pvzModel.OccupancyToGRPC(occupancy), nil
+}
+
+// SearchPVZ is
+func (p *PVZHandler) SearchPVZ(ctx context.Context, request *pvz_v1.SearchRequest) (*pvz_v1.ListResponse, error) {
+	log.Printf("[pvz][delivery][SearchPVZ]")
+	tracer := otel.Tracer("[pvz_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[SearchPVZ]")
+	defer span.End()
+
+	searchReq := pvzModel.FromSearchGRPC(request)
+
+	err := reqvalidator.ValidateRequest(searchReq)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("Failed to read request body: %v", err))
+	}
+
+	foundPVZ, err := p.useCase.SearchPVZ(ctx, searchReq)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "unable to search PVZ")
+		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to search PVZ: %v", err))
+	}
+
+	span.SetStatus(codes.Ok, "Successfully searched PVZ")
+	return pvzModel.ListToGRPC(foundPVZ), nil
+}
+
+// SearchNearbyPVZ is
+func (p *PVZHandler) SearchNearbyPVZ(ctx context.Context, request *pvz_v1.NearbyRequest) (*pvz_v1.NearbyResponse, error) {
+	log.Printf("[pvz][delivery][SearchNearbyPVZ]")
+	tracer := otel.Tracer("[pvz_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[SearchNearbyPVZ]")
+	defer span.End()
+
+	nearbyReq := pvzModel.FromNearbyGRPC(request)
+
+	err := reqvalidator.ValidateRequest(nearbyReq)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("Failed to read request body: %v", err))
+	}
+
+	nearbyPVZ, err := p.useCase.SearchNearbyPVZ(ctx, nearbyReq)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "unable to search nearby PVZ")
+		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to search nearby PVZ: %v", err))
+	}
+
+	span.SetStatus(codes.Ok, "Successfully searched nearby PVZ")
+	return pvzModel.NearbyToGRPC(nearbyPVZ), nil
+}
+
+// expectedVersion is the version sent in the request, the If-Match header passed on by the gateway is used when there is none
+func expectedVersion(ctx context.Context, version int64) (int64, error) {
+	if version != 0 {
+		return version, nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(constants.IfMatchHeader)) == 0 {
+		return 0, nil
+	}
+
+	eTag := md.Get(constants.IfMatchHeader)[0]
+	version, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(eTag, "W/"), `"`), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("If-Match %q is not a PVZ version", eTag)
+	}
+
+	return version, nil
+}
+
+// setETag sends the PVZ version back, the gateway turns it into the ETag HTTP header
+func setETag(ctx context.Context, version int64) {
+	eTag := strconv.Quote(strconv.FormatInt(version, 10))
+	if err := grpc.SetHeader(ctx, metadata.Pairs(constants.ETagHeader, eTag)); err != nil {
+		log.Printf("[pvz][delivery][setETag] grpc.SetHeader: %v", err)
+	}
+}
@@ -2,12 +2,14 @@ package delivery
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -62,9 +64,10 @@ func TestPVZHandler_CreatePVZ(t *testing.T) {
 			useCase: mock.NewUseCaseMock(ctrl).
 				CreatePVZMock.
 				When(minimock.AnyContext, pvzModel.Request{
-					Name:    "test",
-					Address: "kazan",
-					Contact: "+5654646546",
+					Name:     "test",
+					Address:  "kazan",
+					Contact:  "+5654646546",
+					Schedule: pvzModel.Schedule{Timezone: "UTC"},
 				}).
 				Then(errlst.ErrPVZAlreadyExists),
 		},
@@ -79,14 +82,95 @@ func TestPVZHandler_CreatePVZ(t *testing.T) {
 			},
 			wantResp: nil,
 			wantErr:  status.Errorf(codes.Internal, "Failed to create: assert.AnError general error for testing"),
+			useCase: mock.NewUseCaseMock(ctrl).
+				CreatePVZMock.
+				When(minimock.AnyContext, pvzModel.Request{
+					Name:     "test",
+					Address:  "kazan",
+					Contact:  "+5654646546",
+					Schedule: pvzModel.Schedule{Timezone: "UTC"},
+				}).
+				Then(assert.AnError),
+		},
+		{
+			description: "PVZ closes before it opens",
+			requestBody: &pvz_v1.PVZCreateRequest{
+				Pvz: &pvz_v1.PVZ{
+					Name:     "test",
+					Address:  "kazan",
+					Contact:  "+5654646546",
+					Schedule: []*pvz_v1.WorkingDay{{Weekday: 1, Opens: "21:00", Closes: "09:00"}},
+				},
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.InvalidArgument, "Failed to read request body: PVZ has to close after it opens on a working day: weekday 1"),
+			useCase:  mock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Successfully Created PVZ with a structured address",
+			requestBody: &pvz_v1.PVZCreateRequest{
+				Pvz: &pvz_v1.PVZ{
+					Name:    "test",
+					Contact: "+5654646546",
+					StructuredAddress: &pvz_v1.StructuredAddress{
+						Country:    "Russia",
+						City:       "Kazan",
+						Street:     "Baumana",
+						Building:   "1",
+						PostalCode: "420111",
+					},
+				},
+			},
+			wantResp: &abstract.MessageResponse{
+				Message: "Successfully Created PVZ\n",
+			},
+			wantErr: nil,
+			useCase: mock.NewUseCaseMock(ctrl).
+				CreatePVZMock.
+				When(minimock.AnyContext, pvzModel.Request{
+					Name:     "test",
+					Address:  "420111, Russia, Kazan, Baumana, 1",
+					Contact:  "+5654646546",
+					Schedule: pvzModel.Schedule{Timezone: "UTC"},
+					StructuredAddress: pvzModel.StructuredAddress{
+						Country:    "Russia",
+						City:       "Kazan",
+						Street:     "Baumana",
+						Building:   "1",
+						PostalCode: "420111",
+					},
+				}).
+				Then(nil),
+		},
+		{
+			description: "Successfully Created PVZ with a schedule",
+			requestBody: &pvz_v1.PVZCreateRequest{
+				Pvz: &pvz_v1.PVZ{
+					Name:     "test",
+					Address:  "kazan",
+					Contact:  "+5654646546",
+					Timezone: "Europe/Moscow",
+					Schedule: []*pvz_v1.WorkingDay{{Weekday: 1, Opens: "09:00", Closes: "21:00"}},
+					Holidays: []string{"2024-05-09"},
+				},
+			},
+			wantResp: &abstract.MessageResponse{
+				Message: "Successfully Created PVZ\n",
+			},
+			wantErr: nil,
 			useCase: mock.NewUseCaseMock(ctrl).
 				CreatePVZMock.
 				When(minimock.AnyContext, pvzModel.Request{
 					Name:    "test",
 					Address: "kazan",
 					Contact: "+5654646546",
+					Schedule: pvzModel.Schedule{
+						Timezone: "Europe/Moscow",
+						Days:     pvzModel.WorkingDays{{Weekday: 1, Opens: "09:00", Closes: "21:00"}},
+						Holidays: []string{"2024-05-09"},
+					},
 				}).
-				Then(assert.AnError),
+				Then(nil),
 		},
 		{
 			description: constants.SuccessfullyCreatedPVZ,
@@ -104,9 +188,10 @@ func TestPVZHandler_CreatePVZ(t *testing.T) {
 			useCase: mock.NewUseCaseMock(ctrl).
 				CreatePVZMock.
 				When(minimock.AnyContext, pvzModel.Request{
-					Name:    "test",
-					Address: "kazan",
-					Contact: "+5654646546",
+					Name:     "test",
+					Address:  "kazan",
+					Contact:  "+5654646546",
+					Schedule: pvzModel.Schedule{Timezone: "UTC"},
 				}).
 				Then(nil),
 		},
@@ -131,15 +216,17 @@ func TestPVZHandler_DeletePVZByID(t *testing.T) {
 
 	tests := []struct {
 		description string
-		requestID   *pvz_v1.PVZIDRequest
+		requestID   *pvz_v1.DeletePVZRequest
+		ifMatch     string
 		wantResp    *abstract.MessageResponse
 		wantErr     error
 		useCase     pvz.UseCase
 	}{
 		{
 			description: "Successfully Deleted PVZ",
-			requestID: &pvz_v1.PVZIDRequest{
-				PvzID: 1,
+			requestID: &pvz_v1.DeletePVZRequest{
+				PvzID:   1,
+				Version: 2,
 			},
 			wantResp: &abstract.MessageResponse{
 				Message: "Successfully Deleted PVZ\n",
@@ -147,36 +234,101 @@ func TestPVZHandler_DeletePVZByID(t *testing.T) {
 			wantErr: nil,
 			useCase: mock.NewUseCaseMock(ctrl).
 				DeletePVZByIDMock.
-				When(minimock.AnyContext, 1).
+				When(minimock.AnyContext, pvzModel.DeleteRequest{ID: 1, Version: 2}).
 				Then(nil),
 		},
 		{
 			description: "PVZ not found",
-			requestID: &pvz_v1.PVZIDRequest{
-				PvzID: 1,
+			requestID: &pvz_v1.DeletePVZRequest{
+				PvzID:   1,
+				Version: 2,
 			},
 			wantResp: nil,
 			wantErr:  status.Errorf(codes.NotFound, "Error: PVZ not found"),
 			useCase: mock.NewUseCaseMock(ctrl).
 				DeletePVZByIDMock.
-				When(minimock.AnyContext, 1).
+				When(minimock.AnyContext, pvzModel.DeleteRequest{ID: 1, Version: 2}).
 				Then(errlst.ErrPVZNotFound),
 		},
 		{
-			description: "Internal server error",
-			requestID: &pvz_v1.PVZIDRequest{
+			description: "PVZ still holds live orders",
+			requestID: &pvz_v1.DeletePVZRequest{
+				PvzID:   1,
+				Version: 2,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.FailedPrecondition, "Error: PVZ still holds live orders"),
+			useCase: mock.NewUseCaseMock(ctrl).
+				DeletePVZByIDMock.
+				When(minimock.AnyContext, pvzModel.DeleteRequest{ID: 1, Version: 2}).
+				Then(errlst.ErrPVZHasLiveOrders),
+		},
+		{
+			description: "PVZ was changed by another request",
+			requestID: &pvz_v1.DeletePVZRequest{
+				PvzID:   1,
+				Version: 2,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.Aborted, "Error: PVZ was changed by another request, read it again"),
+			useCase: mock.NewUseCaseMock(ctrl).
+				DeletePVZByIDMock.
+				When(minimock.AnyContext, pvzModel.DeleteRequest{ID: 1, Version: 2}).
+				Then(errlst.ErrPVZVersionMismatch),
+		},
+		{
+			description: "Version is taken from If-Match",
+			requestID: &pvz_v1.DeletePVZRequest{
 				PvzID: 1,
 			},
+			ifMatch: `"2"`,
+			wantResp: &abstract.MessageResponse{
+				Message: "Successfully Deleted PVZ\n",
+			},
+			wantErr: nil,
+			useCase: mock.NewUseCaseMock(ctrl).
+				DeletePVZByIDMock.
+				When(minimock.AnyContext, pvzModel.DeleteRequest{ID: 1, Version: 2}).
+				Then(nil),
+		},
+		{
+			description: "If-Match is not a version",
+			requestID: &pvz_v1.DeletePVZRequest{
+				PvzID: 1,
+			},
+			ifMatch:  `"abc"`,
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.InvalidArgument, `Failed to read request body: If-Match "\"abc\"" is not a PVZ version`),
+			useCase:  mock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Version is not provided",
+			requestID: &pvz_v1.DeletePVZRequest{
+				PvzID: 1,
+			},
+			wantResp: nil,
+			wantErr: status.Errorf(
+				codes.InvalidArgument,
+				"Failed to read request body: Key: 'DeleteRequest.Version' Error:Field validation for 'Version' failed on the 'required' tag",
+			),
+			useCase: mock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Internal server error",
+			requestID: &pvz_v1.DeletePVZRequest{
+				PvzID:   1,
+				Version: 2,
+			},
 			wantResp: nil,
 			wantErr:  status.Errorf(codes.Internal, "Failed to delete: assert.AnError general error for testing"),
 			useCase: mock.NewUseCaseMock(ctrl).
 				DeletePVZByIDMock.
-				When(minimock.AnyContext, 1).
+				When(minimock.AnyContext, pvzModel.DeleteRequest{ID: 1, Version: 2}).
 				Then(assert.AnError),
 		},
 		{
 			description: "Unable to get pvzID",
-			requestID: &pvz_v1.PVZIDRequest{
+			requestID: &pvz_v1.DeletePVZRequest{
 				PvzID: -1,
 			},
 			wantResp: nil,
@@ -188,7 +340,11 @@ func TestPVZHandler_DeletePVZByID(t *testing.T) {
 		tt := tt
 		t.Run(tt.description, func(t *testing.T) {
 			t.Parallel()
-			response, err := NewPVZHandler(tt.useCase).DeletePVZ(context.Background(), tt.requestID)
+			ctx := context.Background()
+			if tt.ifMatch != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(constants.IfMatchHeader, tt.ifMatch))
+			}
+			response, err := NewPVZHandler(tt.useCase).DeletePVZ(ctx, tt.requestID)
 
 			assert.Equal(t, tt.wantResp, response)
 			assert.Equal(t, tt.wantErr, err)
@@ -453,7 +609,8 @@ func TestPVZHandler_UpdatePVZ(t *testing.T) {
 		{
 			description: "Request validation failed",
 			requestBody: &pvz_v1.UpdateRequest{
-				Pvz: &argument,
+				Pvz:     &argument,
+				Version: 2,
 			},
 			wantResp: nil,
 			wantErr: status.Errorf(
@@ -465,54 +622,97 @@ func TestPVZHandler_UpdatePVZ(t *testing.T) {
 		{
 			description: "PVZ not found",
 			requestBody: &pvz_v1.UpdateRequest{
-				ID:  1,
-				Pvz: &argument,
+				ID:      1,
+				Pvz:     &argument,
+				Version: 2,
 			},
 			wantResp: nil,
 			wantErr:  status.Errorf(codes.NotFound, "Error: PVZ not found"),
 			useCase: mock.NewUseCaseMock(ctrl).
 				UpdatePVZMock.
 				When(minimock.AnyContext, pvzModel.UpdateRequest{
-					ID:      1,
-					Name:    &argument.Name,
-					Address: &argument.Address,
-					Contact: &argument.Contact,
+					ID:       1,
+					Name:     &argument.Name,
+					Address:  &argument.Address,
+					Contact:  &argument.Contact,
+					Schedule: &pvzModel.Schedule{Timezone: "UTC"},
+					Version:  2,
 				}).
 				Then(errlst.ErrPVZNotFound),
 		},
 		{
-			description: "Internal server error",
+			description: "PVZ was changed by another request",
+			requestBody: &pvz_v1.UpdateRequest{
+				ID:      1,
+				Pvz:     &argument,
+				Version: 2,
+			},
+			wantResp: nil,
+			wantErr:  status.Errorf(codes.Aborted, "Error: PVZ was changed by another request, read it again"),
+			useCase: mock.NewUseCaseMock(ctrl).
+				UpdatePVZMock.
+				When(minimock.AnyContext, pvzModel.UpdateRequest{
+					ID:       1,
+					Name:     &argument.Name,
+					Address:  &argument.Address,
+					Contact:  &argument.Contact,
+					Schedule: &pvzModel.Schedule{Timezone: "UTC"},
+					Version:  2,
+				}).
+				Then(errlst.ErrPVZVersionMismatch),
+		},
+		{
+			description: "Version is not provided",
 			requestBody: &pvz_v1.UpdateRequest{
 				ID:  1,
 				Pvz: &argument,
 			},
 			wantResp: nil,
+			wantErr: status.Errorf(
+				codes.InvalidArgument,
+				"Failed to read request body: Key: 'UpdateRequest.Version' Error:Field validation for 'Version' failed on the 'required' tag",
+			),
+			useCase: mock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Internal server error",
+			requestBody: &pvz_v1.UpdateRequest{
+				ID:      1,
+				Pvz:     &argument,
+				Version: 2,
+			},
+			wantResp: nil,
 			wantErr:  status.Errorf(codes.Internal, "Failed to update: assert.AnError general error for testing"),
 			useCase: mock.NewUseCaseMock(ctrl).
 				UpdatePVZMock.
 				When(minimock.AnyContext, pvzModel.UpdateRequest{
-					ID:      1,
-					Name:    &argument.Name,
-					Address: &argument.Address,
-					Contact: &argument.Contact,
+					ID:       1,
+					Name:     &argument.Name,
+					Address:  &argument.Address,
+					Contact:  &argument.Contact,
+					Schedule: &pvzModel.Schedule{Timezone: "UTC"},
+					Version:  2,
 				}).
 				Then(assert.AnError),
 		},
 		{
 			description: "Successfully Updated PVZ",
 			requestBody: &pvz_v1.UpdateRequest{
-				ID:  1,
-				Pvz: &argument,
+				ID:      1,
+				Pvz:     &argument,
+				Version: 2,
 			},
 			wantResp: &abstract.MessageResponse{Message: "Successfully Updated PVZ"},
 			wantErr:  nil,
 			useCase: mock.NewUseCaseMock(ctrl).
 				UpdatePVZMock.
 				When(minimock.AnyContext, pvzModel.UpdateRequest{
-					ID:      1,
-					Name:    &argument.Name,
-					Address: &argument.Address,
-					Contact: &argument.Contact,
+					ID:       1,
+					Name:     &argument.Name,
+					Address:  &argument.Address,
+					Contact:  &argument.Contact,
+					Schedule: &pvzModel.Schedule{Timezone: "UTC"},
+					Version:  2,
 				}).
 				Then(nil),
 		},
@@ -528,3 +728,482 @@ func TestPVZHandler_UpdatePVZ(t *testing.T) {
 		})
 	}
 }
+
+// TestPVZHandler_CreatePVZCell is
+func TestPVZHandler_CreatePVZCell(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	cellRequest := pvzModel.CellRequest{PVZID: 1, Code: "A-01", MaxWeight: 30, MaxCount: 5}
+
+	tests := []struct {
+		description string
+		requestBody *pvz_v1.CellCreateRequest
+		wantResp    *abstract.MessageResponse
+		wantErr     error
+		useCase     pvz.UseCase
+	}{
+		{
+			description: "Successfully Created PVZ Cell",
+			requestBody: &pvz_v1.CellCreateRequest{PvzID: 1, Code: "A-01", MaxWeight: 30, MaxCount: 5},
+			wantResp:    &abstract.MessageResponse{Message: "Successfully Created PVZ Cell"},
+			useCase:     mock.NewUseCaseMock(ctrl).CreateCellMock.When(minimock.AnyContext, cellRequest).Then(nil),
+		},
+		{
+			description: "Request validation failed",
+			requestBody: &pvz_v1.CellCreateRequest{PvzID: 1, Code: "A-01", MaxWeight: 30},
+			wantErr: status.Errorf(
+				codes.InvalidArgument,
+				"Failed to read request body: Key: 'CellRequest.MaxCount' Error:Field validation for 'MaxCount' failed on the 'gt' tag",
+			),
+			useCase: mock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "PVZ not found",
+			requestBody: &pvz_v1.CellCreateRequest{PvzID: 1, Code: "A-01", MaxWeight: 30, MaxCount: 5},
+			wantErr:     status.Errorf(codes.NotFound, "Error: PVZ not found"),
+			useCase:     mock.NewUseCaseMock(ctrl).CreateCellMock.When(minimock.AnyContext, cellRequest).Then(errlst.ErrPVZNotFound),
+		},
+		{
+			description: "PVZ cell already exists",
+			requestBody: &pvz_v1.CellCreateRequest{PvzID: 1, Code: "A-01", MaxWeight: 30, MaxCount: 5},
+			wantErr: status.Errorf(
+				codes.AlreadyExists,
+				"Failed to create: pq: duplicate key value violates unique constraint \"pvz_cell_pvz_id_code_key\"",
+			),
+			useCase: mock.NewUseCaseMock(ctrl).CreateCellMock.When(minimock.AnyContext, cellRequest).Then(errlst.ErrPVZCellAlreadyExists),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewPVZHandler(tt.useCase).CreatePVZCell(context.Background(), tt.requestBody)
+
+			assert.Equal(t, tt.wantResp, response)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+// TestPVZHandler_DeletePVZCell is
+func TestPVZHandler_DeletePVZCell(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	cellID := pvzModel.CellIDRequest{PVZID: 1, Code: "A-01"}
+
+	tests := []struct {
+		description string
+		requestBody *pvz_v1.CellIDRequest
+		wantResp    *abstract.MessageResponse
+		wantErr     error
+		useCase     pvz.UseCase
+	}{
+		{
+			description: "Successfully Deleted PVZ Cell",
+			requestBody: &pvz_v1.CellIDRequest{PvzID: 1, Code: "A-01"},
+			wantResp:    &abstract.MessageResponse{Message: "Successfully Deleted PVZ Cell"},
+			useCase:     mock.NewUseCaseMock(ctrl).DeleteCellMock.When(minimock.AnyContext, cellID).Then(nil),
+		},
+		{
+			description: "PVZ cell not found",
+			requestBody: &pvz_v1.CellIDRequest{PvzID: 1, Code: "A-01"},
+			wantErr:     status.Errorf(codes.NotFound, "Error: PVZ cell not found"),
+			useCase:     mock.NewUseCaseMock(ctrl).DeleteCellMock.When(minimock.AnyContext, cellID).Then(errlst.ErrPVZCellNotFound),
+		},
+		{
+			description: "PVZ cell still holds orders",
+			requestBody: &pvz_v1.CellIDRequest{PvzID: 1, Code: "A-01"},
+			wantErr:     status.Errorf(codes.FailedPrecondition, "Error: PVZ cell still holds orders"),
+			useCase:     mock.NewUseCaseMock(ctrl).DeleteCellMock.When(minimock.AnyContext, cellID).Then(errlst.ErrPVZCellInUse),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewPVZHandler(tt.useCase).DeletePVZCell(context.Background(), tt.requestBody)
+
+			assert.Equal(t, tt.wantResp, response)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+// TestPVZHandler_PVZOccupancy is
+func TestPVZHandler_PVZOccupancy(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	occupancy := pvzModel.NewOccupancyResponse(1, []pvzModel.CellOccupancyData{
+		{Code: "A-01", MaxWeight: 30, MaxCount: 4, Orders: 3, Weight: 12},
+		{Code: "A-02", MaxWeight: 30, MaxCount: 4, Orders: 1, Weight: 25},
+	})
+
+	tests := []struct {
+		description string
+		requestID   *pvz_v1.PVZIDRequest
+		wantResp    *pvz_v1.OccupancyResponse
+		wantErr     error
+		useCase     pvz.UseCase
+	}{
+		{
+			description: "Successfully Got PVZ occupancy",
+			requestID:   &pvz_v1.PVZIDRequest{PvzID: 1},
+			wantResp: &pvz_v1.OccupancyResponse{
+				PvzID: 1,
+				Cells: []*pvz_v1.CellOccupancy{
+					{Code: "A-01", MaxWeight: 30, MaxCount: 4, Orders: 3, Weight: 12, Utilization: 0.75},
+					{Code: "A-02", MaxWeight: 30, MaxCount: 4, Orders: 1, Weight: 25, Utilization: 0.25},
+				},
+				Capacity:    8,
+				Occupied:    4,
+				Utilization: 0.5,
+			},
+			useCase: mock.NewUseCaseMock(ctrl).GetOccupancyMock.When(minimock.AnyContext, 1).Then(occupancy, nil),
+		},
+		{
+			description: "PVZ not found",
+			requestID:   &pvz_v1.PVZIDRequest{PvzID: 1},
+			wantErr:     status.Errorf(codes.NotFound, "Error: PVZ not found"),
+			useCase: mock.NewUseCaseMock(ctrl).GetOccupancyMock.When(minimock.AnyContext, 1).
+				Then(pvzModel.OccupancyResponse{}, errlst.ErrPVZNotFound),
+		},
+		{
+			description: "Unable to get pvzID",
+			requestID:   &pvz_v1.PVZIDRequest{PvzID: -1},
+			wantErr:     status.Errorf(codes.InvalidArgument, "invalid request id"),
+			useCase:     mock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewPVZHandler(tt.useCase).PVZOccupancy(context.Background(), tt.requestID)
+
+			assert.Equal(t, tt.wantResp, response)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+// TestPVZHandler_SearchNearbyPVZ is
+func TestPVZHandler_SearchNearbyPVZ(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	fixedTime := time.Date(2022, time.January, 1, 12, 0, 0, 0, time.UTC)
+	nearbyRequest := pvzModel.NearbyRequest{
+		Point:    &pvzModel.Location{Latitude: 55.7963, Longitude: 49.1088},
+		RadiusKm: 5,
+		Page:     abstractModel.Page{CurrentPage: 1, ItemsPerPage: 10},
+	}
+
+	tests := []struct {
+		description string
+		request     *pvz_v1.NearbyRequest
+		wantResp    *pvz_v1.NearbyResponse
+		wantErr     error
+		useCase     pvz.UseCase
+	}{
+		{
+			description: "Successfully searched nearby PVZ",
+			request: &pvz_v1.NearbyRequest{
+				Point:    &pvz_v1.GeoPoint{Latitude: 55.7963, Longitude: 49.1088},
+				RadiusKm: 5,
+				Page:     &abstract.Page{CurrentPage: 1, ItemsPerPage: 10},
+			},
+			wantResp: &pvz_v1.NearbyResponse{
+				NearbyPVZ: []*pvz_v1.NearbyPVZ{
+					{
+						PvzAllInfo: &pvz_v1.PVZAllInfo{
+							ID: 1,
+							Pvz: &pvz_v1.PVZ{
+								Name:     "Sample PVZ",
+								Address:  "kazan",
+								Contact:  "+6546545654",
+								Location: &pvz_v1.GeoPoint{Latitude: 55.79, Longitude: 49.12},
+							},
+							CreatedAt: timestamppb.New(fixedTime),
+							UpdatedAt: timestamppb.New(fixedTime),
+						},
+						DistanceKm: 0.9,
+					},
+				},
+				Pagination: &abstract.Pagination{
+					Page:       &abstract.Page{CurrentPage: 1, ItemsPerPage: 1},
+					TotalItems: 1,
+				},
+			},
+			useCase: mock.NewUseCaseMock(ctrl).
+				SearchNearbyPVZMock.
+				When(minimock.AnyContext, nearbyRequest).
+				Then(
+					abstractModel.PaginatedResponse[pvzModel.NearbyResponse]{
+						Items: []pvzModel.NearbyResponse{
+							{
+								AllResponse: pvzModel.AllResponse{
+									ID:        1,
+									Name:      "Sample PVZ",
+									Address:   "kazan",
+									Contact:   "+6546545654",
+									CreatedAt: fixedTime,
+									UpdatedAt: fixedTime,
+									Location:  &pvzModel.Location{Latitude: 55.79, Longitude: 49.12},
+								},
+								DistanceKm: 0.9,
+							},
+						},
+						CurrentPage:  1,
+						ItemsPerPage: 1,
+						TotalItems:   1,
+					},
+					nil,
+				),
+		},
+		{
+			description: "Point out of range",
+			request: &pvz_v1.NearbyRequest{
+				Point:    &pvz_v1.GeoPoint{Latitude: 95, Longitude: 49.1088},
+				RadiusKm: 5,
+				Page:     &abstract.Page{CurrentPage: 1, ItemsPerPage: 10},
+			},
+			wantErr: status.Errorf(codes.InvalidArgument, "Failed to read request body: Key: 'NearbyRequest.Point.Latitude' Error:Field validation for 'Latitude' failed on the 'lte' tag"),
+			useCase: mock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Unable to search nearby PVZ",
+			request: &pvz_v1.NearbyRequest{
+				Point:    &pvz_v1.GeoPoint{Latitude: 55.7963, Longitude: 49.1088},
+				RadiusKm: 5,
+				Page:     &abstract.Page{CurrentPage: 1, ItemsPerPage: 10},
+			},
+			wantErr: status.Errorf(codes.Internal, "Failed to search nearby PVZ: connection refused"),
+			useCase: mock.NewUseCaseMock(ctrl).
+				SearchNearbyPVZMock.
+				When(minimock.AnyContext, nearbyRequest).
+				Then(abstractModel.PaginatedResponse[pvzModel.NearbyResponse]{}, errors.New("connection refused")),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewPVZHandler(tt.useCase).SearchNearbyPVZ(context.Background(), tt.request)
+
+			assert.Equal(t, tt.wantResp, response)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+// TestPVZHandler_SearchPVZ is
+func TestPVZHandler_SearchPVZ(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	fixedTime := time.Date(2022, time.January, 1, 12, 0, 0, 0, time.UTC)
+	searchRequest := pvzModel.SearchRequest{
+		City:  "Kazan",
+		Query: "baumana",
+		Page:  abstractModel.Page{CurrentPage: 1, ItemsPerPage: 10},
+	}
+
+	tests := []struct {
+		description string
+		request     *pvz_v1.SearchRequest
+		wantResp    *pvz_v1.ListResponse
+		wantErr     error
+		useCase     pvz.UseCase
+	}{
+		{
+			description: "Successfully searched PVZ",
+			request: &pvz_v1.SearchRequest{
+				City:  "Kazan",
+				Query: "baumana",
+				Page:  &abstract.Page{CurrentPage: 1, ItemsPerPage: 10},
+			},
+			wantResp: &pvz_v1.ListResponse{
+				PvzAllInfo: []*pvz_v1.PVZAllInfo{
+					{
+						ID: 1,
+						Pvz: &pvz_v1.PVZ{
+							Name:    "Sample PVZ",
+							Address: "420111, Russia, Kazan, Baumana, 1",
+							Contact: "+6546545654",
+							StructuredAddress: &pvz_v1.StructuredAddress{
+								Country:    "Russia",
+								City:       "Kazan",
+								Street:     "Baumana",
+								Building:   "1",
+								PostalCode: "420111",
+							},
+						},
+						CreatedAt: timestamppb.New(fixedTime),
+						UpdatedAt: timestamppb.New(fixedTime),
+					},
+				},
+				Pagination: &abstract.Pagination{
+					Page:       &abstract.Page{CurrentPage: 1, ItemsPerPage: 1},
+					TotalItems: 1,
+				},
+			},
+			useCase: mock.NewUseCaseMock(ctrl).
+				SearchPVZMock.
+				When(minimock.AnyContext, searchRequest).
+				Then(
+					abstractModel.PaginatedResponse[pvzModel.AllResponse]{
+						Items: []pvzModel.AllResponse{
+							{
+								ID:        1,
+								Name:      "Sample PVZ",
+								Address:   "420111, Russia, Kazan, Baumana, 1",
+								Contact:   "+6546545654",
+								CreatedAt: fixedTime,
+								UpdatedAt: fixedTime,
+								StructuredAddress: pvzModel.StructuredAddress{
+									Country:    "Russia",
+									City:       "Kazan",
+									Street:     "Baumana",
+									Building:   "1",
+									PostalCode: "420111",
+								},
+							},
+						},
+						CurrentPage:  1,
+						ItemsPerPage: 1,
+						TotalItems:   1,
+					},
+					nil,
+				),
+		},
+		{
+			description: "Page is not set",
+			request: &pvz_v1.SearchRequest{
+				City: "Kazan",
+			},
+			wantErr: status.Errorf(codes.InvalidArgument, "Failed to read request body: Key: 'SearchRequest.Page.CurrentPage' Error:Field validation for 'CurrentPage' failed on the 'required' tag\n"+
+				"Key: 'SearchRequest.Page.ItemsPerPage' Error:Field validation for 'ItemsPerPage' failed on the 'required' tag"),
+			useCase: mock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Unable to search PVZ",
+			request: &pvz_v1.SearchRequest{
+				City:  "Kazan",
+				Query: "baumana",
+				Page:  &abstract.Page{CurrentPage: 1, ItemsPerPage: 10},
+			},
+			wantErr: status.Errorf(codes.Internal, "Failed to search PVZ: connection refused"),
+			useCase: mock.NewUseCaseMock(ctrl).
+				SearchPVZMock.
+				When(minimock.AnyContext, searchRequest).
+				Then(abstractModel.PaginatedResponse[pvzModel.AllResponse]{}, errors.New("connection refused")),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewPVZHandler(tt.useCase).SearchPVZ(context.Background(), tt.request)
+
+			assert.Equal(t, tt.wantResp, response)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+// TestPVZHandler_RestorePVZ is
+func TestPVZHandler_RestorePVZ(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+
+	tests := []struct {
+		description string
+		requestID   *pvz_v1.PVZIDRequest
+		wantResp    *abstract.MessageResponse
+		wantErr     error
+		useCase     pvz.UseCase
+	}{
+		{
+			description: "Successfully Restored PVZ",
+			requestID:   &pvz_v1.PVZIDRequest{PvzID: 1},
+			wantResp:    &abstract.MessageResponse{Message: "Successfully Restored PVZ"},
+			useCase:     mock.NewUseCaseMock(ctrl).RestorePVZMock.When(minimock.AnyContext, 1).Then(nil),
+		},
+		{
+			description: "Deleted PVZ not found",
+			requestID:   &pvz_v1.PVZIDRequest{PvzID: 1},
+			wantErr:     status.Errorf(codes.NotFound, "Error: PVZ not found"),
+			useCase:     mock.NewUseCaseMock(ctrl).RestorePVZMock.When(minimock.AnyContext, 1).Then(errlst.ErrPVZNotFound),
+		},
+		{
+			description: "Same PVZ is live",
+			requestID:   &pvz_v1.PVZIDRequest{PvzID: 1},
+			wantErr:     status.Errorf(codes.AlreadyExists, "Failed to restore: PVZ already exists in PVZ storage"),
+			useCase:     mock.NewUseCaseMock(ctrl).RestorePVZMock.When(minimock.AnyContext, 1).Then(errlst.ErrPVZAlreadyExists),
+		},
+		{
+			description: "Unable to get pvzID",
+			requestID:   &pvz_v1.PVZIDRequest{PvzID: 0},
+			wantErr:     status.Errorf(codes.InvalidArgument, "invalid request id"),
+			useCase:     mock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewPVZHandler(tt.useCase).RestorePVZ(context.Background(), tt.requestID)
+
+			assert.Equal(t, tt.wantResp, response)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+// TestPVZHandler_PurgePVZ is
+func TestPVZHandler_PurgePVZ(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+
+	tests := []struct {
+		description string
+		requestID   *pvz_v1.PVZIDRequest
+		wantResp    *abstract.MessageResponse
+		wantErr     error
+		useCase     pvz.UseCase
+	}{
+		{
+			description: "Successfully Purged PVZ",
+			requestID:   &pvz_v1.PVZIDRequest{PvzID: 1},
+			wantResp:    &abstract.MessageResponse{Message: "Successfully Purged PVZ"},
+			useCase:     mock.NewUseCaseMock(ctrl).PurgePVZMock.When(minimock.AnyContext, 1).Then(nil),
+		},
+		{
+			description: "Deleted PVZ not found",
+			requestID:   &pvz_v1.PVZIDRequest{PvzID: 1},
+			wantErr:     status.Errorf(codes.NotFound, "Error: PVZ not found"),
+			useCase:     mock.NewUseCaseMock(ctrl).PurgePVZMock.When(minimock.AnyContext, 1).Then(errlst.ErrPVZNotFound),
+		},
+		{
+			description: "PVZ has dependents",
+			requestID:   &pvz_v1.PVZIDRequest{PvzID: 1},
+			wantErr:     status.Errorf(codes.FailedPrecondition, "Error: PVZ is still referenced by orders"),
+			useCase:     mock.NewUseCaseMock(ctrl).PurgePVZMock.When(minimock.AnyContext, 1).Then(errlst.ErrPVZHasDependents),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			response, err := NewPVZHandler(tt.useCase).PurgePVZ(context.Background(), tt.requestID)
+
+			assert.Equal(t, tt.wantResp, response)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -13,5 +13,13 @@ type Handlers interface {
 	GetPVZByID(ctx context.Context, request *pvz_v1.PVZIDRequest) (*pvz_v1.PVZAllInfo, error)
 	ListPVZ(ctx context.Context, request *abstract.Page) (*pvz_v1.ListResponse, error)
 	UpdatePVZ(ctx context.Context, request *pvz_v1.UpdateRequest) (*abstract.MessageResponse, error)
-	DeletePVZ(ctx context.Context, request *pvz_v1.PVZIDRequest) (*abstract.MessageResponse, error)
+	DeletePVZ(ctx context.Context, request *pvz_v1.DeletePVZRequest) (*abstract.MessageResponse, error)
+	ListDeletedPVZ(ctx context.Context, request *abstract.Page) (*pvz_v1.ListResponse, error)
+	RestorePVZ(ctx context.Context, request *pvz_v1.PVZIDRequest) (*abstract.MessageResponse, error)
+	PurgePVZ(ctx context.Context, request *pvz_v1.PVZIDRequest) (*abstract.MessageResponse, error)
+	CreatePVZCell(ctx context.Context, request *pvz_v1.CellCreateRequest) (*abstract.MessageResponse, error)
+	DeletePVZCell(ctx context.Context, request *pvz_v1.CellIDRequest) (*abstract.MessageResponse, error)
+	PVZOccupancy(ctx context.Context, request *pvz_v1.PVZIDRequest) (*pvz_v1.OccupancyResponse, error)
+	SearchPVZ(ctx context.Context, request *pvz_v1.SearchRequest) (*pvz_v1.ListResponse, error)
+	SearchNearbyPVZ(ctx context.Context, request *pvz_v1.NearbyRequest) (*pvz_v1.NearbyResponse, error)
 }
@@ -17,6 +17,23 @@ type Repository interface {
 		pvzPaginationData abstract.PageData,
 	) ([]pvz.AllData, error)
 	CountOfPVZ(ctx context.Context) (int64, error)
+	ListNearbyPVZ(ctx context.Context, nearbyData pvz.NearbyData) ([]pvz.NearbyAllData, error)
+	CountNearbyPVZ(ctx context.Context, nearbyData pvz.NearbyData) (int64, error)
+	SearchPVZ(ctx context.Context, searchData pvz.SearchData) ([]pvz.AllData, error)
+	CountSearchPVZ(ctx context.Context, searchData pvz.SearchData) (int64, error)
 	UpdatePVZ(ctx context.Context, updatePVZData pvz.UpdateData) error
-	DeletePVZByID(ctx context.Context, pvzID int64) error
+	DeletePVZByID(ctx context.Context, deleteData pvz.DeleteRequest) error
+	LockPVZ(ctx context.Context, pvzID int64) error
+	ShareLivePVZ(ctx context.Context, pvzID int64) error
+	ListDeletedPVZ(ctx context.Context, pvzPaginationData abstract.PageData) ([]pvz.AllData, error)
+	CountDeletedPVZ(ctx context.Context) (int64, error)
+	GetDeletedPVZ(ctx context.Context, pvzID int64) (pvz.AllData, error)
+	RestorePVZ(ctx context.Context, pvzID int64) error
+	CountPVZDependents(ctx context.Context, pvzID int64) (int64, error)
+	PurgePVZ(ctx context.Context, pvzID int64) error
+	GetSchedule(ctx context.Context, pvzID int64) (pvz.ScheduleData, error)
+	CreateCell(ctx context.Context, cellData pvz.CellData) error
+	DeleteCell(ctx context.Context, pvzID int64, code string) error
+	ListCellOccupancy(ctx context.Context, pvzID int64) ([]pvz.CellOccupancyData, error)
+	FindFreeCell(ctx context.Context, pvzID int64, weight float64) (*int64, error)
 }
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
@@ -11,9 +13,14 @@ import (
 	"Homework-1/internal/connection"
 	"Homework-1/internal/model/abstract"
 	"Homework-1/internal/model/pvz"
+	"Homework-1/pkg/constants"
 	"Homework-1/pkg/errlst"
 )
 
+// pvzColumns are the columns a PVZ is read with
+const pvzColumns = "id, name, address, contact, created_at, updated_at, version, timezone, schedule, holidays, latitude, longitude, " +
+	"country, city, street, building, postal_code"
+
 // PVZRepository is
 type PVZRepository struct {
 	psqlDB connection.DB
@@ -31,10 +38,21 @@ func (p *PVZRepository) CreatePVZ(ctx context.Context, pvzData pvz.Data) error {
 	log.Println("[pvz][repository][CreatePVZ]")
 
 	_, err := p.psqlDB.Execute(ctx,
-		"INSERT INTO pvz(name, address, contact) VALUES ($1,$2,$3);",
+		"INSERT INTO pvz(name, address, contact, timezone, schedule, holidays, latitude, longitude, country, city, street, building, postal_code) "+
+			"VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13);",
 		pvzData.Name,
 		pvzData.Address,
 		pvzData.Contact,
+		pvzData.Timezone,
+		pvzData.Days,
+		pvzData.Holidays,
+		pvzData.Latitude,
+		pvzData.Longitude,
+		pvzData.Country,
+		pvzData.City,
+		pvzData.Street,
+		pvzData.Building,
+		pvzData.PostalCode,
 	)
 	if err != nil {
 		return fmt.Errorf("p.psqlDB.ExecContext: %w", err)
@@ -74,7 +92,7 @@ func (p *PVZRepository) GetPVZ(ctx context.Context, pvzID int64) (pvz.AllData, e
 	err := p.psqlDB.Get(
 		ctx,
 		&pvzAllData,
-		"Select id, name, address, contact, created_at, updated_at FROM pvz Where  id=$1 AND deleted_at IS NULL",
+		"SELECT "+pvzColumns+" FROM pvz WHERE id=$1 AND deleted_at IS NULL",
 		pvzID,
 	)
 	if err != nil {
@@ -96,7 +114,7 @@ func (p *PVZRepository) ListPVZ(
 	err := p.psqlDB.Select(
 		ctx,
 		&pvzAllData,
-		"SELECT id, name, address, contact, created_at, updated_at FROM pvz WHERE deleted_at IS NULL "+
+		"SELECT "+pvzColumns+" FROM pvz WHERE deleted_at IS NULL "+
 			"ORDER BY created_at DESC OFFSET $1 LIMIT $2",
 		offset,
 		pvzPaginationData.ItemsPerPage,
@@ -125,7 +143,124 @@ func (p *PVZRepository) CountOfPVZ(ctx context.Context) (int64, error) {
 	return totalCount, nil
 }
 
-// UpdatePVZ is
+// nearbyPVZ is the haversine distance in kilometers from the point $1, $2 to every PVZ with a location
+// inside the bounding box $3..$4, $5..$6 of the circle, computed with plain SQL to need no extensions
+var nearbyPVZ = fmt.Sprintf(
+	"WITH nearby AS ("+
+		"SELECT "+pvzColumns+", "+
+		"2 * %f * ASIN(LEAST(1, SQRT("+
+		"POWER(SIN(RADIANS(latitude - $1) / 2), 2) + "+
+		"COS(RADIANS($1)) * COS(RADIANS(latitude)) * POWER(SIN(RADIANS(longitude - $2) / 2), 2)"+
+		"))) AS distance_km "+
+		"FROM pvz WHERE deleted_at IS NULL AND latitude BETWEEN $3 AND $4 AND longitude BETWEEN $5 AND $6"+
+		") ",
+	constants.EarthRadiusKm,
+)
+
+// ListNearbyPVZ is
+func (p *PVZRepository) ListNearbyPVZ(ctx context.Context, nearbyData pvz.NearbyData) ([]pvz.NearbyAllData, error) {
+	log.Println("[pvz][repository][ListNearbyPVZ]")
+	offset := (nearbyData.CurrentPage - 1) * nearbyData.ItemsPerPage
+	var nearbyAllData []pvz.NearbyAllData
+
+	err := p.psqlDB.Select(
+		ctx,
+		&nearbyAllData,
+		nearbyPVZ+"SELECT * FROM nearby WHERE distance_km <= $7 ORDER BY distance_km, id OFFSET $8 LIMIT $9",
+		nearbyData.Latitude,
+		nearbyData.Longitude,
+		nearbyData.MinLatitude,
+		nearbyData.MaxLatitude,
+		nearbyData.MinLongitude,
+		nearbyData.MaxLongitude,
+		nearbyData.RadiusKm,
+		offset,
+		nearbyData.ItemsPerPage,
+	)
+	if err != nil {
+		return []pvz.NearbyAllData{}, fmt.Errorf("p.psqlDB.Select: %w", err)
+	}
+
+	return nearbyAllData, nil
+}
+
+// CountNearbyPVZ is
+func (p *PVZRepository) CountNearbyPVZ(ctx context.Context, nearbyData pvz.NearbyData) (int64, error) {
+	log.Println("[pvz][repository][CountNearbyPVZ]")
+	var totalCount int64
+
+	err := p.psqlDB.Get(
+		ctx,
+		&totalCount,
+		nearbyPVZ+"SELECT COUNT(*) FROM nearby WHERE distance_km <= $7",
+		nearbyData.Latitude,
+		nearbyData.Longitude,
+		nearbyData.MinLatitude,
+		nearbyData.MaxLatitude,
+		nearbyData.MinLongitude,
+		nearbyData.MaxLongitude,
+		nearbyData.RadiusKm,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("p.psqlDB.Get: %w", err)
+	}
+
+	return totalCount, nil
+}
+
+// searchPVZ is the PVZ in the city $1 whose name or address match the query $2 by words or by trigrams,
+// rank is how well they match. An empty city or query matches every PVZ
+const searchPVZ = "WITH found AS (" +
+	"SELECT " + pvzColumns + ", " +
+	"CASE WHEN $2 = '' THEN 0 ELSE " +
+	"ts_rank(to_tsvector('simple', search_text), websearch_to_tsquery('simple', $2)) + word_similarity($2, search_text) " +
+	"END AS rank " +
+	"FROM pvz WHERE deleted_at IS NULL AND ($1 = '' OR lower(city) = lower($1)) " +
+	"AND ($2 = '' OR to_tsvector('simple', search_text) @@ websearch_to_tsquery('simple', $2) OR $2 <% search_text)" +
+	") "
+
+// SearchPVZ is
+func (p *PVZRepository) SearchPVZ(ctx context.Context, searchData pvz.SearchData) ([]pvz.AllData, error) {
+	log.Println("[pvz][repository][SearchPVZ]")
+	offset := (searchData.CurrentPage - 1) * searchData.ItemsPerPage
+	var pvzAllData []pvz.AllData
+
+	err := p.psqlDB.Select(
+		ctx,
+		&pvzAllData,
+		searchPVZ+"SELECT "+pvzColumns+" FROM found ORDER BY rank DESC, id OFFSET $3 LIMIT $4",
+		searchData.City,
+		searchData.Query,
+		offset,
+		searchData.ItemsPerPage,
+	)
+	if err != nil {
+		return []pvz.AllData{}, fmt.Errorf("p.psqlDB.Select: %w", err)
+	}
+
+	return pvzAllData, nil
+}
+
+// CountSearchPVZ is
+func (p *PVZRepository) CountSearchPVZ(ctx context.Context, searchData pvz.SearchData) (int64, error) {
+	log.Println("[pvz][repository][CountSearchPVZ]")
+	var totalCount int64
+
+	err := p.psqlDB.Get(
+		ctx,
+		&totalCount,
+		searchPVZ+"SELECT COUNT(*) FROM found",
+		searchData.City,
+		searchData.Query,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("p.psqlDB.Get: %w", err)
+	}
+
+	return totalCount, nil
+}
+
+// UpdatePVZ is, the PVZ is changed only while it is still at the version the change was made on
 func (p *PVZRepository) UpdatePVZ(ctx context.Context, updatePVZData pvz.UpdateData) error {
 	log.Println("[pvz][repository][UpdatePVZ]")
 
@@ -154,11 +289,40 @@ func (p *PVZRepository) UpdatePVZ(ctx context.Context, updatePVZData pvz.UpdateD
 		setValues = append(setValues, *updatePVZData.Contact)
 		num++
 	}
-	query += " updated_at = NOW(),"
+
+	if updatePVZData.Schedule != nil {
+		query += " timezone = $" + strconv.Itoa(num) + ", schedule = $" + strconv.Itoa(num+1) + ", holidays = $" + strconv.Itoa(num+2) + ","
+
+		setValues = append(setValues, updatePVZData.Schedule.Timezone, updatePVZData.Schedule.Days, updatePVZData.Schedule.Holidays)
+		num += 3
+	}
+
+	if updatePVZData.Location != nil {
+		query += " latitude = $" + strconv.Itoa(num) + ", longitude = $" + strconv.Itoa(num+1) + ","
+
+		setValues = append(setValues, updatePVZData.Location.Latitude, updatePVZData.Location.Longitude)
+		num += 2
+	}
+
+	if updatePVZData.StructuredAddress != nil {
+		query += " country = $" + strconv.Itoa(num) + ", city = $" + strconv.Itoa(num+1) + ", street = $" + strconv.Itoa(num+2) +
+			", building = $" + strconv.Itoa(num+3) + ", postal_code = $" + strconv.Itoa(num+4) + ","
+
+		setValues = append(
+			setValues,
+			updatePVZData.StructuredAddress.Country,
+			updatePVZData.StructuredAddress.City,
+			updatePVZData.StructuredAddress.Street,
+			updatePVZData.StructuredAddress.Building,
+			updatePVZData.StructuredAddress.PostalCode,
+		)
+		num += 5
+	}
+	query += " updated_at = NOW(), version = version + 1,"
 	query = strings.TrimSuffix(query, ",")
-	query += " WHERE id = $" + strconv.Itoa(num)
+	query += " WHERE id = $" + strconv.Itoa(num) + " AND version = $" + strconv.Itoa(num+1) + " AND deleted_at IS NULL"
 
-	setValues = append(setValues, updatePVZData.ID)
+	setValues = append(setValues, updatePVZData.ID, updatePVZData.Version)
 
 	result, err := p.psqlDB.Execute(ctx, query, setValues...)
 	if err != nil {
@@ -171,22 +335,181 @@ func (p *PVZRepository) UpdatePVZ(ctx context.Context, updatePVZData pvz.UpdateD
 	}
 
 	if rows == 0 {
-		return errlst.ErrPVZNotFound
+		return p.versionMismatch(ctx, updatePVZData.ID)
 	}
 
 	return nil
 }
 
 // DeletePVZByID is
-func (p *PVZRepository) DeletePVZByID(ctx context.Context, pvzID int64) error {
+func (p *PVZRepository) DeletePVZByID(ctx context.Context, deleteData pvz.DeleteRequest) error {
 	log.Println("[pvz][repository][DeletePVZ]")
 
 	result, err := p.psqlDB.Execute(
 		ctx,
-		"UPDATE pvz SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL",
+		"UPDATE pvz SET deleted_at = $1, version = version + 1 WHERE id = $2 AND version = $3 AND deleted_at IS NULL",
 		time.Now(),
+		deleteData.ID,
+		deleteData.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.ExecContext: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	if rows == 0 {
+		return p.versionMismatch(ctx, deleteData.ID)
+	}
+
+	return nil
+}
+
+// LockPVZ locks the row of the PVZ until the end of the transaction, an order that is received into the PVZ
+// waits for it, so the orders counted under the lock stay the orders of the PVZ. A missing PVZ is left to the caller
+func (p *PVZRepository) LockPVZ(ctx context.Context, pvzID int64) error {
+	log.Println("[pvz][repository][LockPVZ]")
+	var ids []int64
+
+	err := p.psqlDB.Select(ctx, &ids, "SELECT id FROM pvz WHERE id = $1 FOR UPDATE", pvzID)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.Select: %w", err)
+	}
+
+	return nil
+}
+
+// ShareLivePVZ locks the live PVZ against a delete until the end of the transaction, an order is received only
+// under it, so a PVZ deleted before the lock is taken is not found and a delete that comes later counts the order
+func (p *PVZRepository) ShareLivePVZ(ctx context.Context, pvzID int64) error {
+	log.Println("[pvz][repository][ShareLivePVZ]")
+	var ids []int64
+
+	err := p.psqlDB.Select(ctx, &ids, "SELECT id FROM pvz WHERE id = $1 AND deleted_at IS NULL FOR SHARE", pvzID)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.Select: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return errlst.ErrPVZNotFound
+	}
+
+	return nil
+}
+
+// ListDeletedPVZ is
+func (p *PVZRepository) ListDeletedPVZ(ctx context.Context, pvzPaginationData abstract.PageData) ([]pvz.AllData, error) {
+	log.Println("[pvz][repository][ListDeletedPVZ]")
+	offset := (pvzPaginationData.CurrentPage - 1) * pvzPaginationData.ItemsPerPage
+	var pvzAllData []pvz.AllData
+
+	err := p.psqlDB.Select(
+		ctx,
+		&pvzAllData,
+		"SELECT "+pvzColumns+", deleted_at FROM pvz WHERE deleted_at IS NOT NULL "+
+			"ORDER BY deleted_at DESC, id OFFSET $1 LIMIT $2",
+		offset,
+		pvzPaginationData.ItemsPerPage,
+	)
+	if err != nil {
+		return []pvz.AllData{}, fmt.Errorf("p.psqlDB.Select: %w", err)
+	}
+
+	return pvzAllData, nil
+}
+
+// CountDeletedPVZ is
+func (p *PVZRepository) CountDeletedPVZ(ctx context.Context) (int64, error) {
+	log.Println("[pvz][repository][CountDeletedPVZ]")
+	var totalCount int64
+
+	err := p.psqlDB.Get(
+		ctx,
+		&totalCount,
+		"SELECT COUNT(*) FROM pvz WHERE deleted_at IS NOT NULL",
+	)
+	if err != nil {
+		return 0, fmt.Errorf("p.psqlDB.Get: %w", err)
+	}
+
+	return totalCount, nil
+}
+
+// GetDeletedPVZ is, the row is locked until the end of the transaction so nothing can refer to the PVZ while it is purged or restored
+func (p *PVZRepository) GetDeletedPVZ(ctx context.Context, pvzID int64) (pvz.AllData, error) {
+	log.Println("[pvz][repository][GetDeletedPVZ]")
+
+	var pvzAllData pvz.AllData
+
+	err := p.psqlDB.Get(
+		ctx,
+		&pvzAllData,
+		"SELECT "+pvzColumns+", deleted_at FROM pvz WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE",
+		pvzID,
+	)
+	if err != nil {
+		return pvz.AllData{}, fmt.Errorf("p.psqlDB.Get: %w", err)
+	}
+
+	return pvzAllData, nil
+}
+
+// RestorePVZ is, the restored PVZ gets a new version
+func (p *PVZRepository) RestorePVZ(ctx context.Context, pvzID int64) error {
+	log.Println("[pvz][repository][RestorePVZ]")
+
+	result, err := p.psqlDB.Execute(
+		ctx,
+		"UPDATE pvz SET deleted_at = NULL, updated_at = NOW(), version = version + 1 WHERE id = $1 AND deleted_at IS NOT NULL",
+		pvzID,
+	)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.ExecContext: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	if rows == 0 {
+		return errlst.ErrPVZNotFound
+	}
+
+	return nil
+}
+
+// CountPVZDependents is the number of orders that were ever received at the PVZ
+func (p *PVZRepository) CountPVZDependents(ctx context.Context, pvzID int64) (int64, error) {
+	log.Println("[pvz][repository][CountPVZDependents]")
+	var totalCount int64
+
+	err := p.psqlDB.Get(
+		ctx,
+		&totalCount,
+		"SELECT COUNT(*) FROM orders WHERE pvz_id = $1",
 		pvzID,
 	)
+	if err != nil {
+		return 0, fmt.Errorf("p.psqlDB.Get: %w", err)
+	}
+
+	return totalCount, nil
+}
+
+// PurgePVZ is, a deleted PVZ is removed for good together with its cells
+func (p *PVZRepository) PurgePVZ(ctx context.Context, pvzID int64) error {
+	log.Println("[pvz][repository][PurgePVZ]")
+
+	_, err := p.psqlDB.Execute(ctx, "DELETE FROM pvz_cell WHERE pvz_id = $1", pvzID)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.ExecContext: %w", err)
+	}
+
+	result, err := p.psqlDB.Execute(ctx, "DELETE FROM pvz WHERE id = $1 AND deleted_at IS NOT NULL", pvzID)
 	if err != nil {
 		return fmt.Errorf("p.psqlDB.ExecContext: %w", err)
 	}
@@ -202,3 +525,171 @@ func (p *PVZRepository) DeletePVZByID(ctx context.Context, pvzID int64) error {
 
 	return nil
 }
+
+// versionMismatch tells why nothing was changed, the PVZ is either gone or at another version
+func (p *PVZRepository) versionMismatch(ctx context.Context, pvzID int64) error {
+	var exists bool
+
+	err := p.psqlDB.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM pvz WHERE id = $1 AND deleted_at IS NULL)",
+		pvzID,
+	).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.QueryRowContext: %w", err)
+	}
+
+	if !exists {
+		return errlst.ErrPVZNotFound
+	}
+
+	return errlst.ErrPVZVersionMismatch
+}
+
+// GetSchedule is
+func (p *PVZRepository) GetSchedule(ctx context.Context, pvzID int64) (pvz.ScheduleData, error) {
+	log.Println("[pvz][repository][GetSchedule]")
+
+	var scheduleData pvz.ScheduleData
+
+	err := p.psqlDB.Get(
+		ctx,
+		&scheduleData,
+		"SELECT timezone, schedule, holidays FROM pvz WHERE id = $1 AND deleted_at IS NULL",
+		pvzID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return pvz.ScheduleData{}, errlst.ErrPVZNotFound
+		}
+
+		return pvz.ScheduleData{}, fmt.Errorf("p.psqlDB.Get: %w", err)
+	}
+
+	return scheduleData, nil
+}
+
+// CreateCell is
+func (p *PVZRepository) CreateCell(ctx context.Context, cellData pvz.CellData) error {
+	log.Println("[pvz][repository][CreateCell]")
+
+	result, err := p.psqlDB.Execute(ctx,
+		"INSERT INTO pvz_cell(pvz_id, code, max_weight, max_count) "+
+			"SELECT $1,$2,$3,$4 WHERE EXISTS (SELECT 1 FROM pvz WHERE id = $1 AND deleted_at IS NULL)",
+		cellData.PVZID,
+		cellData.Code,
+		cellData.MaxWeight,
+		cellData.MaxCount,
+	)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.ExecContext: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	if rows == 0 {
+		return errlst.ErrPVZNotFound
+	}
+
+	return nil
+}
+
+// DeleteCell is, a cell that still holds orders is kept
+func (p *PVZRepository) DeleteCell(ctx context.Context, pvzID int64, code string) error {
+	log.Println("[pvz][repository][DeleteCell]")
+
+	result, err := p.psqlDB.Execute(ctx,
+		"DELETE FROM pvz_cell c WHERE c.pvz_id = $1 AND c.code = $2 AND NOT EXISTS (SELECT 1 FROM orders o WHERE o.cell_id = c.id)",
+		pvzID,
+		code,
+	)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.ExecContext: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	if rows > 0 {
+		return nil
+	}
+
+	var exists bool
+
+	err = p.psqlDB.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM pvz_cell WHERE pvz_id = $1 AND code = $2)",
+		pvzID,
+		code,
+	).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("p.psqlDB.QueryRowContext: %w", err)
+	}
+
+	if !exists {
+		return errlst.ErrPVZCellNotFound
+	}
+
+	return errlst.ErrPVZCellInUse
+}
+
+// ListCellOccupancy is
+func (p *PVZRepository) ListCellOccupancy(ctx context.Context, pvzID int64) ([]pvz.CellOccupancyData, error) {
+	log.Println("[pvz][repository][ListCellOccupancy]")
+
+	var cellsData []pvz.CellOccupancyData
+
+	err := p.psqlDB.Select(
+		ctx,
+		&cellsData,
+		"SELECT c.code, c.max_weight, c.max_count, COUNT(o.order_id) AS orders, COALESCE(SUM(o.weight), 0) AS weight "+
+			"FROM pvz_cell c LEFT JOIN orders o ON o.cell_id = c.id WHERE c.pvz_id = $1 GROUP BY c.id ORDER BY c.code",
+		pvzID,
+	)
+	if err != nil {
+		return []pvz.CellOccupancyData{}, fmt.Errorf("p.psqlDB.Select: %w", err)
+	}
+
+	return cellsData, nil
+}
+
+// FindFreeCell is the first cell by code that can take one more order of the weight. The cells of the PVZ
+// stay locked until the transaction ends so that two orders can not take the last place of a cell,
+// a PVZ without cells keeps its orders without one and gets no cell
+func (p *PVZRepository) FindFreeCell(ctx context.Context, pvzID int64, weight float64) (*int64, error) {
+	log.Println("[pvz][repository][FindFreeCell]")
+
+	var cellIDs []int64
+
+	err := p.psqlDB.Select(ctx, &cellIDs, "SELECT id FROM pvz_cell WHERE pvz_id = $1 ORDER BY id FOR UPDATE", pvzID)
+	if err != nil {
+		return nil, fmt.Errorf("p.psqlDB.Select: %w", err)
+	}
+
+	if len(cellIDs) == 0 {
+		return nil, nil
+	}
+
+	var cellID int64
+
+	err = p.psqlDB.Get(
+		ctx,
+		&cellID,
+		"SELECT c.id FROM pvz_cell c LEFT JOIN orders o ON o.cell_id = c.id WHERE c.pvz_id = $1 GROUP BY c.id "+
+			"HAVING COUNT(o.order_id) < c.max_count AND COALESCE(SUM(o.weight), 0) + $2 <= c.max_weight ORDER BY c.code LIMIT 1",
+		pvzID,
+		weight,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errlst.ErrPVZFull
+		}
+
+		return nil, fmt.Errorf("p.psqlDB.Get: %w", err)
+	}
+
+	return &cellID, nil
+}
@@ -16,7 +16,15 @@ import (
 type UseCase interface {
 	CreatePVZ(ctx context.Context, request pvzModel.Request) error
 	GetPVZ(ctx context.Context, pvzID int64) (pvzModel.AllResponse, error)
-	DeletePVZByID(ctx context.Context, pvzID int64) error
+	DeletePVZByID(ctx context.Context, request pvzModel.DeleteRequest) error
 	UpdatePVZ(ctx context.Context, updatePVZRequest pvzModel.UpdateRequest) error
 	ListPVZ(ctx context.Context, pvzPagination abstract.Page) (abstract.PaginatedResponse[pvzModel.AllResponse], error)
+	SearchPVZ(ctx context.Context, request pvzModel.SearchRequest) (abstract.PaginatedResponse[pvzModel.AllResponse], error)
+	SearchNearbyPVZ(ctx context.Context, request pvzModel.NearbyRequest) (abstract.PaginatedResponse[pvzModel.NearbyResponse], error)
+	ListDeletedPVZ(ctx context.Context, pvzPagination abstract.Page) (abstract.PaginatedResponse[pvzModel.AllResponse], error)
+	RestorePVZ(ctx context.Context, pvzID int64) error
+	PurgePVZ(ctx context.Context, pvzID int64) error
+	CreateCell(ctx context.Context, request pvzModel.CellRequest) error
+	DeleteCell(ctx context.Context, request pvzModel.CellIDRequest) error
+	GetOccupancy(ctx context.Context, pvzID int64) (pvzModel.OccupancyResponse, error)
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	"Homework-1/internal/cache"
 	"Homework-1/internal/database"
@@ -72,8 +73,9 @@ func (p *PVZUseCase) GetPVZ(ctx context.Context, pvzID int64) (pvz.AllResponse,
 
 	var response pvz.AllResponse
 
+	// An entry cached before PVZ versions were added has no version and is read again
 	cachedValue, err := p.cache.Get(ctx, cacheArgument)
-	if err == nil && json.Unmarshal(cachedValue, &response) == nil {
+	if err == nil && json.Unmarshal(cachedValue, &response) == nil && response.Version > 0 {
 		span.SetStatus(codes.Ok, "Successfully got Box")
 		return response, nil
 	}
@@ -110,19 +112,34 @@ func (p *PVZUseCase) GetPVZ(ctx context.Context, pvzID int64) (pvz.AllResponse,
 }
 
 // DeletePVZByID is
-func (p *PVZUseCase) DeletePVZByID(ctx context.Context, pvzID int64) error {
+func (p *PVZUseCase) DeletePVZByID(ctx context.Context, request pvz.DeleteRequest) error {
 	log.Println("[pvz][useCase][DeletePVZ]")
 	tracer := otel.Tracer("[pvz_v1][useCase]")
 	ctx, span := tracer.Start(ctx, "[DeletePVZByID]")
 	defer span.End()
 
 	if err := p.repo.WithTransaction(ctx, func(db database.Datastore) error {
-		return db.PvzRepo().DeletePVZByID(ctx, pvzID)
+		if err := db.PvzRepo().LockPVZ(ctx, request.ID); err != nil {
+			return err
+		}
+
+		liveOrders, err := db.OrderRepo().CountLiveOrders(ctx, request.ID)
+		if err != nil {
+			return err
+		}
+
+		if liveOrders > 0 {
+			return errlst.ErrPVZHasLiveOrders
+		}
+
+		return db.PvzRepo().DeletePVZByID(ctx, request)
 	}); err != nil {
 		tracing.ErrorTracer(span, err)
 		return err
 	}
 
+	p.invalidatePVZ(ctx, span, request.ID)
+
 	span.SetStatus(codes.Ok, "Successfully deleted PVZ by ID")
 	return nil
 }
@@ -141,6 +158,8 @@ func (p *PVZUseCase) UpdatePVZ(ctx context.Context, updatePVZRequest pvz.UpdateR
 		return err
 	}
 
+	p.refreshPVZ(ctx, span, updatePVZRequest.ID)
+
 	span.SetStatus(codes.Ok, "Successfully updated PVZ")
 	return nil
 }
@@ -191,3 +210,283 @@ func (p *PVZUseCase) ListPVZ(ctx context.Context, pvzPagination abstract.Page) (
 	span.SetStatus(codes.Ok, "Successfully got list of PVZ")
 	return pvzListResponse, nil
 }
+
+// SearchPVZ is
+func (p *PVZUseCase) SearchPVZ(ctx context.Context, request pvz.SearchRequest) (abstract.PaginatedResponse[pvz.AllResponse], error) {
+	log.Println("[pvz][useCase][SearchPVZ]")
+	tracer := otel.Tracer("[pvz_v1][useCase]")
+	ctx, span := tracer.Start(ctx, "[SearchPVZ]")
+	defer span.End()
+
+	searchData := request.ToStorage()
+	var pvzAllData []pvz.AllData
+	var searchResponse abstract.PaginatedResponse[pvz.AllResponse]
+
+	if err := p.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		count, err := db.PvzRepo().CountSearchPVZ(ctx, searchData)
+		if err != nil {
+			return err
+		}
+
+		searchResponse.TotalItems = count
+		pvzAllData, err = db.PvzRepo().SearchPVZ(ctx, searchData)
+		return err
+	}); err != nil {
+		tracing.ErrorTracer(span, err)
+		return abstract.PaginatedResponse[pvz.AllResponse]{}, err
+	}
+
+	searchResponse.Items = lo.Map(
+		pvzAllData,
+		func(item pvz.AllData, _ int) pvz.AllResponse {
+			return item.ToPVZServer()
+		},
+	)
+	searchResponse.CurrentPage = request.Page.CurrentPage
+	searchResponse.ItemsPerPage = int64(len(searchResponse.Items))
+
+	span.SetStatus(codes.Ok, "Successfully searched PVZ")
+	return searchResponse, nil
+}
+
+// SearchNearbyPVZ is
+func (p *PVZUseCase) SearchNearbyPVZ(ctx context.Context, request pvz.NearbyRequest) (abstract.PaginatedResponse[pvz.NearbyResponse], error) {
+	log.Println("[pvz][useCase][SearchNearbyPVZ]")
+	tracer := otel.Tracer("[pvz_v1][useCase]")
+	ctx, span := tracer.Start(ctx, "[SearchNearbyPVZ]")
+	defer span.End()
+
+	nearbyData := request.ToStorage()
+	var nearbyAllData []pvz.NearbyAllData
+	var nearbyResponse abstract.PaginatedResponse[pvz.NearbyResponse]
+
+	if err := p.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		count, err := db.PvzRepo().CountNearbyPVZ(ctx, nearbyData)
+		if err != nil {
+			return err
+		}
+
+		nearbyResponse.TotalItems = count
+		nearbyAllData, err = db.PvzRepo().ListNearbyPVZ(ctx, nearbyData)
+		return err
+	}); err != nil {
+		tracing.ErrorTracer(span, err)
+		return abstract.PaginatedResponse[pvz.NearbyResponse]{}, err
+	}
+
+	nearbyResponse.Items = lo.Map(
+		nearbyAllData,
+		func(item pvz.NearbyAllData, _ int) pvz.NearbyResponse {
+			return item.ToServer()
+		},
+	)
+	nearbyResponse.CurrentPage = request.Page.CurrentPage
+	nearbyResponse.ItemsPerPage = int64(len(nearbyResponse.Items))
+
+	span.SetStatus(codes.Ok, "Successfully searched nearby PVZ")
+	return nearbyResponse, nil
+}
+
+// ListDeletedPVZ is
+func (p *PVZUseCase) ListDeletedPVZ(ctx context.Context, pvzPagination abstract.Page) (abstract.PaginatedResponse[pvz.AllResponse], error) {
+	log.Println("[pvz][useCase][ListDeletedPVZ]")
+	tracer := otel.Tracer("[pvz_v1][useCase]")
+	ctx, span := tracer.Start(ctx, "[ListDeletedPVZ]")
+	defer span.End()
+
+	var pvzAllData []pvz.AllData
+	var pvzListResponse abstract.PaginatedResponse[pvz.AllResponse]
+
+	if err := p.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		count, err := db.PvzRepo().CountDeletedPVZ(ctx)
+		if err != nil {
+			return err
+		}
+
+		pvzListResponse.TotalItems = count
+		pvzAllData, err = db.PvzRepo().ListDeletedPVZ(ctx, pvzPagination.ToStorage())
+		return err
+	}); err != nil {
+		tracing.ErrorTracer(span, err)
+		return abstract.PaginatedResponse[pvz.AllResponse]{}, err
+	}
+
+	pvzListResponse.Items = lo.Map(
+		pvzAllData,
+		func(item pvz.AllData, _ int) pvz.AllResponse {
+			return item.ToPVZServer()
+		},
+	)
+	pvzListResponse.CurrentPage = pvzPagination.CurrentPage
+	pvzListResponse.ItemsPerPage = int64(len(pvzListResponse.Items))
+
+	span.SetStatus(codes.Ok, "Successfully got list of deleted PVZ")
+	return pvzListResponse, nil
+}
+
+// RestorePVZ is, a PVZ is not restored while a live one has the same name, address and contact
+func (p *PVZUseCase) RestorePVZ(ctx context.Context, pvzID int64) error {
+	log.Println("[pvz][useCase][RestorePVZ]")
+	tracer := otel.Tracer("[pvz_v1][useCase]")
+	ctx, span := tracer.Start(ctx, "[RestorePVZ]")
+	defer span.End()
+
+	if err := p.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		pvzData, err := db.PvzRepo().GetDeletedPVZ(ctx, pvzID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errlst.ErrPVZNotFound
+			}
+			return err
+		}
+
+		err = db.PvzRepo().CheckPVZ(ctx, pvz.Data{Name: pvzData.Name, Address: pvzData.Address, Contact: pvzData.Contact})
+		if err != nil {
+			return err
+		}
+
+		return db.PvzRepo().RestorePVZ(ctx, pvzID)
+	}); err != nil {
+		tracing.ErrorTracer(span, err)
+		return err
+	}
+
+	p.invalidatePVZ(ctx, span, pvzID)
+
+	span.SetStatus(codes.Ok, "Successfully restored PVZ")
+	return nil
+}
+
+// PurgePVZ is, only a deleted PVZ no order refers to can be purged
+func (p *PVZUseCase) PurgePVZ(ctx context.Context, pvzID int64) error {
+	log.Println("[pvz][useCase][PurgePVZ]")
+	tracer := otel.Tracer("[pvz_v1][useCase]")
+	ctx, span := tracer.Start(ctx, "[PurgePVZ]")
+	defer span.End()
+
+	if err := p.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		if _, err := db.PvzRepo().GetDeletedPVZ(ctx, pvzID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errlst.ErrPVZNotFound
+			}
+			return err
+		}
+
+		dependents, err := db.PvzRepo().CountPVZDependents(ctx, pvzID)
+		if err != nil {
+			return err
+		}
+
+		if dependents > 0 {
+			return errlst.ErrPVZHasDependents
+		}
+
+		return db.PvzRepo().PurgePVZ(ctx, pvzID)
+	}); err != nil {
+		tracing.ErrorTracer(span, err)
+		return err
+	}
+
+	p.invalidatePVZ(ctx, span, pvzID)
+
+	span.SetStatus(codes.Ok, "Successfully purged PVZ")
+	return nil
+}
+
+// CreateCell is
+func (p *PVZUseCase) CreateCell(ctx context.Context, request pvz.CellRequest) error {
+	log.Println("[pvz][useCase][CreateCell]")
+	tracer := otel.Tracer("[pvz_v1][useCase]")
+	ctx, span := tracer.Start(ctx, "[CreateCell]")
+	defer span.End()
+
+	if err := p.repo.PvzRepo().CreateCell(ctx, request.ToStorage()); err != nil {
+		tracing.ErrorTracer(span, err)
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Successfully created PVZ cell")
+	return nil
+}
+
+// DeleteCell is
+func (p *PVZUseCase) DeleteCell(ctx context.Context, request pvz.CellIDRequest) error {
+	log.Println("[pvz][useCase][DeleteCell]")
+	tracer := otel.Tracer("[pvz_v1][useCase]")
+	ctx, span := tracer.Start(ctx, "[DeleteCell]")
+	defer span.End()
+
+	if err := p.repo.PvzRepo().DeleteCell(ctx, request.PVZID, request.Code); err != nil {
+		tracing.ErrorTracer(span, err)
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Successfully deleted PVZ cell")
+	return nil
+}
+
+// GetOccupancy is
+func (p *PVZUseCase) GetOccupancy(ctx context.Context, pvzID int64) (pvz.OccupancyResponse, error) {
+	log.Println("[pvz][useCase][GetOccupancy]")
+	tracer := otel.Tracer("[pvz_v1][useCase]")
+	ctx, span := tracer.Start(ctx, "[GetOccupancy]")
+	defer span.End()
+
+	var cellsData []pvz.CellOccupancyData
+
+	if err := p.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		if _, err := db.PvzRepo().GetPVZ(ctx, pvzID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errlst.ErrPVZNotFound
+			}
+			return err
+		}
+
+		var err error
+		cellsData, err = db.PvzRepo().ListCellOccupancy(ctx, pvzID)
+		return err
+	}); err != nil {
+		tracing.ErrorTracer(span, err)
+		return pvz.OccupancyResponse{}, err
+	}
+
+	span.SetStatus(codes.Ok, "Successfully got PVZ occupancy")
+	return pvz.NewOccupancyResponse(pvzID, cellsData), nil
+}
+
+// refreshPVZ caches the PVZ as it is after a change, the entry is dropped when it can not be read again
+func (p *PVZUseCase) refreshPVZ(ctx context.Context, span trace.Span, pvzID int64) {
+	cacheArgument := abstract.CacheArgument{
+		ObjectType: "pvz",
+		ObjectID:   pvzID,
+	}
+
+	pvzData, err := p.repo.PvzRepo().GetPVZ(ctx, pvzID)
+	if err == nil {
+		var marshaledData []byte
+		marshaledData, err = json.Marshal(pvzData.ToPVZServer())
+		if err == nil {
+			err = p.cache.Set(ctx, cacheArgument, marshaledData, pvzTimeDuration)
+		}
+	}
+	if err == nil {
+		return
+	}
+
+	log.Printf("[pvz][usecase][refreshPVZ] %v", err)
+	tracing.ErrorTracer(span, err)
+
+	if err := p.cache.Del(ctx, cacheArgument); err != nil {
+		log.Printf("[pvz][usecase][refreshPVZ] p.cache.Del: %v", err)
+		tracing.ErrorTracer(span, err)
+	}
+}
+
+// invalidatePVZ drops the cached PVZ after it was deleted, restored or purged
+func (p *PVZUseCase) invalidatePVZ(ctx context.Context, span trace.Span, pvzID int64) {
+	err := p.cache.Del(ctx, abstract.CacheArgument{ObjectType: "pvz", ObjectID: pvzID})
+	if err != nil {
+		log.Printf("[pvz][usecase][invalidatePVZ] p.cache.Del: %v", err)
+		tracing.ErrorTracer(span, err)
+	}
+}
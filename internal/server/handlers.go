@@ -5,6 +5,7 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
 	"os"
@@ -18,69 +19,129 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	BoxDelivery "Homework-1/internal/box/delivery"
 	BoxUseCase "Homework-1/internal/box/usecase"
+	"Homework-1/internal/config"
+	"Homework-1/internal/idempotency"
 	"Homework-1/internal/kafka"
 	"Homework-1/internal/metrics"
+	idempotencyModel "Homework-1/internal/model/idempotency"
 	kafkaModel "Homework-1/internal/model/kafka"
+	userModel "Homework-1/internal/model/user"
 	OrderDelivery "Homework-1/internal/order/delivery"
 	OrderUseCase "Homework-1/internal/order/usecase"
+	PricingDelivery "Homework-1/internal/pricing/delivery"
+	PricingUseCase "Homework-1/internal/pricing/usecase"
 	PVZDelivery "Homework-1/internal/pvz/delivery"
 	PVZUseCase "Homework-1/internal/pvz/usecase"
+	"Homework-1/internal/user"
+	UserDelivery "Homework-1/internal/user/delivery"
+	UserUseCase "Homework-1/internal/user/usecase"
 	"Homework-1/pkg/api/box_v1"
 	"Homework-1/pkg/api/order_v1"
+	"Homework-1/pkg/api/pricing_v1"
 	"Homework-1/pkg/api/pvz_v1"
+	"Homework-1/pkg/api/user_v1"
+	"Homework-1/pkg/constants"
+	"Homework-1/pkg/errlst"
 )
 
+// idempotentMethods are the mutating calls a terminal can retry with the same idempotency key
+var idempotentMethods = map[string]bool{
+	order_v1.OrderService_ReceiveOrder_FullMethodName: true,
+	order_v1.OrderService_IssueOrder_FullMethodName:   true,
+	order_v1.OrderService_AcceptOrder_FullMethodName:  true,
+	order_v1.OrderService_TurnInOrder_FullMethodName:  true,
+}
+
 // CombinedInterceptor is
-func CombinedInterceptor(kafkaProducer kafka.Producer) grpc.ServerOption {
+func CombinedInterceptor(
+	kafkaProducer kafka.Producer,
+	userUseCase user.UseCase,
+	idempotencyUseCase idempotency.UseCase,
+	idempotencyCfg config.Idempotency,
+) grpc.ServerOption {
 	return grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
-		authInterceptor(),               // Authentication interceptor
-		KafkaInterceptor(kafkaProducer), // kafka interceptor
-		MetricsInterceptor(),            // metrics interceptor
+		authInterceptor(userUseCase),                               // Authentication interceptor
+		KafkaInterceptor(kafkaProducer),                            // kafka interceptor
+		MetricsInterceptor(),                                       // metrics interceptor
+		IdempotencyInterceptor(idempotencyUseCase, idempotencyCfg), // idempotency interceptor
 	))
 }
 
-func authInterceptor() grpc.UnaryServerInterceptor {
-	return func(ctx context.Context, request any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		// BasicAuthInterceptor logic
+// CombinedStreamInterceptor is, streaming calls go through the same authentication as unary ones
+func CombinedStreamInterceptor(userUseCase user.UseCase) grpc.ServerOption {
+	return grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
+		streamAuthInterceptor(userUseCase), // Authentication interceptor
+	))
+}
 
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
+// authInterceptor is, the caller has to be a known user whose role is allowed to call the method
+// and the user is passed on in the context of the call
+func authInterceptor(useCase user.UseCase) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		caller, err := authenticate(ctx, useCase, info.FullMethod)
+		if err != nil {
+			return nil, err
 		}
+		return handler(userModel.NewContext(ctx, caller), request)
+	}
+}
 
-		authHeader, ok := md["authorization"]
-		if !ok || len(authHeader) < 1 {
-			return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
+func streamAuthInterceptor(useCase user.UseCase) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, err := authenticate(stream.Context(), useCase, info.FullMethod); err != nil {
+			return err
 		}
+		return handler(srv, stream)
+	}
+}
 
-		const prefix = "Basic "
-		if !strings.HasPrefix(authHeader[0], prefix) {
-			return nil, status.Error(codes.Unauthenticated, "authorization token is not Basic")
-		}
+// authenticate is, the caller is authenticated and authorized and its user is returned
+func authenticate(ctx context.Context, useCase user.UseCase, method string) (userModel.AllResponse, error) {
+	// BasicAuthInterceptor logic
 
-		encodedCredentials := authHeader[0][len(prefix):]
-		credentials, err := base64.StdEncoding.DecodeString(encodedCredentials)
-		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "failed to decode authorization token")
-		}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return userModel.AllResponse{}, status.Error(codes.Unauthenticated, "metadata is not provided")
+	}
 
-		parts := strings.SplitN(string(credentials), ":", 2)
-		if len(parts) != 2 {
-			return nil, status.Error(codes.Unauthenticated, "invalid authorization format")
-		}
+	authHeader, ok := md["authorization"]
+	if !ok || len(authHeader) < 1 {
+		return userModel.AllResponse{}, status.Error(codes.Unauthenticated, "authorization token is not provided")
+	}
 
-		username := parts[0]
-		password := parts[1]
+	const prefix = "Basic "
+	if !strings.HasPrefix(authHeader[0], prefix) {
+		return userModel.AllResponse{}, status.Error(codes.Unauthenticated, "authorization token is not Basic")
+	}
 
-		// Check the username and password against your expected values
-		if username != "Homework_3" || password != "test" {
-			return nil, status.Error(codes.Unauthenticated, "invalid credentials")
-		}
-		return handler(ctx, request)
+	encodedCredentials := authHeader[0][len(prefix):]
+	credentials, err := base64.StdEncoding.DecodeString(encodedCredentials)
+	if err != nil {
+		return userModel.AllResponse{}, status.Error(codes.Unauthenticated, "failed to decode authorization token")
+	}
+
+	parts := strings.SplitN(string(credentials), ":", 2)
+	if len(parts) != 2 {
+		return userModel.AllResponse{}, status.Error(codes.Unauthenticated, "invalid authorization format")
+	}
+
+	userResponse, err := useCase.Authenticate(ctx, parts[0], parts[1])
+	if errors.Is(err, errlst.ErrInvalidCredentials) {
+		return userModel.AllResponse{}, status.Error(codes.Unauthenticated, "invalid credentials")
 	}
+	if err != nil {
+		return userModel.AllResponse{}, status.Errorf(codes.Internal, "Failed to authenticate: %v", err)
+	}
+
+	if err = authorize(userResponse.Role, method); err != nil {
+		return userModel.AllResponse{}, err
+	}
+
+	return userResponse, nil
 }
 
 // MetricsInterceptor is
@@ -106,11 +167,76 @@ func MetricsInterceptor() grpc.UnaryServerInterceptor {
 
 }
 
+// IdempotencyInterceptor is, a retried call with the same idempotency-key header gets the response of the first call
+// instead of being handled again, the same key with another request body is rejected. The keys are scoped to the
+// authenticated user, so the response of one user is never replayed to another one
+func IdempotencyInterceptor(useCase idempotency.UseCase, cfg config.Idempotency) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !idempotentMethods[info.FullMethod] {
+			return handler(ctx, request)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		keys := md.Get(constants.IdempotencyKeyHeader)
+		if len(keys) == 0 || keys[0] == "" {
+			return handler(ctx, request)
+		}
+
+		if len(keys[0]) > constants.IdempotencyKeyMaxLength {
+			return nil, status.Error(codes.InvalidArgument, errlst.ErrIdempotencyKeyTooLong.Error())
+		}
+
+		message, ok := request.(proto.Message)
+		if !ok {
+			return handler(ctx, request)
+		}
+
+		caller, ok := userModel.FromContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "caller is not authenticated")
+		}
+
+		idempotencyReq, err := idempotencyModel.NewRequest(caller.Username, keys[0], info.FullMethod, message, cfg.TTL(), cfg.Lease())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to read idempotency key: %v", err)
+		}
+
+		stored, reserved, err := useCase.Begin(ctx, idempotencyReq)
+		switch {
+		case errors.Is(err, errlst.ErrIdempotencyKeyReused):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, errlst.ErrIdempotencyKeyInProgress):
+			return nil, status.Error(codes.Aborted, err.Error())
+		case err != nil:
+			return nil, status.Errorf(codes.Internal, "Failed to check idempotency key: %v", err)
+		}
+
+		if !reserved {
+			if err = grpc.SetHeader(ctx, metadata.Pairs(constants.IdempotentReplayedHeader, "true")); err != nil {
+				log.Printf("[server][IdempotencyInterceptor] grpc.SetHeader: %v", err)
+			}
+			return stored.Replay()
+		}
+
+		response, handlerErr := handler(ctx, request)
+
+		// the outcome is stored even when the caller has gone, otherwise its retries find the key in progress
+		completeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), constants.IdempotencyCompleteTimeout)
+		defer cancel()
+
+		if err = useCase.Complete(completeCtx, idempotencyReq, response, handlerErr); err != nil {
+			log.Printf("[server][IdempotencyInterceptor] useCase.Complete: %v", err)
+		}
+
+		return response, handlerErr
+	}
+}
+
 // KafkaInterceptor is
 func KafkaInterceptor(producer kafka.Producer) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		// KafkaInterceptor logic
-		requestData, err := json.Marshal(request)
+		requestData, err := json.Marshal(redactRequest(request))
 		if err != nil {
 			log.Printf("Failed to marshal request to JSON: %v", err)
 			return nil, err
@@ -129,8 +255,26 @@ func KafkaInterceptor(producer kafka.Producer) grpc.UnaryServerInterceptor {
 	}
 }
 
+// redactRequest is, the passwords of the user management calls are not sent to kafka
+func redactRequest(request any) any {
+	switch req := request.(type) {
+	case *user_v1.UserCreateRequest:
+		redacted := proto.Clone(req).(*user_v1.UserCreateRequest)
+		if redacted.User != nil {
+			redacted.User.Password = ""
+		}
+		return redacted
+	case *user_v1.UserUpdateRequest:
+		redacted := proto.Clone(req).(*user_v1.UserUpdateRequest)
+		redacted.Password = ""
+		return redacted
+	}
+
+	return request
+}
+
 func (s *Server) mapHandlers() {
-	orderUseCase := OrderUseCase.NewOrderUseCase(s.dataStore, s.cacheStore)
+	orderUseCase := OrderUseCase.NewOrderUseCase(s.dataStore, s.cacheStore, s.config.ReturnPolicy, s.config.Volumetric)
 	orderHandlers := OrderDelivery.NewOrdersHandler(orderUseCase)
 	order_v1.RegisterOrderServiceServer(s.gRPC, orderHandlers)
 
@@ -141,6 +285,35 @@ func (s *Server) mapHandlers() {
 	pvzUseCase := PVZUseCase.NewPVZUseCase(s.dataStore, s.cacheStore)
 	pvzHandlers := PVZDelivery.NewPVZHandler(pvzUseCase)
 	pvz_v1.RegisterPVZServiceServer(s.gRPC, pvzHandlers)
+
+	pricingUseCase := PricingUseCase.NewPricingUseCase(s.dataStore)
+	pricingHandlers := PricingDelivery.NewPricingHandler(pricingUseCase)
+	pricing_v1.RegisterTariffServiceServer(s.gRPC, pricingHandlers)
+
+	userUseCase := UserUseCase.NewUserUseCase(s.dataStore, s.cacheStore, s.config.Auth.CacheSecret)
+	userHandlers := UserDelivery.NewUserHandler(userUseCase)
+	user_v1.RegisterUserServiceServer(s.gRPC, userHandlers)
+}
+
+// incomingHeaderMatcher is, the gateway passes the Idempotency-Key and If-Match HTTP headers on as metadata
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, constants.IdempotencyKeyHeader) {
+		return constants.IdempotencyKeyHeader, true
+	}
+	if strings.EqualFold(key, constants.IfMatchHeader) {
+		return constants.IfMatchHeader, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher is, the etag metadata is sent as the ETag HTTP header and the rest keeps the gateway prefix
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, constants.ETagHeader) {
+		return "ETag", true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
 }
 
 // StartGatewayRouter is
@@ -160,7 +333,10 @@ func (s *Server) StartGatewayRouter(ctx context.Context) error {
 	creds := credentials.NewClientTLSFromCert(certPool, "")
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
 
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	)
 
 	log.Printf("Registering Box service handler with endpoint %s...", s.config.Server.GRPCPort)
 	err = box_v1.RegisterBoxServiceHandlerFromEndpoint(ctx, mux, s.config.Server.GRPCPort, opts)
@@ -177,6 +353,16 @@ func (s *Server) StartGatewayRouter(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+
+	err = pricing_v1.RegisterTariffServiceHandlerFromEndpoint(ctx, mux, s.config.Server.GRPCPort, opts)
+	if err != nil {
+		return err
+	}
+
+	err = user_v1.RegisterUserServiceHandlerFromEndpoint(ctx, mux, s.config.Server.GRPCPort, opts)
+	if err != nil {
+		return err
+	}
 	// Start HTTP server (and proxy calls to gRPC server)
 	log.Printf("Starting HTTP server on port %v", s.config.Server.HTTPPort)
 
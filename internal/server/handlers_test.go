@@ -0,0 +1,336 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"Homework-1/internal/config"
+	"Homework-1/internal/idempotency"
+	idempotencyMock "Homework-1/internal/idempotency/mock"
+	idempotencyModel "Homework-1/internal/model/idempotency"
+	userModel "Homework-1/internal/model/user"
+	"Homework-1/internal/user"
+	userMock "Homework-1/internal/user/mock"
+	"Homework-1/pkg/api/abstract"
+	"Homework-1/pkg/api/box_v1"
+	"Homework-1/pkg/api/order_v1"
+	"Homework-1/pkg/api/user_v1"
+	"Homework-1/pkg/errlst"
+)
+
+// TestIdempotencyInterceptor is
+func TestIdempotencyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	cfg := config.Idempotency{TTLHours: 1}
+	request := &order_v1.IssueOrderRequest{OrderIDRequest: []*order_v1.OrderIDRequest{{OrderID: 1}}, PvzID: 2}
+	issueInfo := &grpc.UnaryServerInfo{FullMethod: order_v1.OrderService_IssueOrder_FullMethodName}
+
+	caller := userModel.AllResponse{ID: 2, Username: "operator", Role: userModel.RoleOperator}
+
+	idempotencyReq, err := idempotencyModel.NewRequest(caller.Username, "key-1", issueInfo.FullMethod, request, time.Hour, time.Minute)
+	assert.NoError(t, err)
+
+	handlerResp := &abstract.MessageResponse{Message: "Issued"}
+	storedBody, err := anypb.New(&abstract.MessageResponse{Message: "Issued before"})
+	assert.NoError(t, err)
+	storedBytes, err := proto.Marshal(storedBody)
+	assert.NoError(t, err)
+
+	tests := []*struct {
+		description string
+		key         string
+		anonymous   bool
+		info        *grpc.UnaryServerInfo
+		wantResp    any
+		wantErr     error
+		useCase     idempotency.UseCase
+	}{
+		{
+			description: "Request without a key is handled",
+			info:        issueInfo,
+			wantResp:    handlerResp,
+			useCase:     idempotencyMock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Method that is not idempotent is handled",
+			key:         "key-1",
+			info:        &grpc.UnaryServerInfo{FullMethod: order_v1.OrderService_OrderList_FullMethodName},
+			wantResp:    handlerResp,
+			useCase:     idempotencyMock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Request without an authenticated caller",
+			key:         "key-1",
+			anonymous:   true,
+			info:        issueInfo,
+			wantErr:     status.Error(codes.Unauthenticated, "caller is not authenticated"),
+			useCase:     idempotencyMock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "First request is handled and stored",
+			key:         "key-1",
+			info:        issueInfo,
+			wantResp:    handlerResp,
+			useCase: idempotencyMock.NewUseCaseMock(ctrl).
+				BeginMock.When(minimock.AnyContext, idempotencyReq).Then(idempotencyModel.Response{}, true, nil).
+				CompleteMock.When(minimock.AnyContext, idempotencyReq, handlerResp, nil).Then(nil),
+		},
+		{
+			description: "Retried request gets the stored response",
+			key:         "key-1",
+			info:        issueInfo,
+			wantResp:    &abstract.MessageResponse{Message: "Issued before"},
+			useCase: idempotencyMock.NewUseCaseMock(ctrl).
+				BeginMock.When(minimock.AnyContext, idempotencyReq).
+				Then(idempotencyModel.Response{Body: storedBytes, Code: codes.OK, Completed: true}, false, nil),
+		},
+		{
+			description: "Retried request gets the stored error",
+			key:         "key-1",
+			info:        issueInfo,
+			wantErr:     status.Error(codes.FailedPrecondition, "Order storage period has expired"),
+			useCase: idempotencyMock.NewUseCaseMock(ctrl).
+				BeginMock.When(minimock.AnyContext, idempotencyReq).
+				Then(idempotencyModel.Response{Code: codes.FailedPrecondition, Message: "Order storage period has expired", Completed: true}, false, nil),
+		},
+		{
+			description: "Key reused with another request",
+			key:         "key-1",
+			info:        issueInfo,
+			wantErr:     status.Error(codes.InvalidArgument, errlst.ErrIdempotencyKeyReused.Error()),
+			useCase: idempotencyMock.NewUseCaseMock(ctrl).
+				BeginMock.When(minimock.AnyContext, idempotencyReq).Then(idempotencyModel.Response{}, false, errlst.ErrIdempotencyKeyReused),
+		},
+		{
+			description: "Request with the key is in progress",
+			key:         "key-1",
+			info:        issueInfo,
+			wantErr:     status.Error(codes.Aborted, errlst.ErrIdempotencyKeyInProgress.Error()),
+			useCase: idempotencyMock.NewUseCaseMock(ctrl).
+				BeginMock.When(minimock.AnyContext, idempotencyReq).Then(idempotencyModel.Response{}, false, errlst.ErrIdempotencyKeyInProgress),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			if !tt.anonymous {
+				ctx = userModel.NewContext(ctx, caller)
+			}
+			if tt.key != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("idempotency-key", tt.key))
+			}
+
+			handler := func(_ context.Context, _ any) (any, error) {
+				return handlerResp, nil
+			}
+			response, err := IdempotencyInterceptor(tt.useCase, cfg)(ctx, request, tt.info, handler)
+
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantResp != nil {
+				assert.True(t, proto.Equal(tt.wantResp.(proto.Message), response.(proto.Message)))
+			} else {
+				assert.Nil(t, response)
+			}
+		})
+	}
+}
+
+// TestIdempotencyInterceptor_CancelledContext is
+func TestIdempotencyInterceptor_CancelledContext(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	cfg := config.Idempotency{TTLHours: 1}
+	request := &order_v1.IssueOrderRequest{OrderIDRequest: []*order_v1.OrderIDRequest{{OrderID: 1}}, PvzID: 2}
+	issueInfo := &grpc.UnaryServerInfo{FullMethod: order_v1.OrderService_IssueOrder_FullMethodName}
+
+	caller := userModel.AllResponse{ID: 2, Username: "operator", Role: userModel.RoleOperator}
+
+	idempotencyReq, err := idempotencyModel.NewRequest(caller.Username, "key-1", issueInfo.FullMethod, request, time.Hour, time.Minute)
+	assert.NoError(t, err)
+
+	handlerResp := &abstract.MessageResponse{Message: "Issued"}
+	storedBody, err := anypb.New(handlerResp)
+	assert.NoError(t, err)
+	storedBytes, err := proto.Marshal(storedBody)
+	assert.NoError(t, err)
+
+	useCase := idempotencyMock.NewUseCaseMock(ctrl)
+	useCase.BeginMock.Expect(minimock.AnyContext, idempotencyReq).Return(idempotencyModel.Response{}, true, nil)
+	useCase.CompleteMock.
+		Inspect(func(ctx context.Context, _ idempotencyModel.Request, _ any, _ error) {
+			assert.NoError(t, ctx.Err())
+		}).
+		Return(nil)
+
+	callerCtx := userModel.NewContext(context.Background(), caller)
+	ctx, cancel := context.WithCancel(metadata.NewIncomingContext(callerCtx, metadata.Pairs("idempotency-key", "key-1")))
+	handler := func(_ context.Context, _ any) (any, error) {
+		// the terminal gives up while the order is issued
+		cancel()
+		return handlerResp, nil
+	}
+
+	response, err := IdempotencyInterceptor(useCase, cfg)(ctx, request, issueInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, handlerResp, response)
+
+	retryUseCase := idempotencyMock.NewUseCaseMock(ctrl).
+		BeginMock.When(minimock.AnyContext, idempotencyReq).
+		Then(idempotencyModel.Response{Body: storedBytes, Code: codes.OK, Completed: true}, false, nil)
+	retryCtx := metadata.NewIncomingContext(callerCtx, metadata.Pairs("idempotency-key", "key-1"))
+	retryHandler := func(_ context.Context, _ any) (any, error) {
+		t.Fatal("retried request is handled again")
+		return nil, nil
+	}
+
+	response, err = IdempotencyInterceptor(retryUseCase, cfg)(retryCtx, request, issueInfo, retryHandler)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(handlerResp, response.(proto.Message)))
+}
+
+// TestAuthInterceptor is
+func TestAuthInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	handlerResp := &abstract.MessageResponse{Message: "Created"}
+	createBoxInfo := &grpc.UnaryServerInfo{FullMethod: box_v1.BoxService_CreateBox_FullMethodName}
+
+	tests := []*struct {
+		description   string
+		authorization string
+		info          *grpc.UnaryServerInfo
+		wantResp      any
+		wantErr       error
+		useCase       user.UseCase
+	}{
+		{
+			description: "Request without credentials",
+			info:        createBoxInfo,
+			wantErr:     status.Error(codes.Unauthenticated, "authorization token is not provided"),
+			useCase:     userMock.NewUseCaseMock(ctrl),
+		},
+		{
+			description:   "Credentials are not Basic",
+			authorization: "Bearer token",
+			info:          createBoxInfo,
+			wantErr:       status.Error(codes.Unauthenticated, "authorization token is not Basic"),
+			useCase:       userMock.NewUseCaseMock(ctrl),
+		},
+		{
+			description:   "Wrong password",
+			authorization: basicAuth("catalog", "wrong"),
+			info:          createBoxInfo,
+			wantErr:       status.Error(codes.Unauthenticated, "invalid credentials"),
+			useCase: userMock.NewUseCaseMock(ctrl).AuthenticateMock.
+				When(minimock.AnyContext, "catalog", "wrong").
+				Then(userModel.AllResponse{}, errlst.ErrInvalidCredentials),
+		},
+		{
+			description:   "Users can not be loaded",
+			authorization: basicAuth("catalog", "secret"),
+			info:          createBoxInfo,
+			wantErr:       status.Error(codes.Internal, "Failed to authenticate: assert.AnError general error for testing"),
+			useCase: userMock.NewUseCaseMock(ctrl).AuthenticateMock.
+				When(minimock.AnyContext, "catalog", "secret").
+				Then(userModel.AllResponse{}, assert.AnError),
+		},
+		{
+			description:   "Catalog admin creates a box",
+			authorization: basicAuth("catalog", "secret"),
+			info:          createBoxInfo,
+			wantResp:      handlerResp,
+			useCase: userMock.NewUseCaseMock(ctrl).AuthenticateMock.
+				When(minimock.AnyContext, "catalog", "secret").
+				Then(userModel.AllResponse{ID: 1, Username: "catalog", Role: userModel.RoleCatalogAdmin}, nil),
+		},
+		{
+			description:   "Operator can not create a box",
+			authorization: basicAuth("operator", "secret"),
+			info:          createBoxInfo,
+			wantErr:       status.Error(codes.PermissionDenied, "role operator is not allowed to call /BoxService/CreateBox"),
+			useCase: userMock.NewUseCaseMock(ctrl).AuthenticateMock.
+				When(minimock.AnyContext, "operator", "secret").
+				Then(userModel.AllResponse{ID: 2, Username: "operator", Role: userModel.RoleOperator}, nil),
+		},
+		{
+			description:   "Auditor reads the boxes",
+			authorization: basicAuth("auditor", "secret"),
+			info:          &grpc.UnaryServerInfo{FullMethod: box_v1.BoxService_ListBoxes_FullMethodName},
+			wantResp:      handlerResp,
+			useCase: userMock.NewUseCaseMock(ctrl).AuthenticateMock.
+				When(minimock.AnyContext, "auditor", "secret").
+				Then(userModel.AllResponse{ID: 3, Username: "auditor", Role: userModel.RoleAuditor}, nil),
+		},
+		{
+			description:   "Catalog admin can not manage users",
+			authorization: basicAuth("catalog", "secret"),
+			info:          &grpc.UnaryServerInfo{FullMethod: user_v1.UserService_CreateUser_FullMethodName},
+			wantErr:       status.Error(codes.PermissionDenied, "role catalog_admin is not allowed to call /UserService/CreateUser"),
+			useCase: userMock.NewUseCaseMock(ctrl).AuthenticateMock.
+				When(minimock.AnyContext, "catalog", "secret").
+				Then(userModel.AllResponse{ID: 1, Username: "catalog", Role: userModel.RoleCatalogAdmin}, nil),
+		},
+		{
+			description:   "Admin manages users",
+			authorization: basicAuth("admin", "secret"),
+			info:          &grpc.UnaryServerInfo{FullMethod: user_v1.UserService_CreateUser_FullMethodName},
+			wantResp:      handlerResp,
+			useCase: userMock.NewUseCaseMock(ctrl).AuthenticateMock.
+				When(minimock.AnyContext, "admin", "secret").
+				Then(userModel.AllResponse{ID: 4, Username: "admin", Role: userModel.RoleAdmin}, nil),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{})
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+
+			handler := func(ctx context.Context, _ any) (any, error) {
+				_, ok := userModel.FromContext(ctx)
+				assert.True(t, ok)
+				return handlerResp, nil
+			}
+			response, err := authInterceptor(tt.useCase)(ctx, &box_v1.BoxCreateRequest{}, tt.info, handler)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantResp, response)
+		})
+	}
+}
+
+// TestRedactRequest is
+func TestRedactRequest(t *testing.T) {
+	t.Parallel()
+
+	request := &user_v1.UserCreateRequest{User: &user_v1.User{Username: "operator", Password: "secret-password", Role: "operator"}}
+
+	redacted := redactRequest(request)
+
+	assert.Equal(t, "", redacted.(*user_v1.UserCreateRequest).User.Password)
+	assert.Equal(t, "secret-password", request.User.Password)
+}
+
+func basicAuth(username, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userModel "Homework-1/internal/model/user"
+	"Homework-1/pkg/api/box_v1"
+	"Homework-1/pkg/api/order_v1"
+	"Homework-1/pkg/api/pricing_v1"
+	"Homework-1/pkg/api/pvz_v1"
+)
+
+var (
+	// allRoles are the roles that may read the catalog, the PVZ and the tariffs
+	allRoles = []userModel.Role{userModel.RoleOperator, userModel.RolePVZAdmin, userModel.RoleCatalogAdmin, userModel.RoleAuditor}
+	// orderReaders are
+	orderReaders = []userModel.Role{userModel.RoleOperator, userModel.RoleAuditor}
+	// pvzReaders are
+	pvzReaders = []userModel.Role{userModel.RoleOperator, userModel.RolePVZAdmin, userModel.RoleAuditor}
+	// operators are
+	operators = []userModel.Role{userModel.RoleOperator}
+	// pvzAdmins are
+	pvzAdmins = []userModel.Role{userModel.RolePVZAdmin}
+	// catalogAdmins are
+	catalogAdmins = []userModel.Role{userModel.RoleCatalogAdmin}
+)
+
+// methodRoles are the roles allowed to call each method besides the admin,
+// a method that is not listed here, like the user management, is for the admin only
+var methodRoles = map[string][]userModel.Role{
+	box_v1.BoxService_CreateBox_FullMethodName:        catalogAdmins,
+	box_v1.BoxService_DeleteBox_FullMethodName:        catalogAdmins,
+	box_v1.BoxService_UpdateBox_FullMethodName:        catalogAdmins,
+	box_v1.BoxService_ArchiveBox_FullMethodName:       catalogAdmins,
+	box_v1.BoxService_RestoreBox_FullMethodName:       catalogAdmins,
+	box_v1.BoxService_PurgeBox_FullMethodName:         catalogAdmins,
+	box_v1.BoxService_ListDeletedBoxes_FullMethodName: {userModel.RoleCatalogAdmin, userModel.RoleAuditor},
+	box_v1.BoxService_ListBoxes_FullMethodName:        allRoles,
+	box_v1.BoxService_ListBoxVersions_FullMethodName:  allRoles,
+	box_v1.BoxService_GetBoxByID_FullMethodName:       allRoles,
+
+	pricing_v1.TariffService_CreateTariff_FullMethodName:   catalogAdmins,
+	pricing_v1.TariffService_DeleteTariff_FullMethodName:   catalogAdmins,
+	pricing_v1.TariffService_ActivateTariff_FullMethodName: catalogAdmins,
+	pricing_v1.TariffService_ListTariffs_FullMethodName:    allRoles,
+	pricing_v1.TariffService_GetTariffByID_FullMethodName:  allRoles,
+
+	pvz_v1.PVZService_CreatePVZ_FullMethodName:       pvzAdmins,
+	pvz_v1.PVZService_UpdatePVZ_FullMethodName:       pvzAdmins,
+	pvz_v1.PVZService_DeletePVZ_FullMethodName:       pvzAdmins,
+	pvz_v1.PVZService_RestorePVZ_FullMethodName:      pvzAdmins,
+	pvz_v1.PVZService_PurgePVZ_FullMethodName:        pvzAdmins,
+	pvz_v1.PVZService_CreatePVZCell_FullMethodName:   pvzAdmins,
+	pvz_v1.PVZService_DeletePVZCell_FullMethodName:   pvzAdmins,
+	pvz_v1.PVZService_ListDeletedPVZ_FullMethodName:  {userModel.RolePVZAdmin, userModel.RoleAuditor},
+	pvz_v1.PVZService_PVZOccupancy_FullMethodName:    pvzReaders,
+	pvz_v1.PVZService_GetPVZByID_FullMethodName:      allRoles,
+	pvz_v1.PVZService_ListPVZ_FullMethodName:         allRoles,
+	pvz_v1.PVZService_SearchNearbyPVZ_FullMethodName: allRoles,
+	pvz_v1.PVZService_SearchPVZ_FullMethodName:       allRoles,
+
+	order_v1.OrderService_ReceiveOrder_FullMethodName:       operators,
+	order_v1.OrderService_ReceiveOrdersBatch_FullMethodName: operators,
+	order_v1.OrderService_IssueOrder_FullMethodName:         operators,
+	order_v1.OrderService_QuoteIssue_FullMethodName:         operators,
+	order_v1.OrderService_AcceptOrder_FullMethodName:        operators,
+	order_v1.OrderService_TurnInOrder_FullMethodName:        operators,
+	order_v1.OrderService_ReturnedOrders_FullMethodName:     orderReaders,
+	order_v1.OrderService_OrderList_FullMethodName:          orderReaders,
+	order_v1.OrderService_UniqueClientList_FullMethodName:   orderReaders,
+	order_v1.OrderService_GetOrderByID_FullMethodName:       orderReaders,
+	order_v1.OrderService_GetOrderHistory_FullMethodName:    orderReaders,
+}
+
+// authorize is
+func authorize(role userModel.Role, method string) error {
+	if role == userModel.RoleAdmin || lo.Contains(methodRoles[method], role) {
+		return nil
+	}
+
+	return status.Errorf(codes.PermissionDenied, "role %s is not allowed to call %s", role, method)
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net"
 	"sync"
@@ -13,7 +14,13 @@ import (
 	"Homework-1/internal/cache"
 	"Homework-1/internal/config"
 	"Homework-1/internal/database"
+	IdempotencyUseCase "Homework-1/internal/idempotency/usecase"
 	"Homework-1/internal/kafka"
+	userModel "Homework-1/internal/model/user"
+	"Homework-1/internal/order/sweeper"
+	OrderUseCase "Homework-1/internal/order/usecase"
+	"Homework-1/internal/user"
+	UserUseCase "Homework-1/internal/user/usecase"
 )
 
 // Server is
@@ -53,12 +60,33 @@ func (s *Server) Run(ctx context.Context) error {
 		return err
 	}
 
-	s.gRPC = grpc.NewServer(grpc.Creds(creds), CombinedInterceptor(s.producer))
+	userUseCase := UserUseCase.NewUserUseCase(s.dataStore, s.cacheStore, s.config.Auth.CacheSecret)
+	if err = s.bootstrapAdmin(ctx, userUseCase); err != nil {
+		return err
+	}
+
+	s.gRPC = grpc.NewServer(
+		grpc.Creds(creds),
+		CombinedInterceptor(s.producer, userUseCase, IdempotencyUseCase.NewIdempotencyUseCase(s.dataStore), s.config.Idempotency),
+		CombinedStreamInterceptor(userUseCase),
+	)
 	reflection.Register(s.gRPC)
 	s.mapHandlers()
 
 	var wg sync.WaitGroup
 
+	// Starting the expiry sweeper, it stops together with the servers on ctx
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sweeper.NewSweeper(
+			OrderUseCase.NewOrderUseCase(s.dataStore, s.cacheStore, s.config.ReturnPolicy, s.config.Volumetric),
+			s.producer,
+			s.config.Kafka.EventsTopic,
+			s.config.ExpirySweeper,
+		).Run(ctx)
+	}()
+
 	// Starting the GRPC server in a separate goroutine
 	wg.Add(1)
 	go func() {
@@ -88,3 +116,24 @@ func (s *Server) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// bootstrapAdmin is, the Auth user of the config is the first admin so the users can be managed on a fresh database,
+// once it exists its password and role are only changed through the user management calls.
+// Nothing is created without a password and the server does not start with a password that fails the user validation
+func (s *Server) bootstrapAdmin(ctx context.Context, useCase user.UseCase) error {
+	if s.config.Auth.User == "" || s.config.Auth.Password == "" {
+		log.Println("[server][bootstrapAdmin] Auth user or password is not configured, no admin is created")
+		return nil
+	}
+
+	err := useCase.EnsureUser(ctx, userModel.Request{
+		Username: s.config.Auth.User,
+		Password: s.config.Auth.Password,
+		Role:     userModel.RoleAdmin,
+	})
+	if err != nil {
+		return fmt.Errorf("useCase.EnsureUser: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"Homework-1/internal/config"
+	userModel "Homework-1/internal/model/user"
+	"Homework-1/internal/user"
+	userMock "Homework-1/internal/user/mock"
+)
+
+// TestBootstrapAdmin is
+func TestBootstrapAdmin(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	errDB := errors.New("db is down")
+	admin := userModel.Request{Username: "Homework_3", Password: "admin-password", Role: userModel.RoleAdmin}
+
+	tests := []*struct {
+		description string
+		username    string
+		password    string
+		wantErr     error
+		useCase     user.UseCase
+	}{
+		{
+			description: "Admin is ensured",
+			username:    admin.Username,
+			password:    admin.Password,
+			useCase:     userMock.NewUseCaseMock(ctrl).EnsureUserMock.When(minimock.AnyContext, admin).Then(nil),
+		},
+		{
+			description: "Admin without a password is not created",
+			username:    admin.Username,
+			useCase:     userMock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Admin without a username is not created",
+			password:    admin.Password,
+			useCase:     userMock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Server does not start when the admin is not ensured",
+			username:    admin.Username,
+			password:    admin.Password,
+			wantErr:     errDB,
+			useCase:     userMock.NewUseCaseMock(ctrl).EnsureUserMock.When(minimock.AnyContext, admin).Then(errDB),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &config.Config{}
+			cfg.Auth.User = tc.username
+			cfg.Auth.Password = tc.password
+
+			err := (&Server{config: cfg}).bootstrapAdmin(context.Background(), tc.useCase)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
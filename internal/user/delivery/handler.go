@@ -0,0 +1,186 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abstractModel "Homework-1/internal/model/abstract"
+	userModel "Homework-1/internal/model/user"
+	userUseCase "Homework-1/internal/user"
+	"Homework-1/pkg/api/abstract"
+	"Homework-1/pkg/api/user_v1"
+	"Homework-1/pkg/errlst"
+	"Homework-1/pkg/reqvalidator"
+	"Homework-1/pkg/tracing"
+)
+
+var (
+	_ userUseCase.Handlers = (*UserHandler)(nil)
+)
+
+// UserHandler is
+type UserHandler struct {
+	useCase userUseCase.UseCase
+	user_v1.UnimplementedUserServiceServer
+}
+
+// NewUserHandler is
+func NewUserHandler(useCase userUseCase.UseCase) *UserHandler {
+	return &UserHandler{
+		useCase: useCase,
+	}
+}
+
+// CreateUser is
+func (u *UserHandler) CreateUser(ctx context.Context, request *user_v1.UserCreateRequest) (*abstract.MessageResponse, error) {
+	log.Printf("[user_v1][delivery][CreateUser]")
+	tracer := otel.Tracer("[user_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[CreateUser]")
+	defer span.End()
+
+	userReq := userModel.FromGRPC(request.GetUser())
+
+	err := reqvalidator.ValidateRequest(userReq)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("reqvalidator.ValidateRequest %v", err))
+	}
+
+	id, err := u.useCase.CreateUser(ctx, userReq)
+	if err != nil {
+		if strings.Contains(err.Error(), errlst.ErrUserAlreadyExists.Error()) {
+			tracing.EventErrorTracer(span, err, "User already exists")
+			return nil, status.Errorf(grpcCodes.AlreadyExists, "Failed to create: %v", err)
+		}
+
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, "Failed to create: %v", err)
+	}
+
+	span.SetStatus(codes.Ok, "User created successfully")
+	return &abstract.MessageResponse{Message: strconv.FormatInt(id, 10)}, nil
+}
+
+// UpdateUser is
+func (u *UserHandler) UpdateUser(ctx context.Context, request *user_v1.UserUpdateRequest) (*abstract.MessageResponse, error) {
+	log.Printf("[user_v1][delivery][UpdateUser]")
+	tracer := otel.Tracer("[user_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[UpdateUser]")
+	defer span.End()
+
+	updateReq := userModel.FromUpdateGRPC(request)
+
+	err := reqvalidator.ValidateRequest(updateReq)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("reqvalidator.ValidateRequest %v", err))
+	}
+
+	err = u.useCase.UpdateUser(ctx, updateReq)
+	if err != nil {
+		if errors.Is(err, errlst.ErrUserNotFound) {
+			tracing.EventErrorTracer(span, err, "User not found")
+			return nil, status.Errorf(grpcCodes.NotFound, "Error: %v", err)
+		}
+		if errors.Is(err, errlst.ErrLastAdmin) {
+			tracing.EventErrorTracer(span, err, "Last admin")
+			return nil, status.Errorf(grpcCodes.FailedPrecondition, "Failed to update: %v", err)
+		}
+
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, "Failed to update: %v", err)
+	}
+
+	span.SetStatus(codes.Ok, "User updated successfully")
+	return &abstract.MessageResponse{Message: "Successfully Updated User\n"}, nil
+}
+
+// DeleteUser is
+func (u *UserHandler) DeleteUser(ctx context.Context, request *user_v1.UserIDRequest) (*abstract.MessageResponse, error) {
+	log.Printf("[user_v1][delivery][DeleteUser]")
+	tracer := otel.Tracer("[user_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[DeleteUser]")
+	defer span.End()
+
+	if request.UserID <= 0 {
+		tracing.EventErrorTracer(span, errors.New("invalid request id"), "bad request")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, "invalid request id")
+	}
+
+	err := u.useCase.DeleteUserByID(ctx, request.UserID)
+	if err != nil {
+		if errors.Is(err, errlst.ErrUserNotFound) {
+			tracing.EventErrorTracer(span, err, "User not found")
+			return nil, status.Errorf(grpcCodes.NotFound, "Error: %v", err)
+		}
+		if errors.Is(err, errlst.ErrLastAdmin) {
+			tracing.EventErrorTracer(span, err, "Last admin")
+			return nil, status.Errorf(grpcCodes.FailedPrecondition, "Failed to delete: %v", err)
+		}
+
+		tracing.EventErrorTracer(span, err, "Internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, "Failed to delete: %v", err)
+	}
+
+	span.SetStatus(codes.Ok, "Successfully deleted user by ID")
+	return &abstract.MessageResponse{Message: "Successfully Deleted User\n"}, nil
+}
+
+// ListUsers is
+func (u *UserHandler) ListUsers(ctx context.Context, request *abstract.Page) (*user_v1.UserListResponse, error) {
+	log.Printf("[user_v1][delivery][ListUsers]")
+	tracer := otel.Tracer("[user_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[ListUsers]")
+	defer span.End()
+	page := abstractModel.PageFromGRPC(request)
+
+	err := reqvalidator.ValidateRequest(page)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "Validation failed")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, fmt.Sprintf("reqvalidator.ValidateRequest %v", err))
+	}
+
+	listOfUsers, err := u.useCase.ListUsers(ctx, page)
+	if err != nil {
+		tracing.EventErrorTracer(span, err, "unable to get list of users")
+		return nil, status.Errorf(grpcCodes.Internal, "Failed to get list of users: %v", err)
+	}
+
+	span.SetStatus(codes.Ok, "Successfully received user list")
+	return userModel.ListToGRPC(listOfUsers), nil
+}
+
+// GetUserByID is
+func (u *UserHandler) GetUserByID(ctx context.Context, request *user_v1.UserIDRequest) (*user_v1.UserAllInfo, error) {
+	log.Printf("[user_v1][delivery][GetUserByID]")
+	tracer := otel.Tracer("[user_v1][delivery]")
+	ctx, span := tracer.Start(ctx, "[GetUserByID]")
+	defer span.End()
+
+	if request == nil || request.UserID <= 0 {
+		tracing.EventErrorTracer(span, errors.New("invalid request id"), "bad request")
+		return nil, status.Errorf(grpcCodes.InvalidArgument, "invalid request id")
+	}
+
+	userResponse, err := u.useCase.GetUser(ctx, request.UserID)
+	if err != nil {
+		if errors.Is(err, errlst.ErrUserNotFound) {
+			tracing.EventErrorTracer(span, err, "User not found")
+			return nil, status.Errorf(grpcCodes.NotFound, fmt.Sprintf("Error: %v", err))
+		}
+		tracing.EventErrorTracer(span, err, "internal server error")
+		return nil, status.Errorf(grpcCodes.Internal, fmt.Sprintf("Failed to get: %v", err))
+	}
+
+	span.SetStatus(codes.Ok, "Successfully received user info by ID")
+	return userModel.InfoToGRPC(userResponse), nil
+}
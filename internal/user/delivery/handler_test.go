@@ -0,0 +1,357 @@
+package delivery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	abstractModel "Homework-1/internal/model/abstract"
+	userModel "Homework-1/internal/model/user"
+	"Homework-1/internal/user"
+	userMock "Homework-1/internal/user/mock"
+	"Homework-1/pkg/api/abstract"
+	"Homework-1/pkg/api/user_v1"
+	"Homework-1/pkg/errlst"
+)
+
+// TestUserHandler_CreateUser is
+func TestUserHandler_CreateUser(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+
+	userRequest := userModel.Request{
+		Username: "catalog",
+		Password: "catalog-password",
+		Role:     userModel.RoleCatalogAdmin,
+	}
+
+	tests := []*struct {
+		description string
+		requestBody *user_v1.UserCreateRequest
+		wantResp    *abstract.MessageResponse
+		wantErr     error
+		useCase     user.UseCase
+	}{
+		{
+			description: "Successfully created user",
+			requestBody: &user_v1.UserCreateRequest{
+				User: &user_v1.User{Username: "catalog", Password: "catalog-password", Role: "catalog_admin"},
+			},
+			wantResp: &abstract.MessageResponse{Message: "2"},
+			useCase: userMock.NewUseCaseMock(ctrl).CreateUserMock.
+				When(minimock.AnyContext, userRequest).
+				Then(2, nil),
+		},
+		{
+			description: "User already exists",
+			requestBody: &user_v1.UserCreateRequest{
+				User: &user_v1.User{Username: "catalog", Password: "catalog-password", Role: "catalog_admin"},
+			},
+			wantErr: status.Errorf(codes.AlreadyExists, "Failed to create: pq: duplicate key value violates unique constraint \"users_username_key\""),
+			useCase: userMock.NewUseCaseMock(ctrl).CreateUserMock.
+				When(minimock.AnyContext, userRequest).
+				Then(-1, errlst.ErrUserAlreadyExists),
+		},
+		{
+			description: "Internal server error",
+			requestBody: &user_v1.UserCreateRequest{
+				User: &user_v1.User{Username: "catalog", Password: "catalog-password", Role: "catalog_admin"},
+			},
+			wantErr: status.Errorf(codes.Internal, "Failed to create: assert.AnError general error for testing"),
+			useCase: userMock.NewUseCaseMock(ctrl).CreateUserMock.
+				When(minimock.AnyContext, userRequest).
+				Then(-1, assert.AnError),
+		},
+		{
+			description: "Unknown role",
+			requestBody: &user_v1.UserCreateRequest{
+				User: &user_v1.User{Username: "catalog", Password: "catalog-password", Role: "owner"},
+			},
+			wantErr: status.Errorf(codes.InvalidArgument, "reqvalidator.ValidateRequest Key: 'Request.Role' Error:Field validation for 'Role' failed on the 'oneof' tag"),
+			useCase: userMock.NewUseCaseMock(ctrl),
+		},
+		{
+			description: "Password is too short",
+			requestBody: &user_v1.UserCreateRequest{
+				User: &user_v1.User{Username: "catalog", Password: "short", Role: "catalog_admin"},
+			},
+			wantErr: status.Errorf(codes.InvalidArgument, "reqvalidator.ValidateRequest Key: 'Request.Password' Error:Field validation for 'Password' failed on the 'min' tag"),
+			useCase: userMock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			handler := NewUserHandler(tt.useCase)
+
+			response, err := handler.CreateUser(ctx, tt.requestBody)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantResp, response)
+		})
+	}
+}
+
+// TestUserHandler_UpdateUser is
+func TestUserHandler_UpdateUser(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+
+	tests := []*struct {
+		description string
+		requestBody *user_v1.UserUpdateRequest
+		wantResp    *abstract.MessageResponse
+		wantErr     error
+		useCase     user.UseCase
+	}{
+		{
+			description: "Successfully changed the role",
+			requestBody: &user_v1.UserUpdateRequest{UserID: 2, Role: "auditor"},
+			wantResp:    &abstract.MessageResponse{Message: "Successfully Updated User\n"},
+			useCase: userMock.NewUseCaseMock(ctrl).UpdateUserMock.
+				When(minimock.AnyContext, userModel.UpdateRequest{ID: 2, Role: lo.ToPtr(userModel.RoleAuditor)}).
+				Then(nil),
+		},
+		{
+			description: "Successfully changed the password",
+			requestBody: &user_v1.UserUpdateRequest{UserID: 2, Password: "new-password"},
+			wantResp:    &abstract.MessageResponse{Message: "Successfully Updated User\n"},
+			useCase: userMock.NewUseCaseMock(ctrl).UpdateUserMock.
+				When(minimock.AnyContext, userModel.UpdateRequest{ID: 2, Password: lo.ToPtr("new-password")}).
+				Then(nil),
+		},
+		{
+			description: "Last admin is demoted",
+			requestBody: &user_v1.UserUpdateRequest{UserID: 1, Role: "operator"},
+			wantErr:     status.Errorf(codes.FailedPrecondition, "Failed to update: The last admin can not be deleted or demoted"),
+			useCase: userMock.NewUseCaseMock(ctrl).UpdateUserMock.
+				When(minimock.AnyContext, userModel.UpdateRequest{ID: 1, Role: lo.ToPtr(userModel.RoleOperator)}).
+				Then(errlst.ErrLastAdmin),
+		},
+		{
+			description: "User not found",
+			requestBody: &user_v1.UserUpdateRequest{UserID: 5, Role: "operator"},
+			wantErr:     status.Errorf(codes.NotFound, "Error: User not found"),
+			useCase: userMock.NewUseCaseMock(ctrl).UpdateUserMock.
+				When(minimock.AnyContext, userModel.UpdateRequest{ID: 5, Role: lo.ToPtr(userModel.RoleOperator)}).
+				Then(errlst.ErrUserNotFound),
+		},
+		{
+			description: "Invalid user id",
+			requestBody: &user_v1.UserUpdateRequest{UserID: 0, Role: "operator"},
+			wantErr:     status.Errorf(codes.InvalidArgument, "reqvalidator.ValidateRequest Key: 'UpdateRequest.ID' Error:Field validation for 'ID' failed on the 'gt' tag"),
+			useCase:     userMock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			handler := NewUserHandler(tt.useCase)
+
+			response, err := handler.UpdateUser(ctx, tt.requestBody)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantResp, response)
+		})
+	}
+}
+
+// TestUserHandler_DeleteUser is
+func TestUserHandler_DeleteUser(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+
+	tests := []*struct {
+		description string
+		requestBody *user_v1.UserIDRequest
+		wantResp    *abstract.MessageResponse
+		wantErr     error
+		useCase     user.UseCase
+	}{
+		{
+			description: "Successfully deleted user",
+			requestBody: &user_v1.UserIDRequest{UserID: 2},
+			wantResp:    &abstract.MessageResponse{Message: "Successfully Deleted User\n"},
+			useCase:     userMock.NewUseCaseMock(ctrl).DeleteUserByIDMock.When(minimock.AnyContext, 2).Then(nil),
+		},
+		{
+			description: "Last admin is deleted",
+			requestBody: &user_v1.UserIDRequest{UserID: 1},
+			wantErr:     status.Errorf(codes.FailedPrecondition, "Failed to delete: The last admin can not be deleted or demoted"),
+			useCase:     userMock.NewUseCaseMock(ctrl).DeleteUserByIDMock.When(minimock.AnyContext, 1).Then(errlst.ErrLastAdmin),
+		},
+		{
+			description: "User not found",
+			requestBody: &user_v1.UserIDRequest{UserID: 5},
+			wantErr:     status.Errorf(codes.NotFound, "Error: User not found"),
+			useCase:     userMock.NewUseCaseMock(ctrl).DeleteUserByIDMock.When(minimock.AnyContext, 5).Then(errlst.ErrUserNotFound),
+		},
+		{
+			description: "Invalid user id",
+			requestBody: &user_v1.UserIDRequest{UserID: -1},
+			wantErr:     status.Errorf(codes.InvalidArgument, "invalid request id"),
+			useCase:     userMock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			handler := NewUserHandler(tt.useCase)
+
+			response, err := handler.DeleteUser(ctx, tt.requestBody)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantResp, response)
+		})
+	}
+}
+
+// TestUserHandler_ListUsers is
+func TestUserHandler_ListUsers(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	createdAt := time.Date(2024, 5, 19, 12, 0, 0, 0, time.UTC)
+	page := abstractModel.Page{CurrentPage: 1, ItemsPerPage: 10}
+
+	tests := []*struct {
+		description string
+		requestBody *abstract.Page
+		wantResp    *user_v1.UserListResponse
+		wantErr     error
+		useCase     user.UseCase
+	}{
+		{
+			description: "Successfully received user list",
+			requestBody: &abstract.Page{CurrentPage: 1, ItemsPerPage: 10},
+			wantResp: &user_v1.UserListResponse{
+				UserAllInfo: []*user_v1.UserAllInfo{{
+					ID:        1,
+					Username:  "Homework_3",
+					Role:      "admin",
+					CreatedAt: timestamppb.New(createdAt),
+					UpdatedAt: timestamppb.New(createdAt),
+				}},
+				Pagination: &abstract.Pagination{
+					Page:       &abstract.Page{CurrentPage: 1, ItemsPerPage: 1},
+					TotalItems: 1,
+				},
+			},
+			useCase: userMock.NewUseCaseMock(ctrl).ListUsersMock.
+				When(minimock.AnyContext, page).
+				Then(abstractModel.PaginatedResponse[userModel.AllResponse]{
+					Items: []userModel.AllResponse{{
+						ID:        1,
+						Username:  "Homework_3",
+						Role:      userModel.RoleAdmin,
+						CreatedAt: createdAt,
+						UpdatedAt: createdAt,
+					}},
+					CurrentPage:  1,
+					ItemsPerPage: 1,
+					TotalItems:   1,
+				}, nil),
+		},
+		{
+			description: "Internal server error",
+			requestBody: &abstract.Page{CurrentPage: 1, ItemsPerPage: 10},
+			wantErr:     status.Errorf(codes.Internal, "Failed to get list of users: assert.AnError general error for testing"),
+			useCase: userMock.NewUseCaseMock(ctrl).ListUsersMock.
+				When(minimock.AnyContext, page).
+				Then(abstractModel.PaginatedResponse[userModel.AllResponse]{}, assert.AnError),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			handler := NewUserHandler(tt.useCase)
+
+			response, err := handler.ListUsers(ctx, tt.requestBody)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantResp, response)
+		})
+	}
+}
+
+// TestUserHandler_GetUserByID is
+func TestUserHandler_GetUserByID(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	createdAt := time.Date(2024, 5, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []*struct {
+		description string
+		requestBody *user_v1.UserIDRequest
+		wantResp    *user_v1.UserAllInfo
+		wantErr     error
+		useCase     user.UseCase
+	}{
+		{
+			description: "Successfully received user",
+			requestBody: &user_v1.UserIDRequest{UserID: 2},
+			wantResp: &user_v1.UserAllInfo{
+				ID:        2,
+				Username:  "operator",
+				Role:      "operator",
+				CreatedAt: timestamppb.New(createdAt),
+				UpdatedAt: timestamppb.New(createdAt),
+			},
+			useCase: userMock.NewUseCaseMock(ctrl).GetUserMock.
+				When(minimock.AnyContext, 2).
+				Then(userModel.AllResponse{
+					ID:        2,
+					Username:  "operator",
+					Role:      userModel.RoleOperator,
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+				}, nil),
+		},
+		{
+			description: "User not found",
+			requestBody: &user_v1.UserIDRequest{UserID: 5},
+			wantErr:     status.Errorf(codes.NotFound, "Error: User not found"),
+			useCase: userMock.NewUseCaseMock(ctrl).GetUserMock.
+				When(minimock.AnyContext, 5).
+				Then(userModel.AllResponse{}, errlst.ErrUserNotFound),
+		},
+		{
+			description: "Invalid user id",
+			requestBody: &user_v1.UserIDRequest{UserID: 0},
+			wantErr:     status.Errorf(codes.InvalidArgument, "invalid request id"),
+			useCase:     userMock.NewUseCaseMock(ctrl),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			handler := NewUserHandler(tt.useCase)
+
+			response, err := handler.GetUserByID(ctx, tt.requestBody)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantResp, response)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package user
+
+import (
+	"context"
+
+	"Homework-1/pkg/api/abstract"
+	"Homework-1/pkg/api/user_v1"
+)
+
+// Handlers is
+type Handlers interface {
+	CreateUser(context.Context, *user_v1.UserCreateRequest) (*abstract.MessageResponse, error)
+	UpdateUser(context.Context, *user_v1.UserUpdateRequest) (*abstract.MessageResponse, error)
+	DeleteUser(context.Context, *user_v1.UserIDRequest) (*abstract.MessageResponse, error)
+	ListUsers(context.Context, *abstract.Page) (*user_v1.UserListResponse, error)
+	GetUserByID(context.Context, *user_v1.UserIDRequest) (*user_v1.UserAllInfo, error)
+}
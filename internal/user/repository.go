@@ -0,0 +1,21 @@
+package user
+
+import (
+	"context"
+
+	"Homework-1/internal/model/abstract"
+	"Homework-1/internal/model/user"
+)
+
+// Repository is
+type Repository interface {
+	CreateUser(ctx context.Context, userData user.Data) (int64, error)
+	CreateUserIfNotExists(ctx context.Context, userData user.Data) (bool, error)
+	UpdateUser(ctx context.Context, updateData user.UpdateData) error
+	DeleteUserByID(ctx context.Context, id int64) error
+	ListUsers(ctx context.Context, userPagination abstract.PageData) ([]user.AllData, error)
+	CountUsers(ctx context.Context) (int64, error)
+	CountUsersByRole(ctx context.Context, role user.Role) (int64, error)
+	GetUser(ctx context.Context, id int64) (user.AllData, error)
+	GetUserByUsername(ctx context.Context, username string) (user.AllData, error)
+}
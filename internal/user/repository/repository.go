@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"Homework-1/internal/connection"
+	"Homework-1/internal/model/abstract"
+	"Homework-1/internal/model/user"
+	"Homework-1/pkg/errlst"
+)
+
+// UserRepository is
+type UserRepository struct {
+	psqlDB connection.DB
+}
+
+// NewUserPGRepository is
+func NewUserPGRepository(psqlDB connection.DB) *UserRepository {
+	return &UserRepository{
+		psqlDB: psqlDB,
+	}
+}
+
+const userColumns = "id, username, password_hash, role, created_at, updated_at"
+
+// CreateUser is
+func (u *UserRepository) CreateUser(ctx context.Context, userData user.Data) (int64, error) {
+	log.Println("[user][repository][CreateUser]")
+
+	var id int64
+	row := u.psqlDB.QueryRow(
+		ctx,
+		"INSERT INTO users(username, password_hash, role) VALUES ($1,$2,$3) RETURNING ID;",
+		userData.Username,
+		userData.PasswordHash,
+		userData.Role,
+	)
+
+	err := row.Scan(&id)
+	if err != nil {
+		return -1, fmt.Errorf("u.psqlDB.QueryRow: %w", err)
+	}
+
+	return id, nil
+}
+
+// CreateUserIfNotExists is, false means a user with the username is already there and it is left as it is
+func (u *UserRepository) CreateUserIfNotExists(ctx context.Context, userData user.Data) (bool, error) {
+	log.Println("[user][repository][CreateUserIfNotExists]")
+
+	result, err := u.psqlDB.Execute(
+		ctx,
+		"INSERT INTO users(username, password_hash, role) VALUES ($1,$2,$3) ON CONFLICT (username) DO NOTHING",
+		userData.Username,
+		userData.PasswordHash,
+		userData.Role,
+	)
+	if err != nil {
+		return false, fmt.Errorf("u.psqlDB.Execute: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// UpdateUser is
+func (u *UserRepository) UpdateUser(ctx context.Context, updateData user.UpdateData) error {
+	log.Println("[user][repository][UpdateUser]")
+
+	result, err := u.psqlDB.Execute(
+		ctx,
+		"UPDATE users SET password_hash = COALESCE($1, password_hash), role = COALESCE($2, role), updated_at = $3 "+
+			"WHERE id = $4",
+		updateData.PasswordHash,
+		updateData.Role,
+		time.Now(),
+		updateData.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("u.psqlDB.Execute: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	if rows == 0 {
+		return errlst.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// DeleteUserByID is
+func (u *UserRepository) DeleteUserByID(ctx context.Context, id int64) error {
+	log.Println("[user][repository][DeleteUserByID]")
+
+	result, err := u.psqlDB.Execute(ctx, "DELETE FROM users WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("u.psqlDB.Execute: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	if rows == 0 {
+		return errlst.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// ListUsers is
+func (u *UserRepository) ListUsers(ctx context.Context, userPagination abstract.PageData) ([]user.AllData, error) {
+	log.Println("[user][repository][ListUsers]")
+	offset := (userPagination.CurrentPage - 1) * userPagination.ItemsPerPage
+	var userAllData []user.AllData
+
+	err := u.psqlDB.Select(
+		ctx,
+		&userAllData,
+		"SELECT "+userColumns+" FROM users ORDER BY username OFFSET $1 LIMIT $2",
+		offset,
+		userPagination.ItemsPerPage,
+	)
+	if err != nil {
+		return []user.AllData{}, fmt.Errorf("u.psqlDB.Select: %w", err)
+	}
+
+	return userAllData, nil
+}
+
+// CountUsers is
+func (u *UserRepository) CountUsers(ctx context.Context) (int64, error) {
+	log.Println("[user][repository][CountUsers]")
+	var totalCount int64
+
+	err := u.psqlDB.Get(ctx, &totalCount, "SELECT COUNT(*) FROM users")
+	if err != nil {
+		return 0, fmt.Errorf("u.psqlDB.Get: %w", err)
+	}
+
+	return totalCount, nil
+}
+
+// CountUsersByRole is, the rows are locked so two admins can not demote each other at the same time
+func (u *UserRepository) CountUsersByRole(ctx context.Context, role user.Role) (int64, error) {
+	log.Println("[user][repository][CountUsersByRole]")
+	var ids []int64
+
+	err := u.psqlDB.Select(ctx, &ids, "SELECT id FROM users WHERE role = $1 FOR UPDATE", role)
+	if err != nil {
+		return 0, fmt.Errorf("u.psqlDB.Select: %w", err)
+	}
+
+	return int64(len(ids)), nil
+}
+
+// GetUser is
+func (u *UserRepository) GetUser(ctx context.Context, id int64) (user.AllData, error) {
+	log.Println("[user][repository][GetUser]")
+
+	return u.getUser(ctx, "id = $1", id)
+}
+
+// GetUserByUsername is
+func (u *UserRepository) GetUserByUsername(ctx context.Context, username string) (user.AllData, error) {
+	log.Println("[user][repository][GetUserByUsername]")
+
+	return u.getUser(ctx, "username = $1", username)
+}
+
+// getUser is
+func (u *UserRepository) getUser(ctx context.Context, condition string, args ...interface{}) (user.AllData, error) {
+	var userData user.AllData
+
+	err := u.psqlDB.Get(
+		ctx,
+		&userData,
+		"SELECT "+userColumns+" FROM users WHERE "+condition,
+		args...,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return user.AllData{}, errlst.ErrUserNotFound
+		}
+
+		return user.AllData{}, fmt.Errorf("u.psqlDB.Get: %w", err)
+	}
+
+	return userData, nil
+}
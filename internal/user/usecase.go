@@ -0,0 +1,22 @@
+// Package user ...
+//
+//go:generate minimock -g -i UseCase -o ./mock/usecase_mock.go -n UseCaseMock
+package user
+
+import (
+	"context"
+
+	"Homework-1/internal/model/abstract"
+	userModel "Homework-1/internal/model/user"
+)
+
+// UseCase is
+type UseCase interface {
+	Authenticate(ctx context.Context, username, password string) (userModel.AllResponse, error)
+	EnsureUser(ctx context.Context, request userModel.Request) error
+	CreateUser(ctx context.Context, request userModel.Request) (int64, error)
+	UpdateUser(ctx context.Context, request userModel.UpdateRequest) error
+	DeleteUserByID(ctx context.Context, userID int64) error
+	ListUsers(ctx context.Context, userPagination abstract.Page) (abstract.PaginatedResponse[userModel.AllResponse], error)
+	GetUser(ctx context.Context, userID int64) (userModel.AllResponse, error)
+}
@@ -0,0 +1,322 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/crypto/bcrypt"
+
+	"Homework-1/internal/cache"
+	"Homework-1/internal/database"
+	"Homework-1/internal/model/abstract"
+	"Homework-1/internal/model/user"
+	"Homework-1/pkg/constants"
+	"Homework-1/pkg/errlst"
+	"Homework-1/pkg/reqvalidator"
+	"Homework-1/pkg/tracing"
+)
+
+// unknownUserHash is compared against when the username is unknown, so the answer takes as long as for a wrong password
+var unknownUserHash, _ = bcrypt.GenerateFromPassword([]byte("unknown user"), bcrypt.DefaultCost)
+
+// processAuthSecret keys the cached logins when no secret is configured, the logins cached by one server
+// are then checked again by bcrypt on the others
+var processAuthSecret = func() []byte {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		log.Fatalf("[user][useCase] rand.Read: %v", err)
+	}
+
+	return secret
+}()
+
+// UserUseCase is
+type UserUseCase struct {
+	repo       database.Datastore
+	cache      cache.Store
+	authSecret []byte
+}
+
+// NewUserUseCase is, authSecret keys the digests of the cached logins and has to be the same on every server
+// that shares the cache
+func NewUserUseCase(repo database.Datastore, cache cache.Store, authSecret string) *UserUseCase {
+	secret := processAuthSecret
+	if authSecret != "" {
+		secret = []byte(authSecret)
+	}
+
+	return &UserUseCase{repo: repo, cache: cache, authSecret: secret}
+}
+
+// Authenticate is, an unknown username and a wrong password are the same error.
+// A verified login is cached for constants.UserAuthTimeDuration so that the bcrypt check runs once per login
+func (u *UserUseCase) Authenticate(ctx context.Context, username, password string) (user.AllResponse, error) {
+	log.Println("[user][useCase][Authenticate]")
+	tracer := otel.Tracer("[user][useCase]")
+	ctx, span := tracer.Start(ctx, "[Authenticate]")
+	defer span.End()
+
+	cacheArgument := abstract.CacheArgument{ObjectType: "user_auth", ObjectID: user.AuthCacheID(username)}
+	if value, err := u.cache.Get(ctx, cacheArgument); err == nil {
+		var authCache user.AuthCache
+		if json.Unmarshal(value, &authCache) == nil && authCache.Matches(u.authSecret, username, password) {
+			span.SetStatus(codes.Ok, "User authenticated from cache")
+			return authCache.User, nil
+		}
+	}
+
+	userData, err := u.repo.UserRepo().GetUserByUsername(ctx, username)
+	if err != nil && !errors.Is(err, errlst.ErrUserNotFound) {
+		tracing.ErrorTracer(span, err)
+		return user.AllResponse{}, err
+	}
+
+	if err != nil {
+		_ = bcrypt.CompareHashAndPassword(unknownUserHash, []byte(password))
+		tracing.ErrorTracer(span, errlst.ErrInvalidCredentials)
+		return user.AllResponse{}, errlst.ErrInvalidCredentials
+	}
+
+	if err = bcrypt.CompareHashAndPassword([]byte(userData.PasswordHash), []byte(password)); err != nil {
+		tracing.ErrorTracer(span, errlst.ErrInvalidCredentials)
+		return user.AllResponse{}, errlst.ErrInvalidCredentials
+	}
+
+	userResponse := userData.ToServer()
+
+	marshaledData, err := json.Marshal(user.AuthCache{
+		Username:       username,
+		PasswordDigest: user.PasswordDigest(u.authSecret, username, password),
+		User:           userResponse,
+	})
+	if err == nil {
+		err = u.cache.Set(ctx, cacheArgument, marshaledData, constants.UserAuthTimeDuration)
+	}
+	if err != nil {
+		log.Printf("[user][usecase][Authenticate] u.cache.Set: %v", err)
+		tracing.ErrorTracer(span, err)
+	}
+
+	span.SetStatus(codes.Ok, "User authenticated")
+	return userResponse, nil
+}
+
+// EnsureUser creates the user when there is no user with its username yet, an existing user is not changed.
+// It does not come through a handler, so the request is validated here
+func (u *UserUseCase) EnsureUser(ctx context.Context, request user.Request) error {
+	log.Println("[user][useCase][EnsureUser]")
+	tracer := otel.Tracer("[user][useCase]")
+	ctx, span := tracer.Start(ctx, "[EnsureUser]")
+	defer span.End()
+
+	if err := reqvalidator.ValidateRequest(request); err != nil {
+		tracing.ErrorTracer(span, err)
+		return fmt.Errorf("reqvalidator.ValidateRequest: %w", err)
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return err
+	}
+
+	created, err := u.repo.UserRepo().CreateUserIfNotExists(ctx, request.ToStorage(string(passwordHash)))
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return err
+	}
+
+	if created {
+		log.Printf("[user][useCase][EnsureUser] user %s is created with the %s role", request.Username, request.Role)
+	}
+
+	span.SetStatus(codes.Ok, "User ensured")
+	return nil
+}
+
+// CreateUser is
+func (u *UserUseCase) CreateUser(ctx context.Context, request user.Request) (int64, error) {
+	log.Println("[user][useCase][CreateUser]")
+	tracer := otel.Tracer("[user][useCase]")
+	ctx, span := tracer.Start(ctx, "[CreateUser]")
+	defer span.End()
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return -1, err
+	}
+
+	id, err := u.repo.UserRepo().CreateUser(ctx, request.ToStorage(string(passwordHash)))
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return -1, err
+	}
+
+	span.SetStatus(codes.Ok, "User created successfully")
+	return id, nil
+}
+
+// UpdateUser is, the role of the last admin can not be changed
+func (u *UserUseCase) UpdateUser(ctx context.Context, request user.UpdateRequest) error {
+	log.Println("[user][useCase][UpdateUser]")
+	tracer := otel.Tracer("[user][useCase]")
+	ctx, span := tracer.Start(ctx, "[UpdateUser]")
+	defer span.End()
+
+	var passwordHash *string
+	if request.Password != nil {
+		hash, err := bcrypt.GenerateFromPassword([]byte(*request.Password), bcrypt.DefaultCost)
+		if err != nil {
+			tracing.ErrorTracer(span, err)
+			return err
+		}
+		passwordHash = lo.ToPtr(string(hash))
+	}
+
+	var userData user.AllData
+	err := u.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		var err error
+		if request.Role != nil && *request.Role != user.RoleAdmin {
+			userData, err = checkLastAdmin(ctx, db, request.ID)
+		} else {
+			userData, err = db.UserRepo().GetUser(ctx, request.ID)
+		}
+		if err != nil {
+			return err
+		}
+
+		return db.UserRepo().UpdateUser(ctx, request.ToStorage(passwordHash))
+	})
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return err
+	}
+	u.invalidateUser(ctx, span, userData.Username)
+
+	span.SetStatus(codes.Ok, "User updated successfully")
+	return nil
+}
+
+// DeleteUserByID is, the last admin can not be deleted
+func (u *UserUseCase) DeleteUserByID(ctx context.Context, userID int64) error {
+	log.Println("[user][useCase][DeleteUserByID]")
+	tracer := otel.Tracer("[user][useCase]")
+	ctx, span := tracer.Start(ctx, "[DeleteUserByID]")
+	defer span.End()
+
+	var userData user.AllData
+	err := u.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		var err error
+		userData, err = checkLastAdmin(ctx, db, userID)
+		if err != nil {
+			return err
+		}
+
+		return db.UserRepo().DeleteUserByID(ctx, userID)
+	})
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return err
+	}
+	u.invalidateUser(ctx, span, userData.Username)
+
+	span.SetStatus(codes.Ok, "Successfully deleted user by ID")
+	return nil
+}
+
+// ListUsers is
+func (u *UserUseCase) ListUsers(ctx context.Context, userPage abstract.Page) (abstract.PaginatedResponse[user.AllResponse], error) {
+	log.Println("[user][useCase][ListUsers]")
+	tracer := otel.Tracer("[user][useCase]")
+	ctx, span := tracer.Start(ctx, "[ListUsers]")
+	defer span.End()
+
+	var userAllData []user.AllData
+	var userListResponse abstract.PaginatedResponse[user.AllResponse]
+
+	err := u.repo.WithTransaction(ctx, func(db database.Datastore) error {
+		count, err := db.UserRepo().CountUsers(ctx)
+		if err != nil {
+			return err
+		}
+
+		userListResponse.TotalItems = count
+
+		userAllData, err = db.UserRepo().ListUsers(ctx, userPage.ToStorage())
+		return err
+	})
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return abstract.PaginatedResponse[user.AllResponse]{}, err
+	}
+
+	userList := lo.Map(
+		userAllData,
+		func(item user.AllData, _ int) user.AllResponse {
+			return item.ToServer()
+		},
+	)
+
+	userListResponse.Items = userList
+	userListResponse.CurrentPage = userPage.CurrentPage
+	userListResponse.ItemsPerPage = int64(len(userList))
+
+	span.SetStatus(codes.Ok, "Successfully got list of users")
+	return userListResponse, nil
+}
+
+// GetUser is
+func (u *UserUseCase) GetUser(ctx context.Context, userID int64) (user.AllResponse, error) {
+	log.Println("[user][useCase][GetUser]")
+	tracer := otel.Tracer("[user][useCase]")
+	ctx, span := tracer.Start(ctx, "[GetUser]")
+	defer span.End()
+
+	userData, err := u.repo.UserRepo().GetUser(ctx, userID)
+	if err != nil {
+		tracing.ErrorTracer(span, err)
+		return user.AllResponse{}, err
+	}
+
+	span.SetStatus(codes.Ok, "Successfully got user")
+	return userData.ToServer(), nil
+}
+
+// invalidateUser drops the cached login of the user, so a changed password or role is checked on the next call
+func (u *UserUseCase) invalidateUser(ctx context.Context, span trace.Span, username string) {
+	err := u.cache.Del(ctx, abstract.CacheArgument{ObjectType: "user_auth", ObjectID: user.AuthCacheID(username)})
+	if err != nil {
+		log.Printf("[user][usecase][invalidateUser] u.cache.Del: %v", err)
+		tracing.ErrorTracer(span, err)
+	}
+}
+
+// checkLastAdmin is the user that is going to stop being an admin, it fails when the user is the only admin left.
+// The admin rows are locked before the user is read, so of two calls that demote or delete the last two admins
+// at the same time the second one waits for the first one and counts a single admin
+func checkLastAdmin(ctx context.Context, db database.Datastore, userID int64) (user.AllData, error) {
+	admins, err := db.UserRepo().CountUsersByRole(ctx, user.RoleAdmin)
+	if err != nil {
+		return user.AllData{}, err
+	}
+
+	userData, err := db.UserRepo().GetUser(ctx, userID)
+	if err != nil {
+		return user.AllData{}, err
+	}
+
+	if userData.Role == user.RoleAdmin && admins <= 1 {
+		return user.AllData{}, errlst.ErrLastAdmin
+	}
+
+	return userData, nil
+}
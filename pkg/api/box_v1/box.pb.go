@@ -28,10 +28,16 @@ type Box struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Cost    float64 `protobuf:"fixed64,2,opt,name=cost,proto3" json:"cost,omitempty"`
-	IsCheck bool    `protobuf:"varint,3,opt,name=isCheck,proto3" json:"isCheck,omitempty"`
-	Weight  float64 `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// cost is kept for JSON clients that send money as a float, costAmount wins when both are set
+	//
+	// Deprecated: Marked as deprecated in box.proto.
+	Cost       float64         `protobuf:"fixed64,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	IsCheck    bool            `protobuf:"varint,3,opt,name=isCheck,proto3" json:"isCheck,omitempty"`
+	Weight     float64         `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	CostAmount *abstract.Money `protobuf:"bytes,5,opt,name=costAmount,proto3" json:"costAmount,omitempty"`
+	// dimensions are the inner size of the box, orders that do not fit into it are rejected
+	Dimensions *abstract.Dimensions `protobuf:"bytes,6,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
 }
 
 func (x *Box) Reset() {
@@ -73,6 +79,7 @@ func (x *Box) GetName() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in box.proto.
 func (x *Box) GetCost() float64 {
 	if x != nil {
 		return x.Cost
@@ -94,6 +101,20 @@ func (x *Box) GetWeight() float64 {
 	return 0
 }
 
+func (x *Box) GetCostAmount() *abstract.Money {
+	if x != nil {
+		return x.CostAmount
+	}
+	return nil
+}
+
+func (x *Box) GetDimensions() *abstract.Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
 type BoxAllInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -103,6 +124,10 @@ type BoxAllInfo struct {
 	Box       *Box                   `protobuf:"bytes,2,opt,name=box,proto3" json:"box,omitempty"`
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// archivedAt is set once the box is archived, it is kept for existing orders but new orders can not use it
+	ArchivedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=archivedAt,proto3" json:"archivedAt,omitempty"`
+	// deletedAt is set only for the deleted boxes
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
 }
 
 func (x *BoxAllInfo) Reset() {
@@ -165,6 +190,20 @@ func (x *BoxAllInfo) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *BoxAllInfo) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *BoxAllInfo) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
 type BoxCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -212,6 +251,95 @@ func (x *BoxCreateRequest) GetBox() *Box {
 	return nil
 }
 
+// BoxUpdateRequest is a partial update, fields that are not set are left as they are
+type BoxUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoxID      int64           `protobuf:"varint,1,opt,name=boxID,proto3" json:"boxID,omitempty"`
+	Name       *string         `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	CostAmount *abstract.Money `protobuf:"bytes,3,opt,name=costAmount,proto3" json:"costAmount,omitempty"`
+	IsCheck    *bool           `protobuf:"varint,4,opt,name=isCheck,proto3,oneof" json:"isCheck,omitempty"`
+	Weight     *float64        `protobuf:"fixed64,5,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	// dimensions replace all three sizes of the box when set
+	Dimensions *abstract.Dimensions `protobuf:"bytes,6,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+}
+
+func (x *BoxUpdateRequest) Reset() {
+	*x = BoxUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_box_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoxUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoxUpdateRequest) ProtoMessage() {}
+
+func (x *BoxUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_box_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoxUpdateRequest.ProtoReflect.Descriptor instead.
+func (*BoxUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_box_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BoxUpdateRequest) GetBoxID() int64 {
+	if x != nil {
+		return x.BoxID
+	}
+	return 0
+}
+
+func (x *BoxUpdateRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *BoxUpdateRequest) GetCostAmount() *abstract.Money {
+	if x != nil {
+		return x.CostAmount
+	}
+	return nil
+}
+
+func (x *BoxUpdateRequest) GetIsCheck() bool {
+	if x != nil && x.IsCheck != nil {
+		return *x.IsCheck
+	}
+	return false
+}
+
+func (x *BoxUpdateRequest) GetWeight() float64 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *BoxUpdateRequest) GetDimensions() *abstract.Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
 type BoxIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -223,7 +351,7 @@ type BoxIDRequest struct {
 func (x *BoxIDRequest) Reset() {
 	*x = BoxIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_box_proto_msgTypes[3]
+		mi := &file_box_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -236,7 +364,7 @@ func (x *BoxIDRequest) String() string {
 func (*BoxIDRequest) ProtoMessage() {}
 
 func (x *BoxIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_box_proto_msgTypes[3]
+	mi := &file_box_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -249,7 +377,7 @@ func (x *BoxIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoxIDRequest.ProtoReflect.Descriptor instead.
 func (*BoxIDRequest) Descriptor() ([]byte, []int) {
-	return file_box_proto_rawDescGZIP(), []int{3}
+	return file_box_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BoxIDRequest) GetBoxID() int64 {
@@ -271,7 +399,7 @@ type BoxListResponse struct {
 func (x *BoxListResponse) Reset() {
 	*x = BoxListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_box_proto_msgTypes[4]
+		mi := &file_box_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -284,7 +412,7 @@ func (x *BoxListResponse) String() string {
 func (*BoxListResponse) ProtoMessage() {}
 
 func (x *BoxListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_box_proto_msgTypes[4]
+	mi := &file_box_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -297,7 +425,7 @@ func (x *BoxListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoxListResponse.ProtoReflect.Descriptor instead.
 func (*BoxListResponse) Descriptor() ([]byte, []int) {
-	return file_box_proto_rawDescGZIP(), []int{4}
+	return file_box_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BoxListResponse) GetBoxAllInfo() []*BoxAllInfo {
@@ -314,6 +442,189 @@ func (x *BoxListResponse) GetPagination() *abstract.Pagination {
 	return nil
 }
 
+type BoxVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoxID int64          `protobuf:"varint,1,opt,name=boxID,proto3" json:"boxID,omitempty"`
+	Page  *abstract.Page `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *BoxVersionsRequest) Reset() {
+	*x = BoxVersionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_box_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoxVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoxVersionsRequest) ProtoMessage() {}
+
+func (x *BoxVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_box_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoxVersionsRequest.ProtoReflect.Descriptor instead.
+func (*BoxVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_box_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BoxVersionsRequest) GetBoxID() int64 {
+	if x != nil {
+		return x.BoxID
+	}
+	return 0
+}
+
+func (x *BoxVersionsRequest) GetPage() *abstract.Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// BoxVersion is the box as it was after it was created or updated,
+// orders are charged with the version they were received with
+type BoxVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoxID     int64                  `protobuf:"varint,1,opt,name=boxID,proto3" json:"boxID,omitempty"`
+	Version   int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Box       *Box                   `protobuf:"bytes,3,opt,name=box,proto3" json:"box,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *BoxVersion) Reset() {
+	*x = BoxVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_box_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoxVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoxVersion) ProtoMessage() {}
+
+func (x *BoxVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_box_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoxVersion.ProtoReflect.Descriptor instead.
+func (*BoxVersion) Descriptor() ([]byte, []int) {
+	return file_box_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BoxVersion) GetBoxID() int64 {
+	if x != nil {
+		return x.BoxID
+	}
+	return 0
+}
+
+func (x *BoxVersion) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *BoxVersion) GetBox() *Box {
+	if x != nil {
+		return x.Box
+	}
+	return nil
+}
+
+func (x *BoxVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type BoxVersionListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Versions   []*BoxVersion        `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	Pagination *abstract.Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *BoxVersionListResponse) Reset() {
+	*x = BoxVersionListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_box_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoxVersionListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoxVersionListResponse) ProtoMessage() {}
+
+func (x *BoxVersionListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_box_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoxVersionListResponse.ProtoReflect.Descriptor instead.
+func (*BoxVersionListResponse) Descriptor() ([]byte, []int) {
+	return file_box_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BoxVersionListResponse) GetVersions() []*BoxVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *BoxVersionListResponse) GetPagination() *abstract.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 var File_box_proto protoreflect.FileDescriptor
 
 var file_box_proto_rawDesc = []byte{
@@ -322,57 +633,138 @@ var file_box_proto_rawDesc = []byte{
 	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5f, 0x0a, 0x03, 0x42, 0x6f,
-	0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x0a,
-	0x42, 0x6f, 0x78, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x03, 0x62, 0x6f,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x42, 0x6f, 0x78, 0x52, 0x03, 0x62,
-	0x6f, 0x78, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb8, 0x01, 0x0a, 0x03, 0x42,
+	0x6f, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x63, 0x6f,
+	0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x0a, 0x64, 0x69, 0x6d, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x44,
+	0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9e, 0x02, 0x0a, 0x0a, 0x42, 0x6f, 0x78, 0x41, 0x6c, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x03, 0x62, 0x6f, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x04, 0x2e, 0x42, 0x6f, 0x78, 0x52, 0x03, 0x62, 0x6f, 0x78, 0x12, 0x38, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2a, 0x0a, 0x10, 0x42, 0x6f, 0x78, 0x43, 0x72, 0x65,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2a, 0x0a, 0x10, 0x42, 0x6f, 0x78, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x03, 0x62, 0x6f,
 	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x42, 0x6f, 0x78, 0x52, 0x03, 0x62,
-	0x6f, 0x78, 0x22, 0x24, 0x0a, 0x0c, 0x42, 0x6f, 0x78, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x22, 0x6b, 0x0a, 0x0f, 0x42, 0x6f, 0x78, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x62,
-	0x6f, 0x78, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x42, 0x6f, 0x78, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x62, 0x6f,
-	0x78, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x50,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xad, 0x02, 0x0a, 0x0a, 0x42, 0x6f, 0x78, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f,
-	0x78, 0x12, 0x11, 0x2e, 0x42, 0x6f, 0x78, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01,
-	0x2a, 0x22, 0x0e, 0x2f, 0x62, 0x6f, 0x78, 0x5f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x12, 0x4c, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x78, 0x12, 0x0d,
-	0x2e, 0x42, 0x6f, 0x78, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f, 0x62, 0x6f, 0x78, 0x5f, 0x76, 0x31,
-	0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2f, 0x7b, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x7d, 0x12,
-	0x3d, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x78, 0x65, 0x73, 0x12, 0x05, 0x2e, 0x50,
-	0x61, 0x67, 0x65, 0x1a, 0x10, 0x2e, 0x42, 0x6f, 0x78, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a,
-	0x22, 0x0c, 0x2f, 0x62, 0x6f, 0x78, 0x5f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x45,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x78, 0x42, 0x79, 0x49, 0x44, 0x12, 0x0d, 0x2e, 0x42,
-	0x6f, 0x78, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x42, 0x6f,
-	0x78, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
-	0x12, 0x13, 0x2f, 0x62, 0x6f, 0x78, 0x5f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x7b, 0x62,
-	0x6f, 0x78, 0x49, 0x44, 0x7d, 0x42, 0x20, 0x5a, 0x1e, 0x63, 0x72, 0x75, 0x64, 0x2d, 0x70, 0x76,
-	0x7a, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x6f, 0x78, 0x5f, 0x76, 0x31,
-	0x3b, 0x62, 0x6f, 0x78, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x78, 0x22, 0xf2, 0x01, 0x0a, 0x10, 0x42, 0x6f, 0x78, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x78, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x12, 0x17, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x07, 0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x01, 0x52, 0x07, 0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0a, 0x64, 0x69,
+	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x64, 0x69, 0x6d,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x24, 0x0a, 0x0c, 0x42, 0x6f, 0x78, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x78, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x22, 0x6b, 0x0a,
+	0x0f, 0x42, 0x6f, 0x78, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x0a, 0x62, 0x6f, 0x78, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x42, 0x6f, 0x78, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0a, 0x62, 0x6f, 0x78, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x45, 0x0a, 0x12, 0x42, 0x6f,
+	0x78, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x12, 0x19, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0a, 0x42, 0x6f, 0x78, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x03, 0x62, 0x6f, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e,
+	0x42, 0x6f, 0x78, 0x52, 0x03, 0x62, 0x6f, 0x78, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x6e, 0x0a, 0x16, 0x42, 0x6f, 0x78, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x08,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x42, 0x6f, 0x78, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x32, 0xa2, 0x06, 0x0a, 0x0a, 0x42, 0x6f, 0x78, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x4b, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x78, 0x12, 0x11,
+	0x2e, 0x42, 0x6f, 0x78, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e,
+	0x2f, 0x62, 0x6f, 0x78, 0x5f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x4c,
+	0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x78, 0x12, 0x0d, 0x2e, 0x42, 0x6f,
+	0x78, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f, 0x62, 0x6f, 0x78, 0x5f, 0x76, 0x31, 0x2f, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x2f, 0x7b, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x7d, 0x12, 0x53, 0x0a, 0x09,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x78, 0x12, 0x11, 0x2e, 0x42, 0x6f, 0x78, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x1a, 0x16, 0x2f, 0x62, 0x6f, 0x78, 0x5f,
+	0x76, 0x31, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x7b, 0x62, 0x6f, 0x78, 0x49, 0x44,
+	0x7d, 0x12, 0x4e, 0x0a, 0x0a, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x42, 0x6f, 0x78, 0x12,
+	0x0d, 0x2e, 0x42, 0x6f, 0x78, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x1a, 0x17, 0x2f, 0x62, 0x6f, 0x78, 0x5f, 0x76,
+	0x31, 0x2f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2f, 0x7b, 0x62, 0x6f, 0x78, 0x49, 0x44,
+	0x7d, 0x12, 0x3d, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x78, 0x65, 0x73, 0x12, 0x05,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x1a, 0x10, 0x2e, 0x42, 0x6f, 0x78, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a,
+	0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x62, 0x6f, 0x78, 0x5f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x64, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x78, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x13, 0x2e, 0x42, 0x6f, 0x78, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x42, 0x6f, 0x78, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x62,
+	0x6f, 0x78, 0x5f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x62, 0x6f, 0x78, 0x49, 0x44, 0x7d, 0x12, 0x4c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x6f, 0x78, 0x65, 0x73, 0x12, 0x05, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x1a, 0x10, 0x2e, 0x42, 0x6f, 0x78, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14,
+	0x2f, 0x62, 0x6f, 0x78, 0x5f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x2f,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x42,
+	0x6f, 0x78, 0x12, 0x0d, 0x2e, 0x42, 0x6f, 0x78, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x1a, 0x17, 0x2f, 0x62, 0x6f,
+	0x78, 0x5f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x7b, 0x62, 0x6f,
+	0x78, 0x49, 0x44, 0x7d, 0x12, 0x4a, 0x0a, 0x08, 0x50, 0x75, 0x72, 0x67, 0x65, 0x42, 0x6f, 0x78,
+	0x12, 0x0d, 0x2e, 0x42, 0x6f, 0x78, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x2a, 0x15, 0x2f, 0x62, 0x6f, 0x78, 0x5f,
+	0x76, 0x31, 0x2f, 0x70, 0x75, 0x72, 0x67, 0x65, 0x2f, 0x7b, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x7d,
+	0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x78, 0x42, 0x79, 0x49, 0x44, 0x12, 0x0d,
+	0x2e, 0x42, 0x6f, 0x78, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e,
+	0x42, 0x6f, 0x78, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x15, 0x12, 0x13, 0x2f, 0x62, 0x6f, 0x78, 0x5f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f,
+	0x7b, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x7d, 0x42, 0x20, 0x5a, 0x1e, 0x63, 0x72, 0x75, 0x64, 0x2d,
+	0x70, 0x76, 0x7a, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x6f, 0x78, 0x5f,
+	0x76, 0x31, 0x3b, 0x62, 0x6f, 0x78, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -387,38 +779,67 @@ func file_box_proto_rawDescGZIP() []byte {
 	return file_box_proto_rawDescData
 }
 
-var file_box_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_box_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_box_proto_goTypes = []interface{}{
 	(*Box)(nil),                      // 0: Box
 	(*BoxAllInfo)(nil),               // 1: BoxAllInfo
 	(*BoxCreateRequest)(nil),         // 2: BoxCreateRequest
-	(*BoxIDRequest)(nil),             // 3: BoxIDRequest
-	(*BoxListResponse)(nil),          // 4: BoxListResponse
-	(*timestamppb.Timestamp)(nil),    // 5: google.protobuf.Timestamp
-	(*abstract.Pagination)(nil),      // 6: Pagination
-	(*abstract.Page)(nil),            // 7: Page
-	(*abstract.MessageResponse)(nil), // 8: MessageResponse
+	(*BoxUpdateRequest)(nil),         // 3: BoxUpdateRequest
+	(*BoxIDRequest)(nil),             // 4: BoxIDRequest
+	(*BoxListResponse)(nil),          // 5: BoxListResponse
+	(*BoxVersionsRequest)(nil),       // 6: BoxVersionsRequest
+	(*BoxVersion)(nil),               // 7: BoxVersion
+	(*BoxVersionListResponse)(nil),   // 8: BoxVersionListResponse
+	(*abstract.Money)(nil),           // 9: Money
+	(*abstract.Dimensions)(nil),      // 10: Dimensions
+	(*timestamppb.Timestamp)(nil),    // 11: google.protobuf.Timestamp
+	(*abstract.Pagination)(nil),      // 12: Pagination
+	(*abstract.Page)(nil),            // 13: Page
+	(*abstract.MessageResponse)(nil), // 14: MessageResponse
 }
 var file_box_proto_depIdxs = []int32{
-	0,  // 0: BoxAllInfo.box:type_name -> Box
-	5,  // 1: BoxAllInfo.createdAt:type_name -> google.protobuf.Timestamp
-	5,  // 2: BoxAllInfo.updatedAt:type_name -> google.protobuf.Timestamp
-	0,  // 3: BoxCreateRequest.box:type_name -> Box
-	1,  // 4: BoxListResponse.boxAllInfo:type_name -> BoxAllInfo
-	6,  // 5: BoxListResponse.pagination:type_name -> Pagination
-	2,  // 6: BoxService.CreateBox:input_type -> BoxCreateRequest
-	3,  // 7: BoxService.DeleteBox:input_type -> BoxIDRequest
-	7,  // 8: BoxService.ListBoxes:input_type -> Page
-	3,  // 9: BoxService.GetBoxByID:input_type -> BoxIDRequest
-	8,  // 10: BoxService.CreateBox:output_type -> MessageResponse
-	8,  // 11: BoxService.DeleteBox:output_type -> MessageResponse
-	4,  // 12: BoxService.ListBoxes:output_type -> BoxListResponse
-	1,  // 13: BoxService.GetBoxByID:output_type -> BoxAllInfo
-	10, // [10:14] is the sub-list for method output_type
-	6,  // [6:10] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	9,  // 0: Box.costAmount:type_name -> Money
+	10, // 1: Box.dimensions:type_name -> Dimensions
+	0,  // 2: BoxAllInfo.box:type_name -> Box
+	11, // 3: BoxAllInfo.createdAt:type_name -> google.protobuf.Timestamp
+	11, // 4: BoxAllInfo.updatedAt:type_name -> google.protobuf.Timestamp
+	11, // 5: BoxAllInfo.archivedAt:type_name -> google.protobuf.Timestamp
+	11, // 6: BoxAllInfo.deletedAt:type_name -> google.protobuf.Timestamp
+	0,  // 7: BoxCreateRequest.box:type_name -> Box
+	9,  // 8: BoxUpdateRequest.costAmount:type_name -> Money
+	10, // 9: BoxUpdateRequest.dimensions:type_name -> Dimensions
+	1,  // 10: BoxListResponse.boxAllInfo:type_name -> BoxAllInfo
+	12, // 11: BoxListResponse.pagination:type_name -> Pagination
+	13, // 12: BoxVersionsRequest.page:type_name -> Page
+	0,  // 13: BoxVersion.box:type_name -> Box
+	11, // 14: BoxVersion.createdAt:type_name -> google.protobuf.Timestamp
+	7,  // 15: BoxVersionListResponse.versions:type_name -> BoxVersion
+	12, // 16: BoxVersionListResponse.pagination:type_name -> Pagination
+	2,  // 17: BoxService.CreateBox:input_type -> BoxCreateRequest
+	4,  // 18: BoxService.DeleteBox:input_type -> BoxIDRequest
+	3,  // 19: BoxService.UpdateBox:input_type -> BoxUpdateRequest
+	4,  // 20: BoxService.ArchiveBox:input_type -> BoxIDRequest
+	13, // 21: BoxService.ListBoxes:input_type -> Page
+	6,  // 22: BoxService.ListBoxVersions:input_type -> BoxVersionsRequest
+	13, // 23: BoxService.ListDeletedBoxes:input_type -> Page
+	4,  // 24: BoxService.RestoreBox:input_type -> BoxIDRequest
+	4,  // 25: BoxService.PurgeBox:input_type -> BoxIDRequest
+	4,  // 26: BoxService.GetBoxByID:input_type -> BoxIDRequest
+	14, // 27: BoxService.CreateBox:output_type -> MessageResponse
+	14, // 28: BoxService.DeleteBox:output_type -> MessageResponse
+	14, // 29: BoxService.UpdateBox:output_type -> MessageResponse
+	14, // 30: BoxService.ArchiveBox:output_type -> MessageResponse
+	5,  // 31: BoxService.ListBoxes:output_type -> BoxListResponse
+	8,  // 32: BoxService.ListBoxVersions:output_type -> BoxVersionListResponse
+	5,  // 33: BoxService.ListDeletedBoxes:output_type -> BoxListResponse
+	14, // 34: BoxService.RestoreBox:output_type -> MessageResponse
+	14, // 35: BoxService.PurgeBox:output_type -> MessageResponse
+	1,  // 36: BoxService.GetBoxByID:output_type -> BoxAllInfo
+	27, // [27:37] is the sub-list for method output_type
+	17, // [17:27] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_box_proto_init() }
@@ -464,7 +885,7 @@ func file_box_proto_init() {
 			}
 		}
 		file_box_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoxIDRequest); i {
+			switch v := v.(*BoxUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -476,6 +897,18 @@ func file_box_proto_init() {
 			}
 		}
 		file_box_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoxIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_box_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BoxListResponse); i {
 			case 0:
 				return &v.state
@@ -487,14 +920,51 @@ func file_box_proto_init() {
 				return nil
 			}
 		}
+		file_box_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoxVersionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_box_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoxVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_box_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoxVersionListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_box_proto_msgTypes[3].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_box_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,10 +20,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	BoxService_CreateBox_FullMethodName  = "/BoxService/CreateBox"
-	BoxService_DeleteBox_FullMethodName  = "/BoxService/DeleteBox"
-	BoxService_ListBoxes_FullMethodName  = "/BoxService/ListBoxes"
-	BoxService_GetBoxByID_FullMethodName = "/BoxService/GetBoxByID"
+	BoxService_CreateBox_FullMethodName        = "/BoxService/CreateBox"
+	BoxService_DeleteBox_FullMethodName        = "/BoxService/DeleteBox"
+	BoxService_UpdateBox_FullMethodName        = "/BoxService/UpdateBox"
+	BoxService_ArchiveBox_FullMethodName       = "/BoxService/ArchiveBox"
+	BoxService_ListBoxes_FullMethodName        = "/BoxService/ListBoxes"
+	BoxService_ListBoxVersions_FullMethodName  = "/BoxService/ListBoxVersions"
+	BoxService_ListDeletedBoxes_FullMethodName = "/BoxService/ListDeletedBoxes"
+	BoxService_RestoreBox_FullMethodName       = "/BoxService/RestoreBox"
+	BoxService_PurgeBox_FullMethodName         = "/BoxService/PurgeBox"
+	BoxService_GetBoxByID_FullMethodName       = "/BoxService/GetBoxByID"
 )
 
 // BoxServiceClient is the client API for BoxService service.
@@ -32,7 +38,13 @@ const (
 type BoxServiceClient interface {
 	CreateBox(ctx context.Context, in *BoxCreateRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
 	DeleteBox(ctx context.Context, in *BoxIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
+	UpdateBox(ctx context.Context, in *BoxUpdateRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
+	ArchiveBox(ctx context.Context, in *BoxIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
 	ListBoxes(ctx context.Context, in *abstract.Page, opts ...grpc.CallOption) (*BoxListResponse, error)
+	ListBoxVersions(ctx context.Context, in *BoxVersionsRequest, opts ...grpc.CallOption) (*BoxVersionListResponse, error)
+	ListDeletedBoxes(ctx context.Context, in *abstract.Page, opts ...grpc.CallOption) (*BoxListResponse, error)
+	RestoreBox(ctx context.Context, in *BoxIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
+	PurgeBox(ctx context.Context, in *BoxIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
 	GetBoxByID(ctx context.Context, in *BoxIDRequest, opts ...grpc.CallOption) (*BoxAllInfo, error)
 }
 
@@ -62,6 +74,24 @@ func (c *boxServiceClient) DeleteBox(ctx context.Context, in *BoxIDRequest, opts
 	return out, nil
 }
 
+func (c *boxServiceClient) UpdateBox(ctx context.Context, in *BoxUpdateRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error) {
+	out := new(abstract.MessageResponse)
+	err := c.cc.Invoke(ctx, BoxService_UpdateBox_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boxServiceClient) ArchiveBox(ctx context.Context, in *BoxIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error) {
+	out := new(abstract.MessageResponse)
+	err := c.cc.Invoke(ctx, BoxService_ArchiveBox_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boxServiceClient) ListBoxes(ctx context.Context, in *abstract.Page, opts ...grpc.CallOption) (*BoxListResponse, error) {
 	out := new(BoxListResponse)
 	err := c.cc.Invoke(ctx, BoxService_ListBoxes_FullMethodName, in, out, opts...)
@@ -71,6 +101,42 @@ func (c *boxServiceClient) ListBoxes(ctx context.Context, in *abstract.Page, opt
 	return out, nil
 }
 
+func (c *boxServiceClient) ListBoxVersions(ctx context.Context, in *BoxVersionsRequest, opts ...grpc.CallOption) (*BoxVersionListResponse, error) {
+	out := new(BoxVersionListResponse)
+	err := c.cc.Invoke(ctx, BoxService_ListBoxVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boxServiceClient) ListDeletedBoxes(ctx context.Context, in *abstract.Page, opts ...grpc.CallOption) (*BoxListResponse, error) {
+	out := new(BoxListResponse)
+	err := c.cc.Invoke(ctx, BoxService_ListDeletedBoxes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boxServiceClient) RestoreBox(ctx context.Context, in *BoxIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error) {
+	out := new(abstract.MessageResponse)
+	err := c.cc.Invoke(ctx, BoxService_RestoreBox_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boxServiceClient) PurgeBox(ctx context.Context, in *BoxIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error) {
+	out := new(abstract.MessageResponse)
+	err := c.cc.Invoke(ctx, BoxService_PurgeBox_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boxServiceClient) GetBoxByID(ctx context.Context, in *BoxIDRequest, opts ...grpc.CallOption) (*BoxAllInfo, error) {
 	out := new(BoxAllInfo)
 	err := c.cc.Invoke(ctx, BoxService_GetBoxByID_FullMethodName, in, out, opts...)
@@ -86,7 +152,13 @@ func (c *boxServiceClient) GetBoxByID(ctx context.Context, in *BoxIDRequest, opt
 type BoxServiceServer interface {
 	CreateBox(context.Context, *BoxCreateRequest) (*abstract.MessageResponse, error)
 	DeleteBox(context.Context, *BoxIDRequest) (*abstract.MessageResponse, error)
+	UpdateBox(context.Context, *BoxUpdateRequest) (*abstract.MessageResponse, error)
+	ArchiveBox(context.Context, *BoxIDRequest) (*abstract.MessageResponse, error)
 	ListBoxes(context.Context, *abstract.Page) (*BoxListResponse, error)
+	ListBoxVersions(context.Context, *BoxVersionsRequest) (*BoxVersionListResponse, error)
+	ListDeletedBoxes(context.Context, *abstract.Page) (*BoxListResponse, error)
+	RestoreBox(context.Context, *BoxIDRequest) (*abstract.MessageResponse, error)
+	PurgeBox(context.Context, *BoxIDRequest) (*abstract.MessageResponse, error)
 	GetBoxByID(context.Context, *BoxIDRequest) (*BoxAllInfo, error)
 	mustEmbedUnimplementedBoxServiceServer()
 }
@@ -101,9 +173,27 @@ func (UnimplementedBoxServiceServer) CreateBox(context.Context, *BoxCreateReques
 func (UnimplementedBoxServiceServer) DeleteBox(context.Context, *BoxIDRequest) (*abstract.MessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBox not implemented")
 }
+func (UnimplementedBoxServiceServer) UpdateBox(context.Context, *BoxUpdateRequest) (*abstract.MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBox not implemented")
+}
+func (UnimplementedBoxServiceServer) ArchiveBox(context.Context, *BoxIDRequest) (*abstract.MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveBox not implemented")
+}
 func (UnimplementedBoxServiceServer) ListBoxes(context.Context, *abstract.Page) (*BoxListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBoxes not implemented")
 }
+func (UnimplementedBoxServiceServer) ListBoxVersions(context.Context, *BoxVersionsRequest) (*BoxVersionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBoxVersions not implemented")
+}
+func (UnimplementedBoxServiceServer) ListDeletedBoxes(context.Context, *abstract.Page) (*BoxListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedBoxes not implemented")
+}
+func (UnimplementedBoxServiceServer) RestoreBox(context.Context, *BoxIDRequest) (*abstract.MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBox not implemented")
+}
+func (UnimplementedBoxServiceServer) PurgeBox(context.Context, *BoxIDRequest) (*abstract.MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeBox not implemented")
+}
 func (UnimplementedBoxServiceServer) GetBoxByID(context.Context, *BoxIDRequest) (*BoxAllInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBoxByID not implemented")
 }
@@ -156,6 +246,42 @@ func _BoxService_DeleteBox_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoxService_UpdateBox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoxUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoxServiceServer).UpdateBox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoxService_UpdateBox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoxServiceServer).UpdateBox(ctx, req.(*BoxUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoxService_ArchiveBox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoxIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoxServiceServer).ArchiveBox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoxService_ArchiveBox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoxServiceServer).ArchiveBox(ctx, req.(*BoxIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BoxService_ListBoxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(abstract.Page)
 	if err := dec(in); err != nil {
@@ -174,6 +300,78 @@ func _BoxService_ListBoxes_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoxService_ListBoxVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoxVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoxServiceServer).ListBoxVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoxService_ListBoxVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoxServiceServer).ListBoxVersions(ctx, req.(*BoxVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoxService_ListDeletedBoxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(abstract.Page)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoxServiceServer).ListDeletedBoxes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoxService_ListDeletedBoxes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoxServiceServer).ListDeletedBoxes(ctx, req.(*abstract.Page))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoxService_RestoreBox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoxIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoxServiceServer).RestoreBox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoxService_RestoreBox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoxServiceServer).RestoreBox(ctx, req.(*BoxIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoxService_PurgeBox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoxIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoxServiceServer).PurgeBox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoxService_PurgeBox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoxServiceServer).PurgeBox(ctx, req.(*BoxIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BoxService_GetBoxByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BoxIDRequest)
 	if err := dec(in); err != nil {
@@ -207,10 +405,34 @@ var BoxService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBox",
 			Handler:    _BoxService_DeleteBox_Handler,
 		},
+		{
+			MethodName: "UpdateBox",
+			Handler:    _BoxService_UpdateBox_Handler,
+		},
+		{
+			MethodName: "ArchiveBox",
+			Handler:    _BoxService_ArchiveBox_Handler,
+		},
 		{
 			MethodName: "ListBoxes",
 			Handler:    _BoxService_ListBoxes_Handler,
 		},
+		{
+			MethodName: "ListBoxVersions",
+			Handler:    _BoxService_ListBoxVersions_Handler,
+		},
+		{
+			MethodName: "ListDeletedBoxes",
+			Handler:    _BoxService_ListDeletedBoxes_Handler,
+		},
+		{
+			MethodName: "RestoreBox",
+			Handler:    _BoxService_RestoreBox_Handler,
+		},
+		{
+			MethodName: "PurgeBox",
+			Handler:    _BoxService_PurgeBox_Handler,
+		},
 		{
 			MethodName: "GetBoxByID",
 			Handler:    _BoxService_GetBoxByID_Handler,
@@ -8,6 +8,7 @@ package order_v1
 
 import (
 	abstract "Homework-1/pkg/api/abstract"
+	box_v1 "Homework-1/pkg/api/box_v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -110,18 +111,20 @@ func (x *OrderAllInfo) GetExpiresAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type ReturnedResponse struct {
+type OrderDetails struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderID    int64                  `protobuf:"varint,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
-	ClientID   int64                  `protobuf:"varint,2,opt,name=clientID,proto3" json:"clientID,omitempty"`
-	ReturnedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=returnedAt,proto3" json:"returnedAt,omitempty"`
+	OrderAllInfo *OrderAllInfo          `protobuf:"bytes,1,opt,name=orderAllInfo,proto3" json:"orderAllInfo,omitempty"`
+	Status       string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ReturnedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=returnedAt,proto3" json:"returnedAt,omitempty"`
+	Box          *box_v1.BoxAllInfo     `protobuf:"bytes,4,opt,name=box,proto3" json:"box,omitempty"`
+	CellCode     string                 `protobuf:"bytes,5,opt,name=cellCode,proto3" json:"cellCode,omitempty"`
 }
 
-func (x *ReturnedResponse) Reset() {
-	*x = ReturnedResponse{}
+func (x *OrderDetails) Reset() {
+	*x = OrderDetails{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_order_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -129,13 +132,13 @@ func (x *ReturnedResponse) Reset() {
 	}
 }
 
-func (x *ReturnedResponse) String() string {
+func (x *OrderDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReturnedResponse) ProtoMessage() {}
+func (*OrderDetails) ProtoMessage() {}
 
-func (x *ReturnedResponse) ProtoReflect() protoreflect.Message {
+func (x *OrderDetails) ProtoReflect() protoreflect.Message {
 	mi := &file_order_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -147,43 +150,58 @@ func (x *ReturnedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReturnedResponse.ProtoReflect.Descriptor instead.
-func (*ReturnedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderDetails.ProtoReflect.Descriptor instead.
+func (*OrderDetails) Descriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ReturnedResponse) GetOrderID() int64 {
+func (x *OrderDetails) GetOrderAllInfo() *OrderAllInfo {
 	if x != nil {
-		return x.OrderID
+		return x.OrderAllInfo
 	}
-	return 0
+	return nil
 }
 
-func (x *ReturnedResponse) GetClientID() int64 {
+func (x *OrderDetails) GetStatus() string {
 	if x != nil {
-		return x.ClientID
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *ReturnedResponse) GetReturnedAt() *timestamppb.Timestamp {
+func (x *OrderDetails) GetReturnedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.ReturnedAt
 	}
 	return nil
 }
 
-type UniqueClientListResponse struct {
+func (x *OrderDetails) GetBox() *box_v1.BoxAllInfo {
+	if x != nil {
+		return x.Box
+	}
+	return nil
+}
+
+func (x *OrderDetails) GetCellCode() string {
+	if x != nil {
+		return x.CellCode
+	}
+	return ""
+}
+
+type OrderStatusChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientIDs  []int64              `protobuf:"varint,1,rep,packed,name=clientIDs,proto3" json:"clientIDs,omitempty"`
-	Pagination *abstract.Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	FromStatus string                 `protobuf:"bytes,1,opt,name=fromStatus,proto3" json:"fromStatus,omitempty"`
+	ToStatus   string                 `protobuf:"bytes,2,opt,name=toStatus,proto3" json:"toStatus,omitempty"`
+	ChangedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=changedAt,proto3" json:"changedAt,omitempty"`
 }
 
-func (x *UniqueClientListResponse) Reset() {
-	*x = UniqueClientListResponse{}
+func (x *OrderStatusChange) Reset() {
+	*x = OrderStatusChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_order_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -191,13 +209,13 @@ func (x *UniqueClientListResponse) Reset() {
 	}
 }
 
-func (x *UniqueClientListResponse) String() string {
+func (x *OrderStatusChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UniqueClientListResponse) ProtoMessage() {}
+func (*OrderStatusChange) ProtoMessage() {}
 
-func (x *UniqueClientListResponse) ProtoReflect() protoreflect.Message {
+func (x *OrderStatusChange) ProtoReflect() protoreflect.Message {
 	mi := &file_order_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -209,36 +227,43 @@ func (x *UniqueClientListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UniqueClientListResponse.ProtoReflect.Descriptor instead.
-func (*UniqueClientListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderStatusChange.ProtoReflect.Descriptor instead.
+func (*OrderStatusChange) Descriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *UniqueClientListResponse) GetClientIDs() []int64 {
+func (x *OrderStatusChange) GetFromStatus() string {
 	if x != nil {
-		return x.ClientIDs
+		return x.FromStatus
 	}
-	return nil
+	return ""
 }
 
-func (x *UniqueClientListResponse) GetPagination() *abstract.Pagination {
+func (x *OrderStatusChange) GetToStatus() string {
 	if x != nil {
-		return x.Pagination
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
 	}
 	return nil
 }
 
-type ReturnedListResponse struct {
+type OrderHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReturnedResponse []*ReturnedResponse  `protobuf:"bytes,1,rep,name=returnedResponse,proto3" json:"returnedResponse,omitempty"`
-	Pagination       *abstract.Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	OrderID       int64                `protobuf:"varint,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	StatusChanges []*OrderStatusChange `protobuf:"bytes,2,rep,name=statusChanges,proto3" json:"statusChanges,omitempty"`
 }
 
-func (x *ReturnedListResponse) Reset() {
-	*x = ReturnedListResponse{}
+func (x *OrderHistoryResponse) Reset() {
+	*x = OrderHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_order_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -246,13 +271,13 @@ func (x *ReturnedListResponse) Reset() {
 	}
 }
 
-func (x *ReturnedListResponse) String() string {
+func (x *OrderHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReturnedListResponse) ProtoMessage() {}
+func (*OrderHistoryResponse) ProtoMessage() {}
 
-func (x *ReturnedListResponse) ProtoReflect() protoreflect.Message {
+func (x *OrderHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -264,36 +289,37 @@ func (x *ReturnedListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReturnedListResponse.ProtoReflect.Descriptor instead.
-func (*ReturnedListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderHistoryResponse.ProtoReflect.Descriptor instead.
+func (*OrderHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ReturnedListResponse) GetReturnedResponse() []*ReturnedResponse {
+func (x *OrderHistoryResponse) GetOrderID() int64 {
 	if x != nil {
-		return x.ReturnedResponse
+		return x.OrderID
 	}
-	return nil
+	return 0
 }
 
-func (x *ReturnedListResponse) GetPagination() *abstract.Pagination {
+func (x *OrderHistoryResponse) GetStatusChanges() []*OrderStatusChange {
 	if x != nil {
-		return x.Pagination
+		return x.StatusChanges
 	}
 	return nil
 }
 
-type OrderListResponse struct {
+type ReturnedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderAllInfo []*OrderAllInfo      `protobuf:"bytes,1,rep,name=orderAllInfo,proto3" json:"orderAllInfo,omitempty"`
-	Pagination   *abstract.Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	OrderID    int64                  `protobuf:"varint,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	ClientID   int64                  `protobuf:"varint,2,opt,name=clientID,proto3" json:"clientID,omitempty"`
+	ReturnedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=returnedAt,proto3" json:"returnedAt,omitempty"`
 }
 
-func (x *OrderListResponse) Reset() {
-	*x = OrderListResponse{}
+func (x *ReturnedResponse) Reset() {
+	*x = ReturnedResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_order_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -301,13 +327,13 @@ func (x *OrderListResponse) Reset() {
 	}
 }
 
-func (x *OrderListResponse) String() string {
+func (x *ReturnedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderListResponse) ProtoMessage() {}
+func (*ReturnedResponse) ProtoMessage() {}
 
-func (x *OrderListResponse) ProtoReflect() protoreflect.Message {
+func (x *ReturnedResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -319,35 +345,43 @@ func (x *OrderListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderListResponse.ProtoReflect.Descriptor instead.
-func (*OrderListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReturnedResponse.ProtoReflect.Descriptor instead.
+func (*ReturnedResponse) Descriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *OrderListResponse) GetOrderAllInfo() []*OrderAllInfo {
+func (x *ReturnedResponse) GetOrderID() int64 {
 	if x != nil {
-		return x.OrderAllInfo
+		return x.OrderID
 	}
-	return nil
+	return 0
 }
 
-func (x *OrderListResponse) GetPagination() *abstract.Pagination {
+func (x *ReturnedResponse) GetClientID() int64 {
 	if x != nil {
-		return x.Pagination
+		return x.ClientID
+	}
+	return 0
+}
+
+func (x *ReturnedResponse) GetReturnedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReturnedAt
 	}
 	return nil
 }
 
-type IssueOrderRequest struct {
+type UniqueClientListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderIDRequest []*OrderIDRequest `protobuf:"bytes,1,rep,name=orderIDRequest,proto3" json:"orderIDRequest,omitempty"`
+	ClientIDs  []int64              `protobuf:"varint,1,rep,packed,name=clientIDs,proto3" json:"clientIDs,omitempty"`
+	Pagination *abstract.Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *IssueOrderRequest) Reset() {
-	*x = IssueOrderRequest{}
+func (x *UniqueClientListResponse) Reset() {
+	*x = UniqueClientListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_order_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -355,13 +389,13 @@ func (x *IssueOrderRequest) Reset() {
 	}
 }
 
-func (x *IssueOrderRequest) String() string {
+func (x *UniqueClientListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueOrderRequest) ProtoMessage() {}
+func (*UniqueClientListResponse) ProtoMessage() {}
 
-func (x *IssueOrderRequest) ProtoReflect() protoreflect.Message {
+func (x *UniqueClientListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -373,29 +407,36 @@ func (x *IssueOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueOrderRequest.ProtoReflect.Descriptor instead.
-func (*IssueOrderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UniqueClientListResponse.ProtoReflect.Descriptor instead.
+func (*UniqueClientListResponse) Descriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *IssueOrderRequest) GetOrderIDRequest() []*OrderIDRequest {
+func (x *UniqueClientListResponse) GetClientIDs() []int64 {
 	if x != nil {
-		return x.OrderIDRequest
+		return x.ClientIDs
 	}
 	return nil
 }
 
-type RequestWithClientID struct {
+func (x *UniqueClientListResponse) GetPagination() *abstract.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ReturnedListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderID  int64 `protobuf:"varint,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
-	ClientID int64 `protobuf:"varint,2,opt,name=clientID,proto3" json:"clientID,omitempty"`
+	ReturnedResponse []*ReturnedResponse  `protobuf:"bytes,1,rep,name=returnedResponse,proto3" json:"returnedResponse,omitempty"`
+	Pagination       *abstract.Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *RequestWithClientID) Reset() {
-	*x = RequestWithClientID{}
+func (x *ReturnedListResponse) Reset() {
+	*x = ReturnedListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_order_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -403,13 +444,13 @@ func (x *RequestWithClientID) Reset() {
 	}
 }
 
-func (x *RequestWithClientID) String() string {
+func (x *ReturnedListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestWithClientID) ProtoMessage() {}
+func (*ReturnedListResponse) ProtoMessage() {}
 
-func (x *RequestWithClientID) ProtoReflect() protoreflect.Message {
+func (x *ReturnedListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -421,35 +462,36 @@ func (x *RequestWithClientID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestWithClientID.ProtoReflect.Descriptor instead.
-func (*RequestWithClientID) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReturnedListResponse.ProtoReflect.Descriptor instead.
+func (*ReturnedListResponse) Descriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *RequestWithClientID) GetOrderID() int64 {
+func (x *ReturnedListResponse) GetReturnedResponse() []*ReturnedResponse {
 	if x != nil {
-		return x.OrderID
+		return x.ReturnedResponse
 	}
-	return 0
+	return nil
 }
 
-func (x *RequestWithClientID) GetClientID() int64 {
+func (x *ReturnedListResponse) GetPagination() *abstract.Pagination {
 	if x != nil {
-		return x.ClientID
+		return x.Pagination
 	}
-	return 0
+	return nil
 }
 
-type OrderIDRequest struct {
+type OrderListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderID int64 `protobuf:"varint,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	OrderAllInfo []*OrderAllInfo      `protobuf:"bytes,1,rep,name=orderAllInfo,proto3" json:"orderAllInfo,omitempty"`
+	Pagination   *abstract.Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *OrderIDRequest) Reset() {
-	*x = OrderIDRequest{}
+func (x *OrderListResponse) Reset() {
+	*x = OrderListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_order_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -457,13 +499,13 @@ func (x *OrderIDRequest) Reset() {
 	}
 }
 
-func (x *OrderIDRequest) String() string {
+func (x *OrderListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderIDRequest) ProtoMessage() {}
+func (*OrderListResponse) ProtoMessage() {}
 
-func (x *OrderIDRequest) ProtoReflect() protoreflect.Message {
+func (x *OrderListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -475,29 +517,37 @@ func (x *OrderIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderIDRequest.ProtoReflect.Descriptor instead.
-func (*OrderIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderListResponse.ProtoReflect.Descriptor instead.
+func (*OrderListResponse) Descriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *OrderIDRequest) GetOrderID() int64 {
+func (x *OrderListResponse) GetOrderAllInfo() []*OrderAllInfo {
 	if x != nil {
-		return x.OrderID
+		return x.OrderAllInfo
 	}
-	return 0
+	return nil
 }
 
-type OrderCreateRequest struct {
+func (x *OrderListResponse) GetPagination() *abstract.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type OrderListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Order              *Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
-	ExpireTimeDuration int64  `protobuf:"varint,2,opt,name=expireTimeDuration,proto3" json:"expireTimeDuration,omitempty"`
+	PvzID  int64          `protobuf:"varint,1,opt,name=pvzID,proto3" json:"pvzID,omitempty"`
+	Page   *abstract.Page `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	Filter *OrderFilter   `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *OrderCreateRequest) Reset() {
-	*x = OrderCreateRequest{}
+func (x *OrderListRequest) Reset() {
+	*x = OrderListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_order_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -505,13 +555,13 @@ func (x *OrderCreateRequest) Reset() {
 	}
 }
 
-func (x *OrderCreateRequest) String() string {
+func (x *OrderListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderCreateRequest) ProtoMessage() {}
+func (*OrderListRequest) ProtoMessage() {}
 
-func (x *OrderCreateRequest) ProtoReflect() protoreflect.Message {
+func (x *OrderListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -523,38 +573,56 @@ func (x *OrderCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderCreateRequest.ProtoReflect.Descriptor instead.
-func (*OrderCreateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderListRequest.ProtoReflect.Descriptor instead.
+func (*OrderListRequest) Descriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *OrderCreateRequest) GetOrder() *Order {
+func (x *OrderListRequest) GetPvzID() int64 {
 	if x != nil {
-		return x.Order
+		return x.PvzID
+	}
+	return 0
+}
+
+func (x *OrderListRequest) GetPage() *abstract.Page {
+	if x != nil {
+		return x.Page
 	}
 	return nil
 }
 
-func (x *OrderCreateRequest) GetExpireTimeDuration() int64 {
+func (x *OrderListRequest) GetFilter() *OrderFilter {
 	if x != nil {
-		return x.ExpireTimeDuration
+		return x.Filter
 	}
-	return 0
+	return nil
 }
 
-type Order struct {
+// OrderFilter narrows down list requests, zero values are ignored.
+// UniqueClientList applies the conditions but not the sorting.
+type OrderFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderID  int64   `protobuf:"varint,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
-	ClientID int64   `protobuf:"varint,2,opt,name=clientID,proto3" json:"clientID,omitempty"`
-	Weight   float64 `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
-	BoxID    int64   `protobuf:"varint,4,opt,name=boxID,proto3" json:"boxID,omitempty"`
-}
-
-func (x *Order) Reset() {
-	*x = Order{}
+	ClientID    int64                  `protobuf:"varint,1,opt,name=clientID,proto3" json:"clientID,omitempty"`
+	BoxID       int64                  `protobuf:"varint,2,opt,name=boxID,proto3" json:"boxID,omitempty"`
+	Status      string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdFrom,proto3" json:"createdFrom,omitempty"`
+	CreatedTo   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdTo,proto3" json:"createdTo,omitempty"`
+	ExpiresFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expiresFrom,proto3" json:"expiresFrom,omitempty"`
+	ExpiresTo   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expiresTo,proto3" json:"expiresTo,omitempty"`
+	MinWeight   float64                `protobuf:"fixed64,8,opt,name=minWeight,proto3" json:"minWeight,omitempty"`
+	MaxWeight   float64                `protobuf:"fixed64,9,opt,name=maxWeight,proto3" json:"maxWeight,omitempty"`
+	// sortBy is one of created_at, expires_at, weight, order_id, client_id
+	SortBy string `protobuf:"bytes,10,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
+	// sortDirection is asc or desc, desc by default
+	SortDirection string `protobuf:"bytes,11,opt,name=sortDirection,proto3" json:"sortDirection,omitempty"`
+}
+
+func (x *OrderFilter) Reset() {
+	*x = OrderFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_order_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -562,13 +630,13 @@ func (x *Order) Reset() {
 	}
 }
 
-func (x *Order) String() string {
+func (x *OrderFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*OrderFilter) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
+func (x *OrderFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_order_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -580,138 +648,1387 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderFilter.ProtoReflect.Descriptor instead.
+func (*OrderFilter) Descriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Order) GetOrderID() int64 {
+func (x *OrderFilter) GetClientID() int64 {
 	if x != nil {
-		return x.OrderID
+		return x.ClientID
 	}
 	return 0
 }
 
-func (x *Order) GetClientID() int64 {
+func (x *OrderFilter) GetBoxID() int64 {
 	if x != nil {
-		return x.ClientID
+		return x.BoxID
 	}
 	return 0
 }
 
-func (x *Order) GetWeight() float64 {
+func (x *OrderFilter) GetStatus() string {
 	if x != nil {
-		return x.Weight
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *Order) GetBoxID() int64 {
+func (x *OrderFilter) GetCreatedFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BoxID
+		return x.CreatedFrom
 	}
-	return 0
+	return nil
 }
 
-var File_order_proto protoreflect.FileDescriptor
+func (x *OrderFilter) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
 
-var file_order_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x61,
-	0x62, 0x73, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x02, 0x0a,
-	0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a,
-	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x3a, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x69,
-	0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x84, 0x01,
-	0x0a, 0x10, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x3a, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0x65, 0x0a, 0x18, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x12, 0x2b,
+func (x *OrderFilter) GetExpiresFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresFrom
+	}
+	return nil
+}
+
+func (x *OrderFilter) GetExpiresTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresTo
+	}
+	return nil
+}
+
+func (x *OrderFilter) GetMinWeight() float64 {
+	if x != nil {
+		return x.MinWeight
+	}
+	return 0
+}
+
+func (x *OrderFilter) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *OrderFilter) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *OrderFilter) GetSortDirection() string {
+	if x != nil {
+		return x.SortDirection
+	}
+	return ""
+}
+
+type IssueOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderIDRequest []*OrderIDRequest `protobuf:"bytes,1,rep,name=orderIDRequest,proto3" json:"orderIDRequest,omitempty"`
+	PvzID          int64             `protobuf:"varint,2,opt,name=pvzID,proto3" json:"pvzID,omitempty"`
+}
+
+func (x *IssueOrderRequest) Reset() {
+	*x = IssueOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueOrderRequest) ProtoMessage() {}
+
+func (x *IssueOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueOrderRequest.ProtoReflect.Descriptor instead.
+func (*IssueOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *IssueOrderRequest) GetOrderIDRequest() []*OrderIDRequest {
+	if x != nil {
+		return x.OrderIDRequest
+	}
+	return nil
+}
+
+func (x *IssueOrderRequest) GetPvzID() int64 {
+	if x != nil {
+		return x.PvzID
+	}
+	return 0
+}
+
+type IssueOrderLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID int64  `protobuf:"varint,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	BoxID   int64  `protobuf:"varint,2,opt,name=boxID,proto3" json:"boxID,omitempty"`
+	BoxName string `protobuf:"bytes,3,opt,name=boxName,proto3" json:"boxName,omitempty"`
+	// Deprecated: Marked as deprecated in order.proto.
+	PackagingCost float64 `protobuf:"fixed64,4,opt,name=packagingCost,proto3" json:"packagingCost,omitempty"`
+	Weight        float64 `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Deprecated: Marked as deprecated in order.proto.
+	WeightCost float64 `protobuf:"fixed64,6,opt,name=weightCost,proto3" json:"weightCost,omitempty"`
+	// Deprecated: Marked as deprecated in order.proto.
+	StorageFee float64 `protobuf:"fixed64,7,opt,name=storageFee,proto3" json:"storageFee,omitempty"`
+	// Deprecated: Marked as deprecated in order.proto.
+	Cost                float64           `protobuf:"fixed64,8,opt,name=cost,proto3" json:"cost,omitempty"`
+	PackagingCostAmount *abstract.Money   `protobuf:"bytes,9,opt,name=packagingCostAmount,proto3" json:"packagingCostAmount,omitempty"`
+	WeightCostAmount    *abstract.Money   `protobuf:"bytes,10,opt,name=weightCostAmount,proto3" json:"weightCostAmount,omitempty"`
+	StorageFeeAmount    *abstract.Money   `protobuf:"bytes,11,opt,name=storageFeeAmount,proto3" json:"storageFeeAmount,omitempty"`
+	CostAmount          *abstract.Money   `protobuf:"bytes,12,opt,name=costAmount,proto3" json:"costAmount,omitempty"`
+	Packaging           []*PackagingLayer `protobuf:"bytes,13,rep,name=packaging,proto3" json:"packaging,omitempty"`
+	// chargeableWeight is the larger of the weight and the volumetric weight, weightCost is charged for it
+	ChargeableWeight float64 `protobuf:"fixed64,14,opt,name=chargeableWeight,proto3" json:"chargeableWeight,omitempty"`
+}
+
+func (x *IssueOrderLine) Reset() {
+	*x = IssueOrderLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueOrderLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueOrderLine) ProtoMessage() {}
+
+func (x *IssueOrderLine) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueOrderLine.ProtoReflect.Descriptor instead.
+func (*IssueOrderLine) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *IssueOrderLine) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+func (x *IssueOrderLine) GetBoxID() int64 {
+	if x != nil {
+		return x.BoxID
+	}
+	return 0
+}
+
+func (x *IssueOrderLine) GetBoxName() string {
+	if x != nil {
+		return x.BoxName
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *IssueOrderLine) GetPackagingCost() float64 {
+	if x != nil {
+		return x.PackagingCost
+	}
+	return 0
+}
+
+func (x *IssueOrderLine) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *IssueOrderLine) GetWeightCost() float64 {
+	if x != nil {
+		return x.WeightCost
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *IssueOrderLine) GetStorageFee() float64 {
+	if x != nil {
+		return x.StorageFee
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *IssueOrderLine) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *IssueOrderLine) GetPackagingCostAmount() *abstract.Money {
+	if x != nil {
+		return x.PackagingCostAmount
+	}
+	return nil
+}
+
+func (x *IssueOrderLine) GetWeightCostAmount() *abstract.Money {
+	if x != nil {
+		return x.WeightCostAmount
+	}
+	return nil
+}
+
+func (x *IssueOrderLine) GetStorageFeeAmount() *abstract.Money {
+	if x != nil {
+		return x.StorageFeeAmount
+	}
+	return nil
+}
+
+func (x *IssueOrderLine) GetCostAmount() *abstract.Money {
+	if x != nil {
+		return x.CostAmount
+	}
+	return nil
+}
+
+func (x *IssueOrderLine) GetPackaging() []*PackagingLayer {
+	if x != nil {
+		return x.Packaging
+	}
+	return nil
+}
+
+func (x *IssueOrderLine) GetChargeableWeight() float64 {
+	if x != nil {
+		return x.ChargeableWeight
+	}
+	return 0
+}
+
+// PackagingLayer is the cost of a single packaging layer, packagingCost is the sum of the layers
+type PackagingLayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoxID   int64           `protobuf:"varint,1,opt,name=boxID,proto3" json:"boxID,omitempty"`
+	BoxName string          `protobuf:"bytes,2,opt,name=boxName,proto3" json:"boxName,omitempty"`
+	Cost    *abstract.Money `protobuf:"bytes,3,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (x *PackagingLayer) Reset() {
+	*x = PackagingLayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackagingLayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackagingLayer) ProtoMessage() {}
+
+func (x *PackagingLayer) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackagingLayer.ProtoReflect.Descriptor instead.
+func (*PackagingLayer) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PackagingLayer) GetBoxID() int64 {
+	if x != nil {
+		return x.BoxID
+	}
+	return 0
+}
+
+func (x *PackagingLayer) GetBoxName() string {
+	if x != nil {
+		return x.BoxName
+	}
+	return ""
+}
+
+func (x *PackagingLayer) GetCost() *abstract.Money {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+type IssueOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientID int64             `protobuf:"varint,1,opt,name=clientID,proto3" json:"clientID,omitempty"`
+	Lines    []*IssueOrderLine `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	// Deprecated: Marked as deprecated in order.proto.
+	TotalCost float64                `protobuf:"fixed64,3,opt,name=totalCost,proto3" json:"totalCost,omitempty"`
+	IssuedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	// Deprecated: Marked as deprecated in order.proto.
+	Discount        float64         `protobuf:"fixed64,5,opt,name=discount,proto3" json:"discount,omitempty"`
+	TotalCostAmount *abstract.Money `protobuf:"bytes,6,opt,name=totalCostAmount,proto3" json:"totalCostAmount,omitempty"`
+	DiscountAmount  *abstract.Money `protobuf:"bytes,7,opt,name=discountAmount,proto3" json:"discountAmount,omitempty"`
+}
+
+func (x *IssueOrderResponse) Reset() {
+	*x = IssueOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueOrderResponse) ProtoMessage() {}
+
+func (x *IssueOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueOrderResponse.ProtoReflect.Descriptor instead.
+func (*IssueOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *IssueOrderResponse) GetClientID() int64 {
+	if x != nil {
+		return x.ClientID
+	}
+	return 0
+}
+
+func (x *IssueOrderResponse) GetLines() []*IssueOrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *IssueOrderResponse) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *IssueOrderResponse) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *IssueOrderResponse) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *IssueOrderResponse) GetTotalCostAmount() *abstract.Money {
+	if x != nil {
+		return x.TotalCostAmount
+	}
+	return nil
+}
+
+func (x *IssueOrderResponse) GetDiscountAmount() *abstract.Money {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return nil
+}
+
+type IssueProblem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID int64 `protobuf:"varint,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	// code is one of not_found, wrong_client, expired, invalid_status
+	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *IssueProblem) Reset() {
+	*x = IssueProblem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueProblem) ProtoMessage() {}
+
+func (x *IssueProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueProblem.ProtoReflect.Descriptor instead.
+func (*IssueProblem) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *IssueProblem) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+func (x *IssueProblem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *IssueProblem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type QuoteIssueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientID int64             `protobuf:"varint,1,opt,name=clientID,proto3" json:"clientID,omitempty"`
+	Lines    []*IssueOrderLine `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	// Deprecated: Marked as deprecated in order.proto.
+	TotalCost float64         `protobuf:"fixed64,3,opt,name=totalCost,proto3" json:"totalCost,omitempty"`
+	Problems  []*IssueProblem `protobuf:"bytes,4,rep,name=problems,proto3" json:"problems,omitempty"`
+	// Deprecated: Marked as deprecated in order.proto.
+	Discount        float64         `protobuf:"fixed64,5,opt,name=discount,proto3" json:"discount,omitempty"`
+	TotalCostAmount *abstract.Money `protobuf:"bytes,6,opt,name=totalCostAmount,proto3" json:"totalCostAmount,omitempty"`
+	DiscountAmount  *abstract.Money `protobuf:"bytes,7,opt,name=discountAmount,proto3" json:"discountAmount,omitempty"`
+}
+
+func (x *QuoteIssueResponse) Reset() {
+	*x = QuoteIssueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuoteIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteIssueResponse) ProtoMessage() {}
+
+func (x *QuoteIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteIssueResponse.ProtoReflect.Descriptor instead.
+func (*QuoteIssueResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QuoteIssueResponse) GetClientID() int64 {
+	if x != nil {
+		return x.ClientID
+	}
+	return 0
+}
+
+func (x *QuoteIssueResponse) GetLines() []*IssueOrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *QuoteIssueResponse) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *QuoteIssueResponse) GetProblems() []*IssueProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *QuoteIssueResponse) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *QuoteIssueResponse) GetTotalCostAmount() *abstract.Money {
+	if x != nil {
+		return x.TotalCostAmount
+	}
+	return nil
+}
+
+func (x *QuoteIssueResponse) GetDiscountAmount() *abstract.Money {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return nil
+}
+
+type RequestWithClientID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID  int64 `protobuf:"varint,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	ClientID int64 `protobuf:"varint,2,opt,name=clientID,proto3" json:"clientID,omitempty"`
+	PvzID    int64 `protobuf:"varint,3,opt,name=pvzID,proto3" json:"pvzID,omitempty"`
+}
+
+func (x *RequestWithClientID) Reset() {
+	*x = RequestWithClientID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestWithClientID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestWithClientID) ProtoMessage() {}
+
+func (x *RequestWithClientID) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestWithClientID.ProtoReflect.Descriptor instead.
+func (*RequestWithClientID) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RequestWithClientID) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+func (x *RequestWithClientID) GetClientID() int64 {
+	if x != nil {
+		return x.ClientID
+	}
+	return 0
+}
+
+func (x *RequestWithClientID) GetPvzID() int64 {
+	if x != nil {
+		return x.PvzID
+	}
+	return 0
+}
+
+type OrderIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID int64 `protobuf:"varint,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	PvzID   int64 `protobuf:"varint,2,opt,name=pvzID,proto3" json:"pvzID,omitempty"`
+}
+
+func (x *OrderIDRequest) Reset() {
+	*x = OrderIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderIDRequest) ProtoMessage() {}
+
+func (x *OrderIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderIDRequest.ProtoReflect.Descriptor instead.
+func (*OrderIDRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OrderIDRequest) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+func (x *OrderIDRequest) GetPvzID() int64 {
+	if x != nil {
+		return x.PvzID
+	}
+	return 0
+}
+
+type OrderCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order              *Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	ExpireTimeDuration int64  `protobuf:"varint,2,opt,name=expireTimeDuration,proto3" json:"expireTimeDuration,omitempty"`
+}
+
+func (x *OrderCreateRequest) Reset() {
+	*x = OrderCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCreateRequest) ProtoMessage() {}
+
+func (x *OrderCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCreateRequest.ProtoReflect.Descriptor instead.
+func (*OrderCreateRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OrderCreateRequest) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *OrderCreateRequest) GetExpireTimeDuration() int64 {
+	if x != nil {
+		return x.ExpireTimeDuration
+	}
+	return 0
+}
+
+// ReceiveOrdersBatchRequest is a single manifest line,
+// strict is set for the whole batch when any line sets it
+type ReceiveOrdersBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line *OrderCreateRequest `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	// strict receives nothing when any line fails, otherwise the failed lines are skipped
+	Strict bool `protobuf:"varint,2,opt,name=strict,proto3" json:"strict,omitempty"`
+}
+
+func (x *ReceiveOrdersBatchRequest) Reset() {
+	*x = ReceiveOrdersBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiveOrdersBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveOrdersBatchRequest) ProtoMessage() {}
+
+func (x *ReceiveOrdersBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveOrdersBatchRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveOrdersBatchRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReceiveOrdersBatchRequest) GetLine() *OrderCreateRequest {
+	if x != nil {
+		return x.Line
+	}
+	return nil
+}
+
+func (x *ReceiveOrdersBatchRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+type ReceiveOrderResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// line is the position of the line in the manifest starting from 1
+	Line    int64 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	OrderID int64 `protobuf:"varint,2,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	// code is one of received, invalid, duplicate_line, already_exists, pvz_not_found, box_not_found, box_archived,
+	// packaging_not_compatible, does_not_fit, rejected_by_tariff, not_processed, internal
+	Code    string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ReceiveOrderResult) Reset() {
+	*x = ReceiveOrderResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiveOrderResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveOrderResult) ProtoMessage() {}
+
+func (x *ReceiveOrderResult) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveOrderResult.ProtoReflect.Descriptor instead.
+func (*ReceiveOrderResult) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReceiveOrderResult) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ReceiveOrderResult) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+func (x *ReceiveOrderResult) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReceiveOrderResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ReceiveOrdersBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results  []*ReceiveOrderResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Received int64                 `protobuf:"varint,2,opt,name=received,proto3" json:"received,omitempty"`
+	Failed   int64                 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *ReceiveOrdersBatchResponse) Reset() {
+	*x = ReceiveOrdersBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiveOrdersBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveOrdersBatchResponse) ProtoMessage() {}
+
+func (x *ReceiveOrdersBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveOrdersBatchResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveOrdersBatchResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReceiveOrdersBatchResponse) GetResults() []*ReceiveOrderResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ReceiveOrdersBatchResponse) GetReceived() int64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *ReceiveOrdersBatchResponse) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type Order struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID  int64   `protobuf:"varint,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	ClientID int64   `protobuf:"varint,2,opt,name=clientID,proto3" json:"clientID,omitempty"`
+	Weight   float64 `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// boxID is the outer packaging layer, it is taken from packaging when packaging is set
+	BoxID int64 `protobuf:"varint,4,opt,name=boxID,proto3" json:"boxID,omitempty"`
+	PvzID int64 `protobuf:"varint,5,opt,name=pvzID,proto3" json:"pvzID,omitempty"`
+	// packaging is the list of box ids from the innermost layer to the outermost one,
+	// an order without packaging is packed into boxID only
+	Packaging  []int64              `protobuf:"varint,6,rep,packed,name=packaging,proto3" json:"packaging,omitempty"`
+	Dimensions *abstract.Dimensions `protobuf:"bytes,7,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Order) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+func (x *Order) GetClientID() int64 {
+	if x != nil {
+		return x.ClientID
+	}
+	return 0
+}
+
+func (x *Order) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Order) GetBoxID() int64 {
+	if x != nil {
+		return x.BoxID
+	}
+	return 0
+}
+
+func (x *Order) GetPvzID() int64 {
+	if x != nil {
+		return x.PvzID
+	}
+	return 0
+}
+
+func (x *Order) GetPackaging() []int64 {
+	if x != nil {
+		return x.Packaging
+	}
+	return nil
+}
+
+func (x *Order) GetDimensions() *abstract.Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+var File_order_proto protoreflect.FileDescriptor
+
+var file_order_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x61,
+	0x62, 0x73, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x09, 0x62,
+	0x6f, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x02, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38,
+	0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xd0, 0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x31, 0x0a, 0x0c, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1d, 0x0a, 0x03, 0x62, 0x6f, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x42, 0x6f, 0x78, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x62, 0x6f, 0x78, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x65, 0x6c, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x65, 0x6c, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x11,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6a, 0x0a, 0x14, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x0d, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x3a,
+	0x0a, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22, 0x65, 0x0a, 0x18, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x73, 0x12, 0x2b, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x82, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x10, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x10, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0c, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b,
 	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x82, 0x01, 0x0a, 0x14,
-	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x10, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x10, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x73, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6c,
-	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x50,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x11, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0e, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x57, 0x69,
-	0x74, 0x68, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x22, 0x2a, 0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0x62, 0x0a, 0x12,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x69, 0x0a, 0x10, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x70, 0x76, 0x7a, 0x49, 0x44, 0x12, 0x19, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x24, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xc1, 0x03, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x38,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x3c, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x54, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x54, 0x6f,
+	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f,
+	0x72, 0x74, 0x42, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x72,
+	0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x11, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x37, 0x0a, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x76, 0x7a, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x22, 0xa1,
+	0x04, 0x0a, 0x0e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x6f, 0x78, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x6f, 0x78, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x62, 0x6f, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a,
+	0x0a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6f, 0x73,
+	0x74, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x46, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x38, 0x0a,
+	0x13, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x13, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73,
+	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x10, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x43, 0x6f, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x10, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x43, 0x6f, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x10, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x10, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x63, 0x6f, 0x73,
+	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x09, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x61, 0x62, 0x6c, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x10, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x5c, 0x0a, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x4c,
+	0x61, 0x79, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f,
+	0x78, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6f, 0x78,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74,
+	0x22, 0xb3, 0x02, 0x0a, 0x12, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c,
+	0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73,
+	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x0c, 0x49, 0x73, 0x73, 0x75, 0x65, 0x50,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa6,
+	0x02, 0x0a, 0x12, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x12, 0x25, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e,
+	0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73,
+	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x61, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x22, 0x40, 0x0a, 0x0e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x22, 0x62, 0x0a, 0x12,
 	0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
 	0x12, 0x2e, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x44, 0x75,
 	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x65, 0x78,
 	0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x6b, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
-	0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x78, 0x49, 0x44,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x32, 0xca, 0x04,
-	0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53,
-	0x0a, 0x0c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x13,
-	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a,
-	0x22, 0x11, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x12, 0x4e, 0x0a, 0x0a, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x12, 0x12, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a,
-	0x01, 0x2a, 0x1a, 0x0f, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x31, 0x2f, 0x69, 0x73,
-	0x73, 0x75, 0x65, 0x12, 0x4c, 0x0a, 0x0e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x05, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x1a, 0x15, 0x2e, 0x52,
+	0x22, 0x5c, 0x0a, 0x19, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0x70,
+	0x0a, 0x12, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x7f, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x22, 0xcc, 0x01, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x78,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x6f, 0x78, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x70, 0x76, 0x7a, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x32, 0xf0, 0x07, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x53, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x13, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
+	0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x31, 0x2f, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x12, 0x73, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1a, 0x2e, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a,
+	0x22, 0x17, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x28, 0x01, 0x12, 0x51, 0x0a, 0x0a, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x1a, 0x0f, 0x2f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x31, 0x2f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x12, 0x57,
+	0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x12, 0x2e, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a,
+	0x22, 0x15, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x31, 0x2f, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x58, 0x0a, 0x0e, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x11, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x52,
 	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11,
 	0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
@@ -725,20 +2042,33 @@ var file_order_proto_rawDesc = []byte{
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x2a,
 	0x1b, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x31, 0x2f, 0x74, 0x75, 0x72, 0x6e, 0x5f,
-	0x69, 0x6e, 0x2f, 0x7b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x41, 0x0a, 0x09,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x05, 0x2e, 0x50, 0x61, 0x67, 0x65,
-	0x1a, 0x12, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22,
-	0x0e, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12,
-	0x59, 0x0a, 0x10, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x05, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e, 0x55, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a,
-	0x22, 0x18, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x31, 0x2f, 0x75, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x24, 0x5a, 0x22, 0x63, 0x72,
-	0x75, 0x64, 0x2d, 0x70, 0x76, 0x7a, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x2f, 0x7b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x4d, 0x0a, 0x09,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x10, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x11, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x76, 0x31, 0x2f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79,
+	0x49, 0x44, 0x12, 0x0f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x7b, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x44, 0x7d, 0x12, 0x5e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76,
+	0x31, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x7b, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x44, 0x7d, 0x42, 0x24, 0x5a, 0x22, 0x63, 0x72, 0x75, 0x64, 0x2d, 0x70, 0x76, 0x7a, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x31,
+	0x3b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -753,57 +2083,109 @@ func file_order_proto_rawDescGZIP() []byte {
 	return file_order_proto_rawDescData
 }
 
-var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_order_proto_goTypes = []interface{}{
-	(*OrderAllInfo)(nil),             // 0: OrderAllInfo
-	(*ReturnedResponse)(nil),         // 1: ReturnedResponse
-	(*UniqueClientListResponse)(nil), // 2: UniqueClientListResponse
-	(*ReturnedListResponse)(nil),     // 3: ReturnedListResponse
-	(*OrderListResponse)(nil),        // 4: OrderListResponse
-	(*IssueOrderRequest)(nil),        // 5: IssueOrderRequest
-	(*RequestWithClientID)(nil),      // 6: RequestWithClientID
-	(*OrderIDRequest)(nil),           // 7: OrderIDRequest
-	(*OrderCreateRequest)(nil),       // 8: OrderCreateRequest
-	(*Order)(nil),                    // 9: Order
-	(*timestamppb.Timestamp)(nil),    // 10: google.protobuf.Timestamp
-	(*abstract.Pagination)(nil),      // 11: Pagination
-	(*abstract.Page)(nil),            // 12: Page
-	(*abstract.MessageResponse)(nil), // 13: MessageResponse
+	(*OrderAllInfo)(nil),               // 0: OrderAllInfo
+	(*OrderDetails)(nil),               // 1: OrderDetails
+	(*OrderStatusChange)(nil),          // 2: OrderStatusChange
+	(*OrderHistoryResponse)(nil),       // 3: OrderHistoryResponse
+	(*ReturnedResponse)(nil),           // 4: ReturnedResponse
+	(*UniqueClientListResponse)(nil),   // 5: UniqueClientListResponse
+	(*ReturnedListResponse)(nil),       // 6: ReturnedListResponse
+	(*OrderListResponse)(nil),          // 7: OrderListResponse
+	(*OrderListRequest)(nil),           // 8: OrderListRequest
+	(*OrderFilter)(nil),                // 9: OrderFilter
+	(*IssueOrderRequest)(nil),          // 10: IssueOrderRequest
+	(*IssueOrderLine)(nil),             // 11: IssueOrderLine
+	(*PackagingLayer)(nil),             // 12: PackagingLayer
+	(*IssueOrderResponse)(nil),         // 13: IssueOrderResponse
+	(*IssueProblem)(nil),               // 14: IssueProblem
+	(*QuoteIssueResponse)(nil),         // 15: QuoteIssueResponse
+	(*RequestWithClientID)(nil),        // 16: RequestWithClientID
+	(*OrderIDRequest)(nil),             // 17: OrderIDRequest
+	(*OrderCreateRequest)(nil),         // 18: OrderCreateRequest
+	(*ReceiveOrdersBatchRequest)(nil),  // 19: ReceiveOrdersBatchRequest
+	(*ReceiveOrderResult)(nil),         // 20: ReceiveOrderResult
+	(*ReceiveOrdersBatchResponse)(nil), // 21: ReceiveOrdersBatchResponse
+	(*Order)(nil),                      // 22: Order
+	(*timestamppb.Timestamp)(nil),      // 23: google.protobuf.Timestamp
+	(*box_v1.BoxAllInfo)(nil),          // 24: BoxAllInfo
+	(*abstract.Pagination)(nil),        // 25: Pagination
+	(*abstract.Page)(nil),              // 26: Page
+	(*abstract.Money)(nil),             // 27: Money
+	(*abstract.Dimensions)(nil),        // 28: Dimensions
+	(*abstract.MessageResponse)(nil),   // 29: MessageResponse
 }
 var file_order_proto_depIdxs = []int32{
-	9,  // 0: OrderAllInfo.order:type_name -> Order
-	10, // 1: OrderAllInfo.createdAt:type_name -> google.protobuf.Timestamp
-	10, // 2: OrderAllInfo.updatedAt:type_name -> google.protobuf.Timestamp
-	10, // 3: OrderAllInfo.acceptedAt:type_name -> google.protobuf.Timestamp
-	10, // 4: OrderAllInfo.issuedAt:type_name -> google.protobuf.Timestamp
-	10, // 5: OrderAllInfo.expiresAt:type_name -> google.protobuf.Timestamp
-	10, // 6: ReturnedResponse.returnedAt:type_name -> google.protobuf.Timestamp
-	11, // 7: UniqueClientListResponse.pagination:type_name -> Pagination
-	1,  // 8: ReturnedListResponse.returnedResponse:type_name -> ReturnedResponse
-	11, // 9: ReturnedListResponse.pagination:type_name -> Pagination
-	0,  // 10: OrderListResponse.orderAllInfo:type_name -> OrderAllInfo
-	11, // 11: OrderListResponse.pagination:type_name -> Pagination
-	7,  // 12: IssueOrderRequest.orderIDRequest:type_name -> OrderIDRequest
-	9,  // 13: OrderCreateRequest.order:type_name -> Order
-	8,  // 14: OrderService.ReceiveOrder:input_type -> OrderCreateRequest
-	5,  // 15: OrderService.IssueOrder:input_type -> IssueOrderRequest
-	12, // 16: OrderService.ReturnedOrders:input_type -> Page
-	6,  // 17: OrderService.AcceptOrder:input_type -> RequestWithClientID
-	7,  // 18: OrderService.TurnInOrder:input_type -> OrderIDRequest
-	12, // 19: OrderService.OrderList:input_type -> Page
-	12, // 20: OrderService.UniqueClientList:input_type -> Page
-	13, // 21: OrderService.ReceiveOrder:output_type -> MessageResponse
-	13, // 22: OrderService.IssueOrder:output_type -> MessageResponse
-	3,  // 23: OrderService.ReturnedOrders:output_type -> ReturnedListResponse
-	13, // 24: OrderService.AcceptOrder:output_type -> MessageResponse
-	13, // 25: OrderService.TurnInOrder:output_type -> MessageResponse
-	4,  // 26: OrderService.OrderList:output_type -> OrderListResponse
-	2,  // 27: OrderService.UniqueClientList:output_type -> UniqueClientListResponse
-	21, // [21:28] is the sub-list for method output_type
-	14, // [14:21] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	22, // 0: OrderAllInfo.order:type_name -> Order
+	23, // 1: OrderAllInfo.createdAt:type_name -> google.protobuf.Timestamp
+	23, // 2: OrderAllInfo.updatedAt:type_name -> google.protobuf.Timestamp
+	23, // 3: OrderAllInfo.acceptedAt:type_name -> google.protobuf.Timestamp
+	23, // 4: OrderAllInfo.issuedAt:type_name -> google.protobuf.Timestamp
+	23, // 5: OrderAllInfo.expiresAt:type_name -> google.protobuf.Timestamp
+	0,  // 6: OrderDetails.orderAllInfo:type_name -> OrderAllInfo
+	23, // 7: OrderDetails.returnedAt:type_name -> google.protobuf.Timestamp
+	24, // 8: OrderDetails.box:type_name -> BoxAllInfo
+	23, // 9: OrderStatusChange.changedAt:type_name -> google.protobuf.Timestamp
+	2,  // 10: OrderHistoryResponse.statusChanges:type_name -> OrderStatusChange
+	23, // 11: ReturnedResponse.returnedAt:type_name -> google.protobuf.Timestamp
+	25, // 12: UniqueClientListResponse.pagination:type_name -> Pagination
+	4,  // 13: ReturnedListResponse.returnedResponse:type_name -> ReturnedResponse
+	25, // 14: ReturnedListResponse.pagination:type_name -> Pagination
+	0,  // 15: OrderListResponse.orderAllInfo:type_name -> OrderAllInfo
+	25, // 16: OrderListResponse.pagination:type_name -> Pagination
+	26, // 17: OrderListRequest.page:type_name -> Page
+	9,  // 18: OrderListRequest.filter:type_name -> OrderFilter
+	23, // 19: OrderFilter.createdFrom:type_name -> google.protobuf.Timestamp
+	23, // 20: OrderFilter.createdTo:type_name -> google.protobuf.Timestamp
+	23, // 21: OrderFilter.expiresFrom:type_name -> google.protobuf.Timestamp
+	23, // 22: OrderFilter.expiresTo:type_name -> google.protobuf.Timestamp
+	17, // 23: IssueOrderRequest.orderIDRequest:type_name -> OrderIDRequest
+	27, // 24: IssueOrderLine.packagingCostAmount:type_name -> Money
+	27, // 25: IssueOrderLine.weightCostAmount:type_name -> Money
+	27, // 26: IssueOrderLine.storageFeeAmount:type_name -> Money
+	27, // 27: IssueOrderLine.costAmount:type_name -> Money
+	12, // 28: IssueOrderLine.packaging:type_name -> PackagingLayer
+	27, // 29: PackagingLayer.cost:type_name -> Money
+	11, // 30: IssueOrderResponse.lines:type_name -> IssueOrderLine
+	23, // 31: IssueOrderResponse.issuedAt:type_name -> google.protobuf.Timestamp
+	27, // 32: IssueOrderResponse.totalCostAmount:type_name -> Money
+	27, // 33: IssueOrderResponse.discountAmount:type_name -> Money
+	11, // 34: QuoteIssueResponse.lines:type_name -> IssueOrderLine
+	14, // 35: QuoteIssueResponse.problems:type_name -> IssueProblem
+	27, // 36: QuoteIssueResponse.totalCostAmount:type_name -> Money
+	27, // 37: QuoteIssueResponse.discountAmount:type_name -> Money
+	22, // 38: OrderCreateRequest.order:type_name -> Order
+	18, // 39: ReceiveOrdersBatchRequest.line:type_name -> OrderCreateRequest
+	20, // 40: ReceiveOrdersBatchResponse.results:type_name -> ReceiveOrderResult
+	28, // 41: Order.dimensions:type_name -> Dimensions
+	18, // 42: OrderService.ReceiveOrder:input_type -> OrderCreateRequest
+	19, // 43: OrderService.ReceiveOrdersBatch:input_type -> ReceiveOrdersBatchRequest
+	10, // 44: OrderService.IssueOrder:input_type -> IssueOrderRequest
+	10, // 45: OrderService.QuoteIssue:input_type -> IssueOrderRequest
+	8,  // 46: OrderService.ReturnedOrders:input_type -> OrderListRequest
+	16, // 47: OrderService.AcceptOrder:input_type -> RequestWithClientID
+	17, // 48: OrderService.TurnInOrder:input_type -> OrderIDRequest
+	8,  // 49: OrderService.OrderList:input_type -> OrderListRequest
+	8,  // 50: OrderService.UniqueClientList:input_type -> OrderListRequest
+	17, // 51: OrderService.GetOrderByID:input_type -> OrderIDRequest
+	17, // 52: OrderService.GetOrderHistory:input_type -> OrderIDRequest
+	29, // 53: OrderService.ReceiveOrder:output_type -> MessageResponse
+	21, // 54: OrderService.ReceiveOrdersBatch:output_type -> ReceiveOrdersBatchResponse
+	13, // 55: OrderService.IssueOrder:output_type -> IssueOrderResponse
+	15, // 56: OrderService.QuoteIssue:output_type -> QuoteIssueResponse
+	6,  // 57: OrderService.ReturnedOrders:output_type -> ReturnedListResponse
+	29, // 58: OrderService.AcceptOrder:output_type -> MessageResponse
+	29, // 59: OrderService.TurnInOrder:output_type -> MessageResponse
+	7,  // 60: OrderService.OrderList:output_type -> OrderListResponse
+	5,  // 61: OrderService.UniqueClientList:output_type -> UniqueClientListResponse
+	1,  // 62: OrderService.GetOrderByID:output_type -> OrderDetails
+	3,  // 63: OrderService.GetOrderHistory:output_type -> OrderHistoryResponse
+	53, // [53:64] is the sub-list for method output_type
+	42, // [42:53] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_order_proto_init() }
@@ -825,7 +2207,7 @@ func file_order_proto_init() {
 			}
 		}
 		file_order_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReturnedResponse); i {
+			switch v := v.(*OrderDetails); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -837,7 +2219,7 @@ func file_order_proto_init() {
 			}
 		}
 		file_order_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UniqueClientListResponse); i {
+			switch v := v.(*OrderStatusChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -849,7 +2231,7 @@ func file_order_proto_init() {
 			}
 		}
 		file_order_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReturnedListResponse); i {
+			switch v := v.(*OrderHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -861,7 +2243,7 @@ func file_order_proto_init() {
 			}
 		}
 		file_order_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderListResponse); i {
+			switch v := v.(*ReturnedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -873,7 +2255,7 @@ func file_order_proto_init() {
 			}
 		}
 		file_order_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IssueOrderRequest); i {
+			switch v := v.(*UniqueClientListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -885,7 +2267,7 @@ func file_order_proto_init() {
 			}
 		}
 		file_order_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestWithClientID); i {
+			switch v := v.(*ReturnedListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -897,7 +2279,7 @@ func file_order_proto_init() {
 			}
 		}
 		file_order_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderIDRequest); i {
+			switch v := v.(*OrderListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -909,7 +2291,7 @@ func file_order_proto_init() {
 			}
 		}
 		file_order_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderCreateRequest); i {
+			switch v := v.(*OrderListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -921,6 +2303,162 @@ func file_order_proto_init() {
 			}
 		}
 		file_order_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueOrderLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackagingLayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueProblem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteIssueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestWithClientID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiveOrdersBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiveOrderResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiveOrdersBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Order); i {
 			case 0:
 				return &v.state
@@ -939,7 +2477,7 @@ func file_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_order_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
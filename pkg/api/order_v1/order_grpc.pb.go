@@ -20,13 +20,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	OrderService_ReceiveOrder_FullMethodName     = "/OrderService/ReceiveOrder"
-	OrderService_IssueOrder_FullMethodName       = "/OrderService/IssueOrder"
-	OrderService_ReturnedOrders_FullMethodName   = "/OrderService/ReturnedOrders"
-	OrderService_AcceptOrder_FullMethodName      = "/OrderService/AcceptOrder"
-	OrderService_TurnInOrder_FullMethodName      = "/OrderService/TurnInOrder"
-	OrderService_OrderList_FullMethodName        = "/OrderService/OrderList"
-	OrderService_UniqueClientList_FullMethodName = "/OrderService/UniqueClientList"
+	OrderService_ReceiveOrder_FullMethodName       = "/OrderService/ReceiveOrder"
+	OrderService_ReceiveOrdersBatch_FullMethodName = "/OrderService/ReceiveOrdersBatch"
+	OrderService_IssueOrder_FullMethodName         = "/OrderService/IssueOrder"
+	OrderService_QuoteIssue_FullMethodName         = "/OrderService/QuoteIssue"
+	OrderService_ReturnedOrders_FullMethodName     = "/OrderService/ReturnedOrders"
+	OrderService_AcceptOrder_FullMethodName        = "/OrderService/AcceptOrder"
+	OrderService_TurnInOrder_FullMethodName        = "/OrderService/TurnInOrder"
+	OrderService_OrderList_FullMethodName          = "/OrderService/OrderList"
+	OrderService_UniqueClientList_FullMethodName   = "/OrderService/UniqueClientList"
+	OrderService_GetOrderByID_FullMethodName       = "/OrderService/GetOrderByID"
+	OrderService_GetOrderHistory_FullMethodName    = "/OrderService/GetOrderHistory"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -34,12 +38,17 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OrderServiceClient interface {
 	ReceiveOrder(ctx context.Context, in *OrderCreateRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
-	IssueOrder(ctx context.Context, in *IssueOrderRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
-	ReturnedOrders(ctx context.Context, in *abstract.Page, opts ...grpc.CallOption) (*ReturnedListResponse, error)
+	// ReceiveOrdersBatch receives a courier manifest, one order per message
+	ReceiveOrdersBatch(ctx context.Context, opts ...grpc.CallOption) (OrderService_ReceiveOrdersBatchClient, error)
+	IssueOrder(ctx context.Context, in *IssueOrderRequest, opts ...grpc.CallOption) (*IssueOrderResponse, error)
+	QuoteIssue(ctx context.Context, in *IssueOrderRequest, opts ...grpc.CallOption) (*QuoteIssueResponse, error)
+	ReturnedOrders(ctx context.Context, in *OrderListRequest, opts ...grpc.CallOption) (*ReturnedListResponse, error)
 	AcceptOrder(ctx context.Context, in *RequestWithClientID, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
 	TurnInOrder(ctx context.Context, in *OrderIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
-	OrderList(ctx context.Context, in *abstract.Page, opts ...grpc.CallOption) (*OrderListResponse, error)
-	UniqueClientList(ctx context.Context, in *abstract.Page, opts ...grpc.CallOption) (*UniqueClientListResponse, error)
+	OrderList(ctx context.Context, in *OrderListRequest, opts ...grpc.CallOption) (*OrderListResponse, error)
+	UniqueClientList(ctx context.Context, in *OrderListRequest, opts ...grpc.CallOption) (*UniqueClientListResponse, error)
+	GetOrderByID(ctx context.Context, in *OrderIDRequest, opts ...grpc.CallOption) (*OrderDetails, error)
+	GetOrderHistory(ctx context.Context, in *OrderIDRequest, opts ...grpc.CallOption) (*OrderHistoryResponse, error)
 }
 
 type orderServiceClient struct {
@@ -59,8 +68,42 @@ func (c *orderServiceClient) ReceiveOrder(ctx context.Context, in *OrderCreateRe
 	return out, nil
 }
 
-func (c *orderServiceClient) IssueOrder(ctx context.Context, in *IssueOrderRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error) {
-	out := new(abstract.MessageResponse)
+func (c *orderServiceClient) ReceiveOrdersBatch(ctx context.Context, opts ...grpc.CallOption) (OrderService_ReceiveOrdersBatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OrderService_ServiceDesc.Streams[0], OrderService_ReceiveOrdersBatch_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orderServiceReceiveOrdersBatchClient{stream}
+	return x, nil
+}
+
+type OrderService_ReceiveOrdersBatchClient interface {
+	Send(*ReceiveOrdersBatchRequest) error
+	CloseAndRecv() (*ReceiveOrdersBatchResponse, error)
+	grpc.ClientStream
+}
+
+type orderServiceReceiveOrdersBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *orderServiceReceiveOrdersBatchClient) Send(m *ReceiveOrdersBatchRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *orderServiceReceiveOrdersBatchClient) CloseAndRecv() (*ReceiveOrdersBatchResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ReceiveOrdersBatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *orderServiceClient) IssueOrder(ctx context.Context, in *IssueOrderRequest, opts ...grpc.CallOption) (*IssueOrderResponse, error) {
+	out := new(IssueOrderResponse)
 	err := c.cc.Invoke(ctx, OrderService_IssueOrder_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -68,7 +111,16 @@ func (c *orderServiceClient) IssueOrder(ctx context.Context, in *IssueOrderReque
 	return out, nil
 }
 
-func (c *orderServiceClient) ReturnedOrders(ctx context.Context, in *abstract.Page, opts ...grpc.CallOption) (*ReturnedListResponse, error) {
+func (c *orderServiceClient) QuoteIssue(ctx context.Context, in *IssueOrderRequest, opts ...grpc.CallOption) (*QuoteIssueResponse, error) {
+	out := new(QuoteIssueResponse)
+	err := c.cc.Invoke(ctx, OrderService_QuoteIssue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ReturnedOrders(ctx context.Context, in *OrderListRequest, opts ...grpc.CallOption) (*ReturnedListResponse, error) {
 	out := new(ReturnedListResponse)
 	err := c.cc.Invoke(ctx, OrderService_ReturnedOrders_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -95,7 +147,7 @@ func (c *orderServiceClient) TurnInOrder(ctx context.Context, in *OrderIDRequest
 	return out, nil
 }
 
-func (c *orderServiceClient) OrderList(ctx context.Context, in *abstract.Page, opts ...grpc.CallOption) (*OrderListResponse, error) {
+func (c *orderServiceClient) OrderList(ctx context.Context, in *OrderListRequest, opts ...grpc.CallOption) (*OrderListResponse, error) {
 	out := new(OrderListResponse)
 	err := c.cc.Invoke(ctx, OrderService_OrderList_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -104,7 +156,7 @@ func (c *orderServiceClient) OrderList(ctx context.Context, in *abstract.Page, o
 	return out, nil
 }
 
-func (c *orderServiceClient) UniqueClientList(ctx context.Context, in *abstract.Page, opts ...grpc.CallOption) (*UniqueClientListResponse, error) {
+func (c *orderServiceClient) UniqueClientList(ctx context.Context, in *OrderListRequest, opts ...grpc.CallOption) (*UniqueClientListResponse, error) {
 	out := new(UniqueClientListResponse)
 	err := c.cc.Invoke(ctx, OrderService_UniqueClientList_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -113,17 +165,40 @@ func (c *orderServiceClient) UniqueClientList(ctx context.Context, in *abstract.
 	return out, nil
 }
 
+func (c *orderServiceClient) GetOrderByID(ctx context.Context, in *OrderIDRequest, opts ...grpc.CallOption) (*OrderDetails, error) {
+	out := new(OrderDetails)
+	err := c.cc.Invoke(ctx, OrderService_GetOrderByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrderHistory(ctx context.Context, in *OrderIDRequest, opts ...grpc.CallOption) (*OrderHistoryResponse, error) {
+	out := new(OrderHistoryResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetOrderHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility
 type OrderServiceServer interface {
 	ReceiveOrder(context.Context, *OrderCreateRequest) (*abstract.MessageResponse, error)
-	IssueOrder(context.Context, *IssueOrderRequest) (*abstract.MessageResponse, error)
-	ReturnedOrders(context.Context, *abstract.Page) (*ReturnedListResponse, error)
+	// ReceiveOrdersBatch receives a courier manifest, one order per message
+	ReceiveOrdersBatch(OrderService_ReceiveOrdersBatchServer) error
+	IssueOrder(context.Context, *IssueOrderRequest) (*IssueOrderResponse, error)
+	QuoteIssue(context.Context, *IssueOrderRequest) (*QuoteIssueResponse, error)
+	ReturnedOrders(context.Context, *OrderListRequest) (*ReturnedListResponse, error)
 	AcceptOrder(context.Context, *RequestWithClientID) (*abstract.MessageResponse, error)
 	TurnInOrder(context.Context, *OrderIDRequest) (*abstract.MessageResponse, error)
-	OrderList(context.Context, *abstract.Page) (*OrderListResponse, error)
-	UniqueClientList(context.Context, *abstract.Page) (*UniqueClientListResponse, error)
+	OrderList(context.Context, *OrderListRequest) (*OrderListResponse, error)
+	UniqueClientList(context.Context, *OrderListRequest) (*UniqueClientListResponse, error)
+	GetOrderByID(context.Context, *OrderIDRequest) (*OrderDetails, error)
+	GetOrderHistory(context.Context, *OrderIDRequest) (*OrderHistoryResponse, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -134,10 +209,16 @@ type UnimplementedOrderServiceServer struct {
 func (UnimplementedOrderServiceServer) ReceiveOrder(context.Context, *OrderCreateRequest) (*abstract.MessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReceiveOrder not implemented")
 }
-func (UnimplementedOrderServiceServer) IssueOrder(context.Context, *IssueOrderRequest) (*abstract.MessageResponse, error) {
+func (UnimplementedOrderServiceServer) ReceiveOrdersBatch(OrderService_ReceiveOrdersBatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReceiveOrdersBatch not implemented")
+}
+func (UnimplementedOrderServiceServer) IssueOrder(context.Context, *IssueOrderRequest) (*IssueOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IssueOrder not implemented")
 }
-func (UnimplementedOrderServiceServer) ReturnedOrders(context.Context, *abstract.Page) (*ReturnedListResponse, error) {
+func (UnimplementedOrderServiceServer) QuoteIssue(context.Context, *IssueOrderRequest) (*QuoteIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteIssue not implemented")
+}
+func (UnimplementedOrderServiceServer) ReturnedOrders(context.Context, *OrderListRequest) (*ReturnedListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReturnedOrders not implemented")
 }
 func (UnimplementedOrderServiceServer) AcceptOrder(context.Context, *RequestWithClientID) (*abstract.MessageResponse, error) {
@@ -146,12 +227,18 @@ func (UnimplementedOrderServiceServer) AcceptOrder(context.Context, *RequestWith
 func (UnimplementedOrderServiceServer) TurnInOrder(context.Context, *OrderIDRequest) (*abstract.MessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TurnInOrder not implemented")
 }
-func (UnimplementedOrderServiceServer) OrderList(context.Context, *abstract.Page) (*OrderListResponse, error) {
+func (UnimplementedOrderServiceServer) OrderList(context.Context, *OrderListRequest) (*OrderListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OrderList not implemented")
 }
-func (UnimplementedOrderServiceServer) UniqueClientList(context.Context, *abstract.Page) (*UniqueClientListResponse, error) {
+func (UnimplementedOrderServiceServer) UniqueClientList(context.Context, *OrderListRequest) (*UniqueClientListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UniqueClientList not implemented")
 }
+func (UnimplementedOrderServiceServer) GetOrderByID(context.Context, *OrderIDRequest) (*OrderDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderByID not implemented")
+}
+func (UnimplementedOrderServiceServer) GetOrderHistory(context.Context, *OrderIDRequest) (*OrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderHistory not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 
 // UnsafeOrderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -183,6 +270,32 @@ func _OrderService_ReceiveOrder_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_ReceiveOrdersBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OrderServiceServer).ReceiveOrdersBatch(&orderServiceReceiveOrdersBatchServer{stream})
+}
+
+type OrderService_ReceiveOrdersBatchServer interface {
+	SendAndClose(*ReceiveOrdersBatchResponse) error
+	Recv() (*ReceiveOrdersBatchRequest, error)
+	grpc.ServerStream
+}
+
+type orderServiceReceiveOrdersBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *orderServiceReceiveOrdersBatchServer) SendAndClose(m *ReceiveOrdersBatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *orderServiceReceiveOrdersBatchServer) Recv() (*ReceiveOrdersBatchRequest, error) {
+	m := new(ReceiveOrdersBatchRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _OrderService_IssueOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IssueOrderRequest)
 	if err := dec(in); err != nil {
@@ -201,8 +314,26 @@ func _OrderService_IssueOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_QuoteIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).QuoteIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_QuoteIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).QuoteIssue(ctx, req.(*IssueOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_ReturnedOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(abstract.Page)
+	in := new(OrderListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -214,7 +345,7 @@ func _OrderService_ReturnedOrders_Handler(srv interface{}, ctx context.Context,
 		FullMethod: OrderService_ReturnedOrders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).ReturnedOrders(ctx, req.(*abstract.Page))
+		return srv.(OrderServiceServer).ReturnedOrders(ctx, req.(*OrderListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -256,7 +387,7 @@ func _OrderService_TurnInOrder_Handler(srv interface{}, ctx context.Context, dec
 }
 
 func _OrderService_OrderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(abstract.Page)
+	in := new(OrderListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -268,13 +399,13 @@ func _OrderService_OrderList_Handler(srv interface{}, ctx context.Context, dec f
 		FullMethod: OrderService_OrderList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).OrderList(ctx, req.(*abstract.Page))
+		return srv.(OrderServiceServer).OrderList(ctx, req.(*OrderListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _OrderService_UniqueClientList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(abstract.Page)
+	in := new(OrderListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -286,7 +417,43 @@ func _OrderService_UniqueClientList_Handler(srv interface{}, ctx context.Context
 		FullMethod: OrderService_UniqueClientList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).UniqueClientList(ctx, req.(*abstract.Page))
+		return srv.(OrderServiceServer).UniqueClientList(ctx, req.(*OrderListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrderByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderByID(ctx, req.(*OrderIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderHistory(ctx, req.(*OrderIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -306,6 +473,10 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IssueOrder",
 			Handler:    _OrderService_IssueOrder_Handler,
 		},
+		{
+			MethodName: "QuoteIssue",
+			Handler:    _OrderService_QuoteIssue_Handler,
+		},
 		{
 			MethodName: "ReturnedOrders",
 			Handler:    _OrderService_ReturnedOrders_Handler,
@@ -326,7 +497,21 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UniqueClientList",
 			Handler:    _OrderService_UniqueClientList_Handler,
 		},
+		{
+			MethodName: "GetOrderByID",
+			Handler:    _OrderService_GetOrderByID_Handler,
+		},
+		{
+			MethodName: "GetOrderHistory",
+			Handler:    _OrderService_GetOrderHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReceiveOrdersBatch",
+			Handler:       _OrderService_ReceiveOrdersBatch_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "order.proto",
 }
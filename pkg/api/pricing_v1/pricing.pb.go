@@ -0,0 +1,772 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.26.1
+// source: pricing.proto
+
+package pricing_v1
+
+import (
+	abstract "Homework-1/pkg/api/abstract"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WeightBracket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinWeight float64 `protobuf:"fixed64,1,opt,name=minWeight,proto3" json:"minWeight,omitempty"`
+	MaxWeight float64 `protobuf:"fixed64,2,opt,name=maxWeight,proto3" json:"maxWeight,omitempty"`
+	// Deprecated: Marked as deprecated in pricing.proto.
+	Cost       float64         `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	CostAmount *abstract.Money `protobuf:"bytes,4,opt,name=costAmount,proto3" json:"costAmount,omitempty"`
+}
+
+func (x *WeightBracket) Reset() {
+	*x = WeightBracket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pricing_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeightBracket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightBracket) ProtoMessage() {}
+
+func (x *WeightBracket) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightBracket.ProtoReflect.Descriptor instead.
+func (*WeightBracket) Descriptor() ([]byte, []int) {
+	return file_pricing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WeightBracket) GetMinWeight() float64 {
+	if x != nil {
+		return x.MinWeight
+	}
+	return 0
+}
+
+func (x *WeightBracket) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in pricing.proto.
+func (x *WeightBracket) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *WeightBracket) GetCostAmount() *abstract.Money {
+	if x != nil {
+		return x.CostAmount
+	}
+	return nil
+}
+
+type BoxSurcharge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoxID int64 `protobuf:"varint,1,opt,name=boxID,proto3" json:"boxID,omitempty"`
+	// Deprecated: Marked as deprecated in pricing.proto.
+	Surcharge       float64         `protobuf:"fixed64,2,opt,name=surcharge,proto3" json:"surcharge,omitempty"`
+	SurchargeAmount *abstract.Money `protobuf:"bytes,3,opt,name=surchargeAmount,proto3" json:"surchargeAmount,omitempty"`
+}
+
+func (x *BoxSurcharge) Reset() {
+	*x = BoxSurcharge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pricing_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoxSurcharge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoxSurcharge) ProtoMessage() {}
+
+func (x *BoxSurcharge) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoxSurcharge.ProtoReflect.Descriptor instead.
+func (*BoxSurcharge) Descriptor() ([]byte, []int) {
+	return file_pricing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BoxSurcharge) GetBoxID() int64 {
+	if x != nil {
+		return x.BoxID
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in pricing.proto.
+func (x *BoxSurcharge) GetSurcharge() float64 {
+	if x != nil {
+		return x.Surcharge
+	}
+	return 0
+}
+
+func (x *BoxSurcharge) GetSurchargeAmount() *abstract.Money {
+	if x != nil {
+		return x.SurchargeAmount
+	}
+	return nil
+}
+
+type Tariff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	WeightBrackets  []*WeightBracket `protobuf:"bytes,2,rep,name=weightBrackets,proto3" json:"weightBrackets,omitempty"`
+	BoxSurcharges   []*BoxSurcharge  `protobuf:"bytes,3,rep,name=boxSurcharges,proto3" json:"boxSurcharges,omitempty"`
+	FreeStorageDays int64            `protobuf:"varint,4,opt,name=freeStorageDays,proto3" json:"freeStorageDays,omitempty"`
+	// Deprecated: Marked as deprecated in pricing.proto.
+	StorageFeePerDay       float64         `protobuf:"fixed64,5,opt,name=storageFeePerDay,proto3" json:"storageFeePerDay,omitempty"`
+	DiscountPercent        float64         `protobuf:"fixed64,6,opt,name=discountPercent,proto3" json:"discountPercent,omitempty"`
+	DiscountMinOrders      int64           `protobuf:"varint,7,opt,name=discountMinOrders,proto3" json:"discountMinOrders,omitempty"`
+	StorageFeePerDayAmount *abstract.Money `protobuf:"bytes,8,opt,name=storageFeePerDayAmount,proto3" json:"storageFeePerDayAmount,omitempty"`
+}
+
+func (x *Tariff) Reset() {
+	*x = Tariff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pricing_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tariff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tariff) ProtoMessage() {}
+
+func (x *Tariff) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tariff.ProtoReflect.Descriptor instead.
+func (*Tariff) Descriptor() ([]byte, []int) {
+	return file_pricing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Tariff) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tariff) GetWeightBrackets() []*WeightBracket {
+	if x != nil {
+		return x.WeightBrackets
+	}
+	return nil
+}
+
+func (x *Tariff) GetBoxSurcharges() []*BoxSurcharge {
+	if x != nil {
+		return x.BoxSurcharges
+	}
+	return nil
+}
+
+func (x *Tariff) GetFreeStorageDays() int64 {
+	if x != nil {
+		return x.FreeStorageDays
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in pricing.proto.
+func (x *Tariff) GetStorageFeePerDay() float64 {
+	if x != nil {
+		return x.StorageFeePerDay
+	}
+	return 0
+}
+
+func (x *Tariff) GetDiscountPercent() float64 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *Tariff) GetDiscountMinOrders() int64 {
+	if x != nil {
+		return x.DiscountMinOrders
+	}
+	return 0
+}
+
+func (x *Tariff) GetStorageFeePerDayAmount() *abstract.Money {
+	if x != nil {
+		return x.StorageFeePerDayAmount
+	}
+	return nil
+}
+
+type TariffAllInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Tariff    *Tariff                `protobuf:"bytes,2,opt,name=tariff,proto3" json:"tariff,omitempty"`
+	IsActive  bool                   `protobuf:"varint,3,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *TariffAllInfo) Reset() {
+	*x = TariffAllInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pricing_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TariffAllInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffAllInfo) ProtoMessage() {}
+
+func (x *TariffAllInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffAllInfo.ProtoReflect.Descriptor instead.
+func (*TariffAllInfo) Descriptor() ([]byte, []int) {
+	return file_pricing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TariffAllInfo) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *TariffAllInfo) GetTariff() *Tariff {
+	if x != nil {
+		return x.Tariff
+	}
+	return nil
+}
+
+func (x *TariffAllInfo) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *TariffAllInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TariffAllInfo) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type TariffCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tariff *Tariff `protobuf:"bytes,1,opt,name=tariff,proto3" json:"tariff,omitempty"`
+}
+
+func (x *TariffCreateRequest) Reset() {
+	*x = TariffCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pricing_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TariffCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffCreateRequest) ProtoMessage() {}
+
+func (x *TariffCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffCreateRequest.ProtoReflect.Descriptor instead.
+func (*TariffCreateRequest) Descriptor() ([]byte, []int) {
+	return file_pricing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TariffCreateRequest) GetTariff() *Tariff {
+	if x != nil {
+		return x.Tariff
+	}
+	return nil
+}
+
+type TariffIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TariffID int64 `protobuf:"varint,1,opt,name=tariffID,proto3" json:"tariffID,omitempty"`
+}
+
+func (x *TariffIDRequest) Reset() {
+	*x = TariffIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pricing_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TariffIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffIDRequest) ProtoMessage() {}
+
+func (x *TariffIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffIDRequest.ProtoReflect.Descriptor instead.
+func (*TariffIDRequest) Descriptor() ([]byte, []int) {
+	return file_pricing_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TariffIDRequest) GetTariffID() int64 {
+	if x != nil {
+		return x.TariffID
+	}
+	return 0
+}
+
+type TariffListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TariffAllInfo []*TariffAllInfo     `protobuf:"bytes,1,rep,name=tariffAllInfo,proto3" json:"tariffAllInfo,omitempty"`
+	Pagination    *abstract.Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *TariffListResponse) Reset() {
+	*x = TariffListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pricing_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TariffListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffListResponse) ProtoMessage() {}
+
+func (x *TariffListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffListResponse.ProtoReflect.Descriptor instead.
+func (*TariffListResponse) Descriptor() ([]byte, []int) {
+	return file_pricing_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TariffListResponse) GetTariffAllInfo() []*TariffAllInfo {
+	if x != nil {
+		return x.TariffAllInfo
+	}
+	return nil
+}
+
+func (x *TariffListResponse) GetPagination() *abstract.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+var File_pricing_proto protoreflect.FileDescriptor
+
+var file_pricing_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0e, 0x61, 0x62, 0x73, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8b,
+	0x01, 0x0a, 0x0d, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x04,
+	0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04,
+	0x63, 0x6f, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x78, 0x0a, 0x0c,
+	0x42, 0x6f, 0x78, 0x53, 0x75, 0x72, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x6f, 0x78, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x6f, 0x78,
+	0x49, 0x44, 0x12, 0x20, 0x0a, 0x09, 0x73, 0x75, 0x72, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x73, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x0f, 0x73, 0x75, 0x72, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0f, 0x73, 0x75, 0x72, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xfb, 0x02, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x69, 0x66,
+	0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x0e, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x0e, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x33, 0x0a,
+	0x0d, 0x62, 0x6f, 0x78, 0x53, 0x75, 0x72, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x42, 0x6f, 0x78, 0x53, 0x75, 0x72, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x52, 0x0d, 0x62, 0x6f, 0x78, 0x53, 0x75, 0x72, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x72, 0x65, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x44, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x66, 0x72, 0x65,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2e, 0x0a, 0x10,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x28, 0x0a, 0x0f,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x11, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x3e, 0x0a, 0x16, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46,
+	0x65, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x16, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd0, 0x01, 0x0a, 0x0d, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x41,
+	0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x52,
+	0x06, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x36, 0x0a, 0x13, 0x54, 0x61, 0x72, 0x69, 0x66,
+	0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07,
+	0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x52, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x22,
+	0x2d, 0x0a, 0x0f, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x44, 0x22, 0x77,
+	0x0a, 0x12, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x41, 0x6c,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x61,
+	0x72, 0x69, 0x66, 0x66, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x74, 0x61, 0x72,
+	0x69, 0x66, 0x66, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xbf, 0x03, 0x0a, 0x0d, 0x54, 0x61, 0x72, 0x69,
+	0x66, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x12, 0x14, 0x2e, 0x54, 0x61, 0x72, 0x69,
+	0x66, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x70,
+	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x59, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66,
+	0x12, 0x10, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x2a, 0x1d, 0x2f, 0x70,
+	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x2f, 0x7b, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x44, 0x7d, 0x12, 0x5d, 0x0a, 0x0e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x12, 0x10, 0x2e,
+	0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x1a, 0x1f, 0x2f, 0x70, 0x72, 0x69, 0x63,
+	0x69, 0x6e, 0x67, 0x5f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f,
+	0x7b, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x44, 0x7d, 0x12, 0x46, 0x0a, 0x0b, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x73, 0x12, 0x05, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x1a, 0x13, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a,
+	0x22, 0x10, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x31, 0x2f, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x55, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x42,
+	0x79, 0x49, 0x44, 0x12, 0x10, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x41, 0x6c,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f,
+	0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x7b,
+	0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x44, 0x7d, 0x42, 0x28, 0x5a, 0x26, 0x63, 0x72, 0x75,
+	0x64, 0x2d, 0x70, 0x76, 0x7a, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72,
+	0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x31, 0x3b, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
+	0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pricing_proto_rawDescOnce sync.Once
+	file_pricing_proto_rawDescData = file_pricing_proto_rawDesc
+)
+
+func file_pricing_proto_rawDescGZIP() []byte {
+	file_pricing_proto_rawDescOnce.Do(func() {
+		file_pricing_proto_rawDescData = protoimpl.X.CompressGZIP(file_pricing_proto_rawDescData)
+	})
+	return file_pricing_proto_rawDescData
+}
+
+var file_pricing_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_pricing_proto_goTypes = []interface{}{
+	(*WeightBracket)(nil),            // 0: WeightBracket
+	(*BoxSurcharge)(nil),             // 1: BoxSurcharge
+	(*Tariff)(nil),                   // 2: Tariff
+	(*TariffAllInfo)(nil),            // 3: TariffAllInfo
+	(*TariffCreateRequest)(nil),      // 4: TariffCreateRequest
+	(*TariffIDRequest)(nil),          // 5: TariffIDRequest
+	(*TariffListResponse)(nil),       // 6: TariffListResponse
+	(*abstract.Money)(nil),           // 7: Money
+	(*timestamppb.Timestamp)(nil),    // 8: google.protobuf.Timestamp
+	(*abstract.Pagination)(nil),      // 9: Pagination
+	(*abstract.Page)(nil),            // 10: Page
+	(*abstract.MessageResponse)(nil), // 11: MessageResponse
+}
+var file_pricing_proto_depIdxs = []int32{
+	7,  // 0: WeightBracket.costAmount:type_name -> Money
+	7,  // 1: BoxSurcharge.surchargeAmount:type_name -> Money
+	0,  // 2: Tariff.weightBrackets:type_name -> WeightBracket
+	1,  // 3: Tariff.boxSurcharges:type_name -> BoxSurcharge
+	7,  // 4: Tariff.storageFeePerDayAmount:type_name -> Money
+	2,  // 5: TariffAllInfo.tariff:type_name -> Tariff
+	8,  // 6: TariffAllInfo.createdAt:type_name -> google.protobuf.Timestamp
+	8,  // 7: TariffAllInfo.updatedAt:type_name -> google.protobuf.Timestamp
+	2,  // 8: TariffCreateRequest.tariff:type_name -> Tariff
+	3,  // 9: TariffListResponse.tariffAllInfo:type_name -> TariffAllInfo
+	9,  // 10: TariffListResponse.pagination:type_name -> Pagination
+	4,  // 11: TariffService.CreateTariff:input_type -> TariffCreateRequest
+	5,  // 12: TariffService.DeleteTariff:input_type -> TariffIDRequest
+	5,  // 13: TariffService.ActivateTariff:input_type -> TariffIDRequest
+	10, // 14: TariffService.ListTariffs:input_type -> Page
+	5,  // 15: TariffService.GetTariffByID:input_type -> TariffIDRequest
+	11, // 16: TariffService.CreateTariff:output_type -> MessageResponse
+	11, // 17: TariffService.DeleteTariff:output_type -> MessageResponse
+	11, // 18: TariffService.ActivateTariff:output_type -> MessageResponse
+	6,  // 19: TariffService.ListTariffs:output_type -> TariffListResponse
+	3,  // 20: TariffService.GetTariffByID:output_type -> TariffAllInfo
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_pricing_proto_init() }
+func file_pricing_proto_init() {
+	if File_pricing_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pricing_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeightBracket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pricing_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoxSurcharge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pricing_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tariff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pricing_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TariffAllInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pricing_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TariffCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pricing_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TariffIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pricing_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TariffListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pricing_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pricing_proto_goTypes,
+		DependencyIndexes: file_pricing_proto_depIdxs,
+		MessageInfos:      file_pricing_proto_msgTypes,
+	}.Build()
+	File_pricing_proto = out.File
+	file_pricing_proto_rawDesc = nil
+	file_pricing_proto_goTypes = nil
+	file_pricing_proto_depIdxs = nil
+}
@@ -0,0 +1,258 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: pricing.proto
+
+package pricing_v1
+
+import (
+	context "context"
+	abstract "Homework-1/pkg/api/abstract"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	TariffService_CreateTariff_FullMethodName   = "/TariffService/CreateTariff"
+	TariffService_DeleteTariff_FullMethodName   = "/TariffService/DeleteTariff"
+	TariffService_ActivateTariff_FullMethodName = "/TariffService/ActivateTariff"
+	TariffService_ListTariffs_FullMethodName    = "/TariffService/ListTariffs"
+	TariffService_GetTariffByID_FullMethodName  = "/TariffService/GetTariffByID"
+)
+
+// TariffServiceClient is the client API for TariffService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TariffServiceClient interface {
+	CreateTariff(ctx context.Context, in *TariffCreateRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
+	DeleteTariff(ctx context.Context, in *TariffIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
+	ActivateTariff(ctx context.Context, in *TariffIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
+	ListTariffs(ctx context.Context, in *abstract.Page, opts ...grpc.CallOption) (*TariffListResponse, error)
+	GetTariffByID(ctx context.Context, in *TariffIDRequest, opts ...grpc.CallOption) (*TariffAllInfo, error)
+}
+
+type tariffServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTariffServiceClient(cc grpc.ClientConnInterface) TariffServiceClient {
+	return &tariffServiceClient{cc}
+}
+
+func (c *tariffServiceClient) CreateTariff(ctx context.Context, in *TariffCreateRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error) {
+	out := new(abstract.MessageResponse)
+	err := c.cc.Invoke(ctx, TariffService_CreateTariff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tariffServiceClient) DeleteTariff(ctx context.Context, in *TariffIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error) {
+	out := new(abstract.MessageResponse)
+	err := c.cc.Invoke(ctx, TariffService_DeleteTariff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tariffServiceClient) ActivateTariff(ctx context.Context, in *TariffIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error) {
+	out := new(abstract.MessageResponse)
+	err := c.cc.Invoke(ctx, TariffService_ActivateTariff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tariffServiceClient) ListTariffs(ctx context.Context, in *abstract.Page, opts ...grpc.CallOption) (*TariffListResponse, error) {
+	out := new(TariffListResponse)
+	err := c.cc.Invoke(ctx, TariffService_ListTariffs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tariffServiceClient) GetTariffByID(ctx context.Context, in *TariffIDRequest, opts ...grpc.CallOption) (*TariffAllInfo, error) {
+	out := new(TariffAllInfo)
+	err := c.cc.Invoke(ctx, TariffService_GetTariffByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TariffServiceServer is the server API for TariffService service.
+// All implementations must embed UnimplementedTariffServiceServer
+// for forward compatibility
+type TariffServiceServer interface {
+	CreateTariff(context.Context, *TariffCreateRequest) (*abstract.MessageResponse, error)
+	DeleteTariff(context.Context, *TariffIDRequest) (*abstract.MessageResponse, error)
+	ActivateTariff(context.Context, *TariffIDRequest) (*abstract.MessageResponse, error)
+	ListTariffs(context.Context, *abstract.Page) (*TariffListResponse, error)
+	GetTariffByID(context.Context, *TariffIDRequest) (*TariffAllInfo, error)
+	mustEmbedUnimplementedTariffServiceServer()
+}
+
+// UnimplementedTariffServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTariffServiceServer struct {
+}
+
+func (UnimplementedTariffServiceServer) CreateTariff(context.Context, *TariffCreateRequest) (*abstract.MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTariff not implemented")
+}
+func (UnimplementedTariffServiceServer) DeleteTariff(context.Context, *TariffIDRequest) (*abstract.MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTariff not implemented")
+}
+func (UnimplementedTariffServiceServer) ActivateTariff(context.Context, *TariffIDRequest) (*abstract.MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateTariff not implemented")
+}
+func (UnimplementedTariffServiceServer) ListTariffs(context.Context, *abstract.Page) (*TariffListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTariffs not implemented")
+}
+func (UnimplementedTariffServiceServer) GetTariffByID(context.Context, *TariffIDRequest) (*TariffAllInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTariffByID not implemented")
+}
+func (UnimplementedTariffServiceServer) mustEmbedUnimplementedTariffServiceServer() {}
+
+// UnsafeTariffServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TariffServiceServer will
+// result in compilation errors.
+type UnsafeTariffServiceServer interface {
+	mustEmbedUnimplementedTariffServiceServer()
+}
+
+func RegisterTariffServiceServer(s grpc.ServiceRegistrar, srv TariffServiceServer) {
+	s.RegisterService(&TariffService_ServiceDesc, srv)
+}
+
+func _TariffService_CreateTariff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TariffCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TariffServiceServer).CreateTariff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TariffService_CreateTariff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TariffServiceServer).CreateTariff(ctx, req.(*TariffCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TariffService_DeleteTariff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TariffIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TariffServiceServer).DeleteTariff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TariffService_DeleteTariff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TariffServiceServer).DeleteTariff(ctx, req.(*TariffIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TariffService_ActivateTariff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TariffIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TariffServiceServer).ActivateTariff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TariffService_ActivateTariff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TariffServiceServer).ActivateTariff(ctx, req.(*TariffIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TariffService_ListTariffs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(abstract.Page)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TariffServiceServer).ListTariffs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TariffService_ListTariffs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TariffServiceServer).ListTariffs(ctx, req.(*abstract.Page))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TariffService_GetTariffByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TariffIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TariffServiceServer).GetTariffByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TariffService_GetTariffByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TariffServiceServer).GetTariffByID(ctx, req.(*TariffIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TariffService_ServiceDesc is the grpc.ServiceDesc for TariffService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TariffService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "TariffService",
+	HandlerType: (*TariffServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTariff",
+			Handler:    _TariffService_CreateTariff_Handler,
+		},
+		{
+			MethodName: "DeleteTariff",
+			Handler:    _TariffService_DeleteTariff_Handler,
+		},
+		{
+			MethodName: "ActivateTariff",
+			Handler:    _TariffService_ActivateTariff_Handler,
+		},
+		{
+			MethodName: "ListTariffs",
+			Handler:    _TariffService_ListTariffs_Handler,
+		},
+		{
+			MethodName: "GetTariffByID",
+			Handler:    _TariffService_GetTariffByID_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pricing.proto",
+}
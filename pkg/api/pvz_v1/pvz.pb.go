@@ -28,8 +28,9 @@ type UpdateRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID  int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Pvz *PVZ  `protobuf:"bytes,2,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	ID      int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Pvz     *PVZ  `protobuf:"bytes,2,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	Version int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *UpdateRequest) Reset() {
@@ -78,6 +79,13 @@ func (x *UpdateRequest) GetPvz() *PVZ {
 	return nil
 }
 
+func (x *UpdateRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type ListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -90,20 +98,830 @@ type ListResponse struct {
 func (x *ListResponse) Reset() {
 	*x = ListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pvz_proto_msgTypes[1]
+		mi := &file_pvz_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponse) ProtoMessage() {}
+
+func (x *ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListResponse) GetPvzAllInfo() []*PVZAllInfo {
+	if x != nil {
+		return x.PvzAllInfo
+	}
+	return nil
+}
+
+func (x *ListResponse) GetPagination() *abstract.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type PVZCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pvz *PVZ `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+}
+
+func (x *PVZCreateRequest) Reset() {
+	*x = PVZCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pvz_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PVZCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZCreateRequest) ProtoMessage() {}
+
+func (x *PVZCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZCreateRequest.ProtoReflect.Descriptor instead.
+func (*PVZCreateRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PVZCreateRequest) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+type PVZIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PvzID int64 `protobuf:"varint,1,opt,name=pvzID,proto3" json:"pvzID,omitempty"`
+}
+
+func (x *PVZIDRequest) Reset() {
+	*x = PVZIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pvz_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PVZIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZIDRequest) ProtoMessage() {}
+
+func (x *PVZIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZIDRequest.ProtoReflect.Descriptor instead.
+func (*PVZIDRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PVZIDRequest) GetPvzID() int64 {
+	if x != nil {
+		return x.PvzID
+	}
+	return 0
+}
+
+type DeletePVZRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PvzID   int64 `protobuf:"varint,1,opt,name=pvzID,proto3" json:"pvzID,omitempty"`
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *DeletePVZRequest) Reset() {
+	*x = DeletePVZRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pvz_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePVZRequest) ProtoMessage() {}
+
+func (x *DeletePVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePVZRequest.ProtoReflect.Descriptor instead.
+func (*DeletePVZRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeletePVZRequest) GetPvzID() int64 {
+	if x != nil {
+		return x.PvzID
+	}
+	return 0
+}
+
+func (x *DeletePVZRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type PVZ struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name              string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address           string             `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Contact           string             `protobuf:"bytes,3,opt,name=contact,proto3" json:"contact,omitempty"`
+	Timezone          string             `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Schedule          []*WorkingDay      `protobuf:"bytes,5,rep,name=schedule,proto3" json:"schedule,omitempty"`
+	Holidays          []string           `protobuf:"bytes,6,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	Location          *GeoPoint          `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	StructuredAddress *StructuredAddress `protobuf:"bytes,8,opt,name=structuredAddress,proto3" json:"structuredAddress,omitempty"`
+}
+
+func (x *PVZ) Reset() {
+	*x = PVZ{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pvz_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PVZ) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZ) ProtoMessage() {}
+
+func (x *PVZ) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZ.ProtoReflect.Descriptor instead.
+func (*PVZ) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PVZ) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PVZ) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PVZ) GetContact() string {
+	if x != nil {
+		return x.Contact
+	}
+	return ""
+}
+
+func (x *PVZ) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *PVZ) GetSchedule() []*WorkingDay {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *PVZ) GetHolidays() []string {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+func (x *PVZ) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *PVZ) GetStructuredAddress() *StructuredAddress {
+	if x != nil {
+		return x.StructuredAddress
+	}
+	return nil
+}
+
+// StructuredAddress is the address split into its parts, address is made of them when it is not set
+type StructuredAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Country    string `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	City       string `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	Street     string `protobuf:"bytes,3,opt,name=street,proto3" json:"street,omitempty"`
+	Building   string `protobuf:"bytes,4,opt,name=building,proto3" json:"building,omitempty"`
+	PostalCode string `protobuf:"bytes,5,opt,name=postalCode,proto3" json:"postalCode,omitempty"`
+}
+
+func (x *StructuredAddress) Reset() {
+	*x = StructuredAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pvz_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StructuredAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StructuredAddress) ProtoMessage() {}
+
+func (x *StructuredAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StructuredAddress.ProtoReflect.Descriptor instead.
+func (*StructuredAddress) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StructuredAddress) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *StructuredAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *StructuredAddress) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *StructuredAddress) GetBuilding() string {
+	if x != nil {
+		return x.Building
+	}
+	return ""
+}
+
+func (x *StructuredAddress) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+// GeoPoint is a point on the Earth, latitude and longitude are in degrees
+type GeoPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pvz_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GeoPoint) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type WorkingDay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weekday int32  `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	Opens   string `protobuf:"bytes,2,opt,name=opens,proto3" json:"opens,omitempty"`
+	Closes  string `protobuf:"bytes,3,opt,name=closes,proto3" json:"closes,omitempty"`
+}
+
+func (x *WorkingDay) Reset() {
+	*x = WorkingDay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pvz_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkingDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingDay) ProtoMessage() {}
+
+func (x *WorkingDay) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingDay.ProtoReflect.Descriptor instead.
+func (*WorkingDay) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WorkingDay) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *WorkingDay) GetOpens() string {
+	if x != nil {
+		return x.Opens
+	}
+	return ""
+}
+
+func (x *WorkingDay) GetCloses() string {
+	if x != nil {
+		return x.Closes
+	}
+	return ""
+}
+
+type PVZAllInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Pvz       *PVZ                   `protobuf:"bytes,2,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Version   int64                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// deletedAt is set only for the deleted PVZ
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+}
+
+func (x *PVZAllInfo) Reset() {
+	*x = PVZAllInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pvz_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PVZAllInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZAllInfo) ProtoMessage() {}
+
+func (x *PVZAllInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZAllInfo.ProtoReflect.Descriptor instead.
+func (*PVZAllInfo) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PVZAllInfo) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PVZAllInfo) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+func (x *PVZAllInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PVZAllInfo) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PVZAllInfo) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PVZAllInfo) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type CellCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PvzID     int64   `protobuf:"varint,1,opt,name=pvzID,proto3" json:"pvzID,omitempty"`
+	Code      string  `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	MaxWeight float64 `protobuf:"fixed64,3,opt,name=maxWeight,proto3" json:"maxWeight,omitempty"`
+	MaxCount  int64   `protobuf:"varint,4,opt,name=maxCount,proto3" json:"maxCount,omitempty"`
+}
+
+func (x *CellCreateRequest) Reset() {
+	*x = CellCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pvz_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CellCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellCreateRequest) ProtoMessage() {}
+
+func (x *CellCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellCreateRequest.ProtoReflect.Descriptor instead.
+func (*CellCreateRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CellCreateRequest) GetPvzID() int64 {
+	if x != nil {
+		return x.PvzID
+	}
+	return 0
+}
+
+func (x *CellCreateRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CellCreateRequest) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *CellCreateRequest) GetMaxCount() int64 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+type CellIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PvzID int64  `protobuf:"varint,1,opt,name=pvzID,proto3" json:"pvzID,omitempty"`
+	Code  string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *CellIDRequest) Reset() {
+	*x = CellIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pvz_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CellIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellIDRequest) ProtoMessage() {}
+
+func (x *CellIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellIDRequest.ProtoReflect.Descriptor instead.
+func (*CellIDRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CellIDRequest) GetPvzID() int64 {
+	if x != nil {
+		return x.PvzID
+	}
+	return 0
+}
+
+func (x *CellIDRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type CellOccupancy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code        string  `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	MaxWeight   float64 `protobuf:"fixed64,2,opt,name=maxWeight,proto3" json:"maxWeight,omitempty"`
+	MaxCount    int64   `protobuf:"varint,3,opt,name=maxCount,proto3" json:"maxCount,omitempty"`
+	Orders      int64   `protobuf:"varint,4,opt,name=orders,proto3" json:"orders,omitempty"`
+	Weight      float64 `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	Utilization float64 `protobuf:"fixed64,6,opt,name=utilization,proto3" json:"utilization,omitempty"`
+}
+
+func (x *CellOccupancy) Reset() {
+	*x = CellOccupancy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pvz_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CellOccupancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellOccupancy) ProtoMessage() {}
+
+func (x *CellOccupancy) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellOccupancy.ProtoReflect.Descriptor instead.
+func (*CellOccupancy) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CellOccupancy) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CellOccupancy) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *CellOccupancy) GetMaxCount() int64 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+func (x *CellOccupancy) GetOrders() int64 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *CellOccupancy) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CellOccupancy) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+type OccupancyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PvzID       int64            `protobuf:"varint,1,opt,name=pvzID,proto3" json:"pvzID,omitempty"`
+	Cells       []*CellOccupancy `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`
+	Capacity    int64            `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Occupied    int64            `protobuf:"varint,4,opt,name=occupied,proto3" json:"occupied,omitempty"`
+	Utilization float64          `protobuf:"fixed64,5,opt,name=utilization,proto3" json:"utilization,omitempty"`
+}
+
+func (x *OccupancyResponse) Reset() {
+	*x = OccupancyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pvz_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListResponse) String() string {
+func (x *OccupancyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResponse) ProtoMessage() {}
+func (*OccupancyResponse) ProtoMessage() {}
 
-func (x *ListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_proto_msgTypes[1]
+func (x *OccupancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -114,50 +932,73 @@ func (x *ListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
-func (*ListResponse) Descriptor() ([]byte, []int) {
-	return file_pvz_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use OccupancyResponse.ProtoReflect.Descriptor instead.
+func (*OccupancyResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListResponse) GetPvzAllInfo() []*PVZAllInfo {
+func (x *OccupancyResponse) GetPvzID() int64 {
 	if x != nil {
-		return x.PvzAllInfo
+		return x.PvzID
 	}
-	return nil
+	return 0
 }
 
-func (x *ListResponse) GetPagination() *abstract.Pagination {
+func (x *OccupancyResponse) GetCells() []*CellOccupancy {
 	if x != nil {
-		return x.Pagination
+		return x.Cells
 	}
 	return nil
 }
 
-type PVZCreateRequest struct {
+func (x *OccupancyResponse) GetCapacity() int64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *OccupancyResponse) GetOccupied() int64 {
+	if x != nil {
+		return x.Occupied
+	}
+	return 0
+}
+
+func (x *OccupancyResponse) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+type NearbyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pvz *PVZ `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	Point    *GeoPoint      `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	RadiusKm float64        `protobuf:"fixed64,2,opt,name=radiusKm,proto3" json:"radiusKm,omitempty"`
+	Page     *abstract.Page `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 }
 
-func (x *PVZCreateRequest) Reset() {
-	*x = PVZCreateRequest{}
+func (x *NearbyRequest) Reset() {
+	*x = NearbyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pvz_proto_msgTypes[2]
+		mi := &file_pvz_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PVZCreateRequest) String() string {
+func (x *NearbyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PVZCreateRequest) ProtoMessage() {}
+func (*NearbyRequest) ProtoMessage() {}
 
-func (x *PVZCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_proto_msgTypes[2]
+func (x *NearbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -168,43 +1009,58 @@ func (x *PVZCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PVZCreateRequest.ProtoReflect.Descriptor instead.
-func (*PVZCreateRequest) Descriptor() ([]byte, []int) {
-	return file_pvz_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use NearbyRequest.ProtoReflect.Descriptor instead.
+func (*NearbyRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PVZCreateRequest) GetPvz() *PVZ {
+func (x *NearbyRequest) GetPoint() *GeoPoint {
 	if x != nil {
-		return x.Pvz
+		return x.Point
 	}
 	return nil
 }
 
-type PVZIDRequest struct {
+func (x *NearbyRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *NearbyRequest) GetPage() *abstract.Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type NearbyPVZ struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PvzID int64 `protobuf:"varint,1,opt,name=pvzID,proto3" json:"pvzID,omitempty"`
+	PvzAllInfo *PVZAllInfo `protobuf:"bytes,1,opt,name=pvzAllInfo,proto3" json:"pvzAllInfo,omitempty"`
+	DistanceKm float64     `protobuf:"fixed64,2,opt,name=distanceKm,proto3" json:"distanceKm,omitempty"`
 }
 
-func (x *PVZIDRequest) Reset() {
-	*x = PVZIDRequest{}
+func (x *NearbyPVZ) Reset() {
+	*x = NearbyPVZ{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pvz_proto_msgTypes[3]
+		mi := &file_pvz_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PVZIDRequest) String() string {
+func (x *NearbyPVZ) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PVZIDRequest) ProtoMessage() {}
+func (*NearbyPVZ) ProtoMessage() {}
 
-func (x *PVZIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_proto_msgTypes[3]
+func (x *NearbyPVZ) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,45 +1071,51 @@ func (x *PVZIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PVZIDRequest.ProtoReflect.Descriptor instead.
-func (*PVZIDRequest) Descriptor() ([]byte, []int) {
-	return file_pvz_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use NearbyPVZ.ProtoReflect.Descriptor instead.
+func (*NearbyPVZ) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *PVZIDRequest) GetPvzID() int64 {
+func (x *NearbyPVZ) GetPvzAllInfo() *PVZAllInfo {
 	if x != nil {
-		return x.PvzID
+		return x.PvzAllInfo
+	}
+	return nil
+}
+
+func (x *NearbyPVZ) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
 	}
 	return 0
 }
 
-type PVZ struct {
+type NearbyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Contact string `protobuf:"bytes,3,opt,name=contact,proto3" json:"contact,omitempty"`
+	NearbyPVZ  []*NearbyPVZ         `protobuf:"bytes,1,rep,name=nearbyPVZ,proto3" json:"nearbyPVZ,omitempty"`
+	Pagination *abstract.Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *PVZ) Reset() {
-	*x = PVZ{}
+func (x *NearbyResponse) Reset() {
+	*x = NearbyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pvz_proto_msgTypes[4]
+		mi := &file_pvz_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PVZ) String() string {
+func (x *NearbyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PVZ) ProtoMessage() {}
+func (*NearbyResponse) ProtoMessage() {}
 
-func (x *PVZ) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_proto_msgTypes[4]
+func (x *NearbyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,60 +1126,54 @@ func (x *PVZ) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PVZ.ProtoReflect.Descriptor instead.
-func (*PVZ) Descriptor() ([]byte, []int) {
-	return file_pvz_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *PVZ) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use NearbyResponse.ProtoReflect.Descriptor instead.
+func (*NearbyResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *PVZ) GetAddress() string {
+func (x *NearbyResponse) GetNearbyPVZ() []*NearbyPVZ {
 	if x != nil {
-		return x.Address
+		return x.NearbyPVZ
 	}
-	return ""
+	return nil
 }
 
-func (x *PVZ) GetContact() string {
+func (x *NearbyResponse) GetPagination() *abstract.Pagination {
 	if x != nil {
-		return x.Contact
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-type PVZAllInfo struct {
+// SearchRequest finds the PVZ in the city whose name or address match the query,
+// the best matches come first. Empty fields are ignored
+type SearchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Pvz       *PVZ                   `protobuf:"bytes,2,opt,name=pvz,proto3" json:"pvz,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	City  string         `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Query string         `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Page  *abstract.Page `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 }
 
-func (x *PVZAllInfo) Reset() {
-	*x = PVZAllInfo{}
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pvz_proto_msgTypes[5]
+		mi := &file_pvz_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PVZAllInfo) String() string {
+func (x *SearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PVZAllInfo) ProtoMessage() {}
+func (*SearchRequest) ProtoMessage() {}
 
-func (x *PVZAllInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_proto_msgTypes[5]
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -328,35 +1184,28 @@ func (x *PVZAllInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PVZAllInfo.ProtoReflect.Descriptor instead.
-func (*PVZAllInfo) Descriptor() ([]byte, []int) {
-	return file_pvz_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *PVZAllInfo) GetID() int64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *PVZAllInfo) GetPvz() *PVZ {
+func (x *SearchRequest) GetCity() string {
 	if x != nil {
-		return x.Pvz
+		return x.City
 	}
-	return nil
+	return ""
 }
 
-func (x *PVZAllInfo) GetCreatedAt() *timestamppb.Timestamp {
+func (x *SearchRequest) GetQuery() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Query
 	}
-	return nil
+	return ""
 }
 
-func (x *PVZAllInfo) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *SearchRequest) GetPage() *abstract.Page {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Page
 	}
 	return nil
 }
@@ -369,61 +1218,202 @@ var file_pvz_proto_rawDesc = []byte{
 	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x37, 0x0a, 0x0d, 0x55, 0x70,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x0d, 0x55, 0x70,
 	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
 	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x03, 0x70,
 	0x76, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x50, 0x56, 0x5a, 0x52, 0x03,
-	0x70, 0x76, 0x7a, 0x22, 0x68, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x70, 0x76, 0x7a, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66,
-	0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x50, 0x56, 0x5a, 0x41, 0x6c, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x76, 0x7a, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x2b, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a,
-	0x10, 0x50, 0x56, 0x5a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x03, 0x70, 0x76, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04,
-	0x2e, 0x50, 0x56, 0x5a, 0x52, 0x03, 0x70, 0x76, 0x7a, 0x22, 0x24, 0x0a, 0x0c, 0x50, 0x56, 0x5a,
-	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x76, 0x7a,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x22,
-	0x4d, 0x0a, 0x03, 0x50, 0x56, 0x5a, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0xa8,
-	0x01, 0x0a, 0x0a, 0x50, 0x56, 0x5a, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a,
-	0x03, 0x70, 0x76, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x50, 0x56, 0x5a,
-	0x52, 0x03, 0x70, 0x76, 0x7a, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x32, 0xf2, 0x02, 0x0a, 0x0a, 0x50, 0x56,
-	0x5a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x56, 0x5a, 0x12, 0x11, 0x2e, 0x50, 0x56, 0x5a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x70, 0x76, 0x7a, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x68, 0x0a,
+	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
+	0x0a, 0x70, 0x76, 0x7a, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x50, 0x56, 0x5a, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a,
+	0x70, 0x76, 0x7a, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x10, 0x50, 0x56, 0x5a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x03, 0x70,
+	0x76, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x50, 0x56, 0x5a, 0x52, 0x03,
+	0x70, 0x76, 0x7a, 0x22, 0x24, 0x0a, 0x0c, 0x50, 0x56, 0x5a, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x22, 0x42, 0x0a, 0x10, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x56, 0x5a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x76,
+	0x7a, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x97, 0x02,
+	0x0a, 0x03, 0x50, 0x56, 0x5a, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x79, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x12, 0x25,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x11, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72,
+	0x65, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0x44, 0x0a, 0x08, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x54, 0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x44, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x70,
+	0x65, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x22, 0xfc, 0x01, 0x0a, 0x0a,
+	0x50, 0x56, 0x5a, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x03, 0x70, 0x76,
+	0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x50, 0x56, 0x5a, 0x52, 0x03, 0x70,
+	0x76, 0x7a, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x38, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x77, 0x0a, 0x11, 0x43, 0x65,
+	0x6c, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x70, 0x76, 0x7a, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61,
+	0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x39, 0x0a, 0x0d, 0x43, 0x65, 0x6c, 0x6c, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xaf,
+	0x01, 0x0a, 0x0d, 0x43, 0x65, 0x6c, 0x6c, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xa9, 0x01, 0x0a, 0x11, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x05,
+	0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x43, 0x65,
+	0x6c, 0x6c, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x05, 0x63, 0x65, 0x6c,
+	0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x69, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x74,
+	0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x67, 0x0a, 0x0d,
+	0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x47,
+	0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4b, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4b, 0x6d, 0x12, 0x19, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x09, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x50,
+	0x56, 0x5a, 0x12, 0x2b, 0x0a, 0x0a, 0x70, 0x76, 0x7a, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x50, 0x56, 0x5a, 0x41, 0x6c, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x76, 0x7a, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x22,
+	0x67, 0x0a, 0x0e, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x09, 0x6e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x50, 0x56, 0x5a, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x50, 0x56, 0x5a,
+	0x52, 0x09, 0x6e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x50, 0x56, 0x5a, 0x12, 0x2b, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x05, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x32, 0xfd,
+	0x07, 0x0a, 0x0a, 0x50, 0x56, 0x5a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a,
+	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x56, 0x5a, 0x12, 0x11, 0x2e, 0x50, 0x56, 0x5a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x70, 0x76, 0x7a,
+	0x5f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x50, 0x56, 0x5a, 0x42, 0x79, 0x49, 0x44, 0x12, 0x0d, 0x2e, 0x50, 0x56, 0x5a, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x50, 0x56, 0x5a, 0x41, 0x6c, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x13, 0x2f, 0x70,
+	0x76, 0x7a, 0x5f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x7b, 0x70, 0x76, 0x7a, 0x49, 0x44,
+	0x7d, 0x12, 0x38, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x56, 0x5a, 0x12, 0x05, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f,
+	0x70, 0x76, 0x7a, 0x5f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x09, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x56, 0x5a, 0x12, 0x0e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x70, 0x76, 0x7a, 0x5f, 0x76, 0x31, 0x2f, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x56, 0x5a, 0x42,
-	0x79, 0x49, 0x44, 0x12, 0x0d, 0x2e, 0x50, 0x56, 0x5a, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x50, 0x56, 0x5a, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22,
-	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x13, 0x2f, 0x70, 0x76, 0x7a, 0x5f, 0x76, 0x31,
-	0x2f, 0x67, 0x65, 0x74, 0x2f, 0x7b, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x7d, 0x12, 0x38, 0x0a, 0x07,
-	0x4c, 0x69, 0x73, 0x74, 0x50, 0x56, 0x5a, 0x12, 0x05, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x1a, 0x0d,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x70, 0x76, 0x7a, 0x5f, 0x76,
-	0x31, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x56, 0x5a, 0x12, 0x0e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a,
-	0x1a, 0x0e, 0x2f, 0x70, 0x76, 0x7a, 0x5f, 0x76, 0x31, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x4c, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x56, 0x5a, 0x12, 0x0d, 0x2e,
-	0x50, 0x56, 0x5a, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f, 0x70, 0x76, 0x7a, 0x5f, 0x76, 0x31, 0x2f,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2f, 0x7b, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x7d, 0x42, 0x20,
+	0x02, 0x13, 0x3a, 0x01, 0x2a, 0x1a, 0x0e, 0x2f, 0x70, 0x76, 0x7a, 0x5f, 0x76, 0x31, 0x2f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x56, 0x5a, 0x12, 0x11, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x56, 0x5a, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a,
+	0x16, 0x2f, 0x70, 0x76, 0x7a, 0x5f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2f,
+	0x7b, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x7d, 0x12, 0x47, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x56, 0x5a, 0x12, 0x05, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x1a, 0x0d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x70, 0x76, 0x7a,
+	0x5f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x2f, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x4e, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x56, 0x5a, 0x12, 0x0d,
+	0x2e, 0x50, 0x56, 0x5a, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x1a, 0x17, 0x2f, 0x70, 0x76, 0x7a, 0x5f, 0x76, 0x31,
+	0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x7b, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x7d,
+	0x12, 0x4a, 0x0a, 0x08, 0x50, 0x75, 0x72, 0x67, 0x65, 0x50, 0x56, 0x5a, 0x12, 0x0d, 0x2e, 0x50,
+	0x56, 0x5a, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x2a, 0x15, 0x2f, 0x70, 0x76, 0x7a, 0x5f, 0x76, 0x31, 0x2f, 0x70,
+	0x75, 0x72, 0x67, 0x65, 0x2f, 0x7b, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x7d, 0x12, 0x55, 0x0a, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x56, 0x5a, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x12, 0x2e,
+	0x43, 0x65, 0x6c, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13,
+	0x2f, 0x70, 0x76, 0x7a, 0x5f, 0x76, 0x31, 0x2f, 0x63, 0x65, 0x6c, 0x6c, 0x2f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x5d, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x56, 0x5a,
+	0x43, 0x65, 0x6c, 0x6c, 0x12, 0x0e, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x2a, 0x22,
+	0x2f, 0x70, 0x76, 0x7a, 0x5f, 0x76, 0x31, 0x2f, 0x63, 0x65, 0x6c, 0x6c, 0x2f, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x2f, 0x7b, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x7d, 0x2f, 0x7b, 0x63, 0x6f, 0x64,
+	0x65, 0x7d, 0x12, 0x54, 0x0a, 0x0c, 0x50, 0x56, 0x5a, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e,
+	0x63, 0x79, 0x12, 0x0d, 0x2e, 0x50, 0x56, 0x5a, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x12, 0x2e, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f,
+	0x70, 0x76, 0x7a, 0x5f, 0x76, 0x31, 0x2f, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79,
+	0x2f, 0x7b, 0x70, 0x76, 0x7a, 0x49, 0x44, 0x7d, 0x12, 0x4d, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x50, 0x56, 0x5a, 0x12, 0x0e, 0x2e, 0x4e, 0x65,
+	0x61, 0x72, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x4e, 0x65,
+	0x61, 0x72, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x70, 0x76, 0x7a, 0x5f, 0x76, 0x31,
+	0x2f, 0x6e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x12, 0x45, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x56, 0x5a, 0x12, 0x0e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e,
+	0x2f, 0x70, 0x76, 0x7a, 0x5f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x20,
 	0x5a, 0x1e, 0x63, 0x72, 0x75, 0x64, 0x2d, 0x70, 0x76, 0x7a, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
 	0x70, 0x69, 0x2f, 0x70, 0x76, 0x7a, 0x5f, 0x76, 0x31, 0x3b, 0x70, 0x76, 0x7a, 0x5f, 0x76, 0x31,
 	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
@@ -441,42 +1431,81 @@ func file_pvz_proto_rawDescGZIP() []byte {
 	return file_pvz_proto_rawDescData
 }
 
-var file_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_pvz_proto_goTypes = []interface{}{
 	(*UpdateRequest)(nil),            // 0: UpdateRequest
 	(*ListResponse)(nil),             // 1: ListResponse
 	(*PVZCreateRequest)(nil),         // 2: PVZCreateRequest
 	(*PVZIDRequest)(nil),             // 3: PVZIDRequest
-	(*PVZ)(nil),                      // 4: PVZ
-	(*PVZAllInfo)(nil),               // 5: PVZAllInfo
-	(*abstract.Pagination)(nil),      // 6: Pagination
-	(*timestamppb.Timestamp)(nil),    // 7: google.protobuf.Timestamp
-	(*abstract.Page)(nil),            // 8: Page
-	(*abstract.MessageResponse)(nil), // 9: MessageResponse
+	(*DeletePVZRequest)(nil),         // 4: DeletePVZRequest
+	(*PVZ)(nil),                      // 5: PVZ
+	(*StructuredAddress)(nil),        // 6: StructuredAddress
+	(*GeoPoint)(nil),                 // 7: GeoPoint
+	(*WorkingDay)(nil),               // 8: WorkingDay
+	(*PVZAllInfo)(nil),               // 9: PVZAllInfo
+	(*CellCreateRequest)(nil),        // 10: CellCreateRequest
+	(*CellIDRequest)(nil),            // 11: CellIDRequest
+	(*CellOccupancy)(nil),            // 12: CellOccupancy
+	(*OccupancyResponse)(nil),        // 13: OccupancyResponse
+	(*NearbyRequest)(nil),            // 14: NearbyRequest
+	(*NearbyPVZ)(nil),                // 15: NearbyPVZ
+	(*NearbyResponse)(nil),           // 16: NearbyResponse
+	(*SearchRequest)(nil),            // 17: SearchRequest
+	(*abstract.Pagination)(nil),      // 18: Pagination
+	(*timestamppb.Timestamp)(nil),    // 19: google.protobuf.Timestamp
+	(*abstract.Page)(nil),            // 20: Page
+	(*abstract.MessageResponse)(nil), // 21: MessageResponse
 }
 var file_pvz_proto_depIdxs = []int32{
-	4,  // 0: UpdateRequest.pvz:type_name -> PVZ
-	5,  // 1: ListResponse.pvzAllInfo:type_name -> PVZAllInfo
-	6,  // 2: ListResponse.pagination:type_name -> Pagination
-	4,  // 3: PVZCreateRequest.pvz:type_name -> PVZ
-	4,  // 4: PVZAllInfo.pvz:type_name -> PVZ
-	7,  // 5: PVZAllInfo.createdAt:type_name -> google.protobuf.Timestamp
-	7,  // 6: PVZAllInfo.updatedAt:type_name -> google.protobuf.Timestamp
-	2,  // 7: PVZService.CreatePVZ:input_type -> PVZCreateRequest
-	3,  // 8: PVZService.GetPVZByID:input_type -> PVZIDRequest
-	8,  // 9: PVZService.ListPVZ:input_type -> Page
-	0,  // 10: PVZService.UpdatePVZ:input_type -> UpdateRequest
-	3,  // 11: PVZService.DeletePVZ:input_type -> PVZIDRequest
-	9,  // 12: PVZService.CreatePVZ:output_type -> MessageResponse
-	5,  // 13: PVZService.GetPVZByID:output_type -> PVZAllInfo
-	1,  // 14: PVZService.ListPVZ:output_type -> ListResponse
-	9,  // 15: PVZService.UpdatePVZ:output_type -> MessageResponse
-	9,  // 16: PVZService.DeletePVZ:output_type -> MessageResponse
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	5,  // 0: UpdateRequest.pvz:type_name -> PVZ
+	9,  // 1: ListResponse.pvzAllInfo:type_name -> PVZAllInfo
+	18, // 2: ListResponse.pagination:type_name -> Pagination
+	5,  // 3: PVZCreateRequest.pvz:type_name -> PVZ
+	8,  // 4: PVZ.schedule:type_name -> WorkingDay
+	7,  // 5: PVZ.location:type_name -> GeoPoint
+	6,  // 6: PVZ.structuredAddress:type_name -> StructuredAddress
+	5,  // 7: PVZAllInfo.pvz:type_name -> PVZ
+	19, // 8: PVZAllInfo.createdAt:type_name -> google.protobuf.Timestamp
+	19, // 9: PVZAllInfo.updatedAt:type_name -> google.protobuf.Timestamp
+	19, // 10: PVZAllInfo.deletedAt:type_name -> google.protobuf.Timestamp
+	12, // 11: OccupancyResponse.cells:type_name -> CellOccupancy
+	7,  // 12: NearbyRequest.point:type_name -> GeoPoint
+	20, // 13: NearbyRequest.page:type_name -> Page
+	9,  // 14: NearbyPVZ.pvzAllInfo:type_name -> PVZAllInfo
+	15, // 15: NearbyResponse.nearbyPVZ:type_name -> NearbyPVZ
+	18, // 16: NearbyResponse.pagination:type_name -> Pagination
+	20, // 17: SearchRequest.page:type_name -> Page
+	2,  // 18: PVZService.CreatePVZ:input_type -> PVZCreateRequest
+	3,  // 19: PVZService.GetPVZByID:input_type -> PVZIDRequest
+	20, // 20: PVZService.ListPVZ:input_type -> Page
+	0,  // 21: PVZService.UpdatePVZ:input_type -> UpdateRequest
+	4,  // 22: PVZService.DeletePVZ:input_type -> DeletePVZRequest
+	20, // 23: PVZService.ListDeletedPVZ:input_type -> Page
+	3,  // 24: PVZService.RestorePVZ:input_type -> PVZIDRequest
+	3,  // 25: PVZService.PurgePVZ:input_type -> PVZIDRequest
+	10, // 26: PVZService.CreatePVZCell:input_type -> CellCreateRequest
+	11, // 27: PVZService.DeletePVZCell:input_type -> CellIDRequest
+	3,  // 28: PVZService.PVZOccupancy:input_type -> PVZIDRequest
+	14, // 29: PVZService.SearchNearbyPVZ:input_type -> NearbyRequest
+	17, // 30: PVZService.SearchPVZ:input_type -> SearchRequest
+	21, // 31: PVZService.CreatePVZ:output_type -> MessageResponse
+	9,  // 32: PVZService.GetPVZByID:output_type -> PVZAllInfo
+	1,  // 33: PVZService.ListPVZ:output_type -> ListResponse
+	21, // 34: PVZService.UpdatePVZ:output_type -> MessageResponse
+	21, // 35: PVZService.DeletePVZ:output_type -> MessageResponse
+	1,  // 36: PVZService.ListDeletedPVZ:output_type -> ListResponse
+	21, // 37: PVZService.RestorePVZ:output_type -> MessageResponse
+	21, // 38: PVZService.PurgePVZ:output_type -> MessageResponse
+	21, // 39: PVZService.CreatePVZCell:output_type -> MessageResponse
+	21, // 40: PVZService.DeletePVZCell:output_type -> MessageResponse
+	13, // 41: PVZService.PVZOccupancy:output_type -> OccupancyResponse
+	16, // 42: PVZService.SearchNearbyPVZ:output_type -> NearbyResponse
+	1,  // 43: PVZService.SearchPVZ:output_type -> ListResponse
+	31, // [31:44] is the sub-list for method output_type
+	18, // [18:31] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_pvz_proto_init() }
@@ -534,7 +1563,7 @@ func file_pvz_proto_init() {
 			}
 		}
 		file_pvz_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PVZ); i {
+			switch v := v.(*DeletePVZRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -546,6 +1575,54 @@ func file_pvz_proto_init() {
 			}
 		}
 		file_pvz_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PVZ); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pvz_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StructuredAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pvz_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pvz_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkingDay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pvz_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PVZAllInfo); i {
 			case 0:
 				return &v.state
@@ -557,6 +1634,102 @@ func file_pvz_proto_init() {
 				return nil
 			}
 		}
+		file_pvz_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CellCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pvz_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CellIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pvz_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CellOccupancy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pvz_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OccupancyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pvz_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NearbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pvz_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NearbyPVZ); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pvz_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NearbyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pvz_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -564,7 +1737,7 @@ func file_pvz_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pvz_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
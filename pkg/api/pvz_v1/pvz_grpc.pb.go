@@ -20,11 +20,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	PVZService_CreatePVZ_FullMethodName  = "/PVZService/CreatePVZ"
-	PVZService_GetPVZByID_FullMethodName = "/PVZService/GetPVZByID"
-	PVZService_ListPVZ_FullMethodName    = "/PVZService/ListPVZ"
-	PVZService_UpdatePVZ_FullMethodName  = "/PVZService/UpdatePVZ"
-	PVZService_DeletePVZ_FullMethodName  = "/PVZService/DeletePVZ"
+	PVZService_CreatePVZ_FullMethodName       = "/PVZService/CreatePVZ"
+	PVZService_GetPVZByID_FullMethodName      = "/PVZService/GetPVZByID"
+	PVZService_ListPVZ_FullMethodName         = "/PVZService/ListPVZ"
+	PVZService_UpdatePVZ_FullMethodName       = "/PVZService/UpdatePVZ"
+	PVZService_DeletePVZ_FullMethodName       = "/PVZService/DeletePVZ"
+	PVZService_ListDeletedPVZ_FullMethodName  = "/PVZService/ListDeletedPVZ"
+	PVZService_RestorePVZ_FullMethodName      = "/PVZService/RestorePVZ"
+	PVZService_PurgePVZ_FullMethodName        = "/PVZService/PurgePVZ"
+	PVZService_CreatePVZCell_FullMethodName   = "/PVZService/CreatePVZCell"
+	PVZService_DeletePVZCell_FullMethodName   = "/PVZService/DeletePVZCell"
+	PVZService_PVZOccupancy_FullMethodName    = "/PVZService/PVZOccupancy"
+	PVZService_SearchNearbyPVZ_FullMethodName = "/PVZService/SearchNearbyPVZ"
+	PVZService_SearchPVZ_FullMethodName       = "/PVZService/SearchPVZ"
 )
 
 // PVZServiceClient is the client API for PVZService service.
@@ -35,7 +43,15 @@ type PVZServiceClient interface {
 	GetPVZByID(ctx context.Context, in *PVZIDRequest, opts ...grpc.CallOption) (*PVZAllInfo, error)
 	ListPVZ(ctx context.Context, in *abstract.Page, opts ...grpc.CallOption) (*ListResponse, error)
 	UpdatePVZ(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
-	DeletePVZ(ctx context.Context, in *PVZIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
+	DeletePVZ(ctx context.Context, in *DeletePVZRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
+	ListDeletedPVZ(ctx context.Context, in *abstract.Page, opts ...grpc.CallOption) (*ListResponse, error)
+	RestorePVZ(ctx context.Context, in *PVZIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
+	PurgePVZ(ctx context.Context, in *PVZIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
+	CreatePVZCell(ctx context.Context, in *CellCreateRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
+	DeletePVZCell(ctx context.Context, in *CellIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error)
+	PVZOccupancy(ctx context.Context, in *PVZIDRequest, opts ...grpc.CallOption) (*OccupancyResponse, error)
+	SearchNearbyPVZ(ctx context.Context, in *NearbyRequest, opts ...grpc.CallOption) (*NearbyResponse, error)
+	SearchPVZ(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*ListResponse, error)
 }
 
 type pVZServiceClient struct {
@@ -82,7 +98,7 @@ func (c *pVZServiceClient) UpdatePVZ(ctx context.Context, in *UpdateRequest, opt
 	return out, nil
 }
 
-func (c *pVZServiceClient) DeletePVZ(ctx context.Context, in *PVZIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error) {
+func (c *pVZServiceClient) DeletePVZ(ctx context.Context, in *DeletePVZRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error) {
 	out := new(abstract.MessageResponse)
 	err := c.cc.Invoke(ctx, PVZService_DeletePVZ_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -91,6 +107,78 @@ func (c *pVZServiceClient) DeletePVZ(ctx context.Context, in *PVZIDRequest, opts
 	return out, nil
 }
 
+func (c *pVZServiceClient) ListDeletedPVZ(ctx context.Context, in *abstract.Page, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListDeletedPVZ_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) RestorePVZ(ctx context.Context, in *PVZIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error) {
+	out := new(abstract.MessageResponse)
+	err := c.cc.Invoke(ctx, PVZService_RestorePVZ_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) PurgePVZ(ctx context.Context, in *PVZIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error) {
+	out := new(abstract.MessageResponse)
+	err := c.cc.Invoke(ctx, PVZService_PurgePVZ_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) CreatePVZCell(ctx context.Context, in *CellCreateRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error) {
+	out := new(abstract.MessageResponse)
+	err := c.cc.Invoke(ctx, PVZService_CreatePVZCell_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) DeletePVZCell(ctx context.Context, in *CellIDRequest, opts ...grpc.CallOption) (*abstract.MessageResponse, error) {
+	out := new(abstract.MessageResponse)
+	err := c.cc.Invoke(ctx, PVZService_DeletePVZCell_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) PVZOccupancy(ctx context.Context, in *PVZIDRequest, opts ...grpc.CallOption) (*OccupancyResponse, error) {
+	out := new(OccupancyResponse)
+	err := c.cc.Invoke(ctx, PVZService_PVZOccupancy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) SearchNearbyPVZ(ctx context.Context, in *NearbyRequest, opts ...grpc.CallOption) (*NearbyResponse, error) {
+	out := new(NearbyResponse)
+	err := c.cc.Invoke(ctx, PVZService_SearchNearbyPVZ_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) SearchPVZ(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, PVZService_SearchPVZ_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PVZServiceServer is the server API for PVZService service.
 // All implementations must embed UnimplementedPVZServiceServer
 // for forward compatibility
@@ -99,7 +187,15 @@ type PVZServiceServer interface {
 	GetPVZByID(context.Context, *PVZIDRequest) (*PVZAllInfo, error)
 	ListPVZ(context.Context, *abstract.Page) (*ListResponse, error)
 	UpdatePVZ(context.Context, *UpdateRequest) (*abstract.MessageResponse, error)
-	DeletePVZ(context.Context, *PVZIDRequest) (*abstract.MessageResponse, error)
+	DeletePVZ(context.Context, *DeletePVZRequest) (*abstract.MessageResponse, error)
+	ListDeletedPVZ(context.Context, *abstract.Page) (*ListResponse, error)
+	RestorePVZ(context.Context, *PVZIDRequest) (*abstract.MessageResponse, error)
+	PurgePVZ(context.Context, *PVZIDRequest) (*abstract.MessageResponse, error)
+	CreatePVZCell(context.Context, *CellCreateRequest) (*abstract.MessageResponse, error)
+	DeletePVZCell(context.Context, *CellIDRequest) (*abstract.MessageResponse, error)
+	PVZOccupancy(context.Context, *PVZIDRequest) (*OccupancyResponse, error)
+	SearchNearbyPVZ(context.Context, *NearbyRequest) (*NearbyResponse, error)
+	SearchPVZ(context.Context, *SearchRequest) (*ListResponse, error)
 	mustEmbedUnimplementedPVZServiceServer()
 }
 
@@ -119,9 +215,33 @@ func (UnimplementedPVZServiceServer) ListPVZ(context.Context, *abstract.Page) (*
 func (UnimplementedPVZServiceServer) UpdatePVZ(context.Context, *UpdateRequest) (*abstract.MessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePVZ not implemented")
 }
-func (UnimplementedPVZServiceServer) DeletePVZ(context.Context, *PVZIDRequest) (*abstract.MessageResponse, error) {
+func (UnimplementedPVZServiceServer) DeletePVZ(context.Context, *DeletePVZRequest) (*abstract.MessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePVZ not implemented")
 }
+func (UnimplementedPVZServiceServer) ListDeletedPVZ(context.Context, *abstract.Page) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedPVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) RestorePVZ(context.Context, *PVZIDRequest) (*abstract.MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) PurgePVZ(context.Context, *PVZIDRequest) (*abstract.MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgePVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) CreatePVZCell(context.Context, *CellCreateRequest) (*abstract.MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePVZCell not implemented")
+}
+func (UnimplementedPVZServiceServer) DeletePVZCell(context.Context, *CellIDRequest) (*abstract.MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePVZCell not implemented")
+}
+func (UnimplementedPVZServiceServer) PVZOccupancy(context.Context, *PVZIDRequest) (*OccupancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PVZOccupancy not implemented")
+}
+func (UnimplementedPVZServiceServer) SearchNearbyPVZ(context.Context, *NearbyRequest) (*NearbyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchNearbyPVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) SearchPVZ(context.Context, *SearchRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPVZ not implemented")
+}
 func (UnimplementedPVZServiceServer) mustEmbedUnimplementedPVZServiceServer() {}
 
 // UnsafePVZServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -208,7 +328,7 @@ func _PVZService_UpdatePVZ_Handler(srv interface{}, ctx context.Context, dec fun
 }
 
 func _PVZService_DeletePVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PVZIDRequest)
+	in := new(DeletePVZRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -220,7 +340,151 @@ func _PVZService_DeletePVZ_Handler(srv interface{}, ctx context.Context, dec fun
 		FullMethod: PVZService_DeletePVZ_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PVZServiceServer).DeletePVZ(ctx, req.(*PVZIDRequest))
+		return srv.(PVZServiceServer).DeletePVZ(ctx, req.(*DeletePVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ListDeletedPVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(abstract.Page)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListDeletedPVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListDeletedPVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListDeletedPVZ(ctx, req.(*abstract.Page))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_RestorePVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PVZIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).RestorePVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_RestorePVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).RestorePVZ(ctx, req.(*PVZIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_PurgePVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PVZIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).PurgePVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_PurgePVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).PurgePVZ(ctx, req.(*PVZIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_CreatePVZCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CellCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CreatePVZCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CreatePVZCell_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CreatePVZCell(ctx, req.(*CellCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_DeletePVZCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CellIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).DeletePVZCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_DeletePVZCell_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).DeletePVZCell(ctx, req.(*CellIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_PVZOccupancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PVZIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).PVZOccupancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_PVZOccupancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).PVZOccupancy(ctx, req.(*PVZIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_SearchNearbyPVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NearbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).SearchNearbyPVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_SearchNearbyPVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).SearchNearbyPVZ(ctx, req.(*NearbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_SearchPVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).SearchPVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_SearchPVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).SearchPVZ(ctx, req.(*SearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -252,6 +516,38 @@ var PVZService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePVZ",
 			Handler:    _PVZService_DeletePVZ_Handler,
 		},
+		{
+			MethodName: "ListDeletedPVZ",
+			Handler:    _PVZService_ListDeletedPVZ_Handler,
+		},
+		{
+			MethodName: "RestorePVZ",
+			Handler:    _PVZService_RestorePVZ_Handler,
+		},
+		{
+			MethodName: "PurgePVZ",
+			Handler:    _PVZService_PurgePVZ_Handler,
+		},
+		{
+			MethodName: "CreatePVZCell",
+			Handler:    _PVZService_CreatePVZCell_Handler,
+		},
+		{
+			MethodName: "DeletePVZCell",
+			Handler:    _PVZService_DeletePVZCell_Handler,
+		},
+		{
+			MethodName: "PVZOccupancy",
+			Handler:    _PVZService_PVZOccupancy_Handler,
+		},
+		{
+			MethodName: "SearchNearbyPVZ",
+			Handler:    _PVZService_SearchNearbyPVZ_Handler,
+		},
+		{
+			MethodName: "SearchPVZ",
+			Handler:    _PVZService_SearchPVZ_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pvz.proto",
@@ -1,6 +1,9 @@
 package constants
 
-import "time"
+import (
+	"math"
+	"time"
+)
 
 // SuccessfullyCreatedPVZ is
 const SuccessfullyCreatedPVZ = "Successfully created PVZ"
@@ -8,6 +11,74 @@ const SuccessfullyCreatedPVZ = "Successfully created PVZ"
 // BoxTimeDuration is
 const BoxTimeDuration = time.Hour
 
+// BoxReceiveTimeDuration is the time a box read on a cache miss of a receive is cached for, it is short
+// because an update of the box can be committed between the read and the write to the cache
+const BoxReceiveTimeDuration = 30 * time.Second
+
+// OrderTimeDuration is
+const OrderTimeDuration = 10 * time.Minute
+
+// UserAuthTimeDuration is the time a verified login is trusted without checking its password hash again
+const UserAuthTimeDuration = time.Minute
+
+// ExpirySweepInterval is
+const ExpirySweepInterval = time.Minute
+
+// ExpirySweepBatchSize is
+const ExpirySweepBatchSize = 100
+
+// DefaultReturnWindow is
+const DefaultReturnWindow = 48 * time.Hour
+
+// DefaultVolumetricDivisor is the number of cubic centimeters that weigh one kilogram
+const DefaultVolumetricDivisor = 5000
+
+// ReceiveBatchChunkSize is the number of manifest lines received in one transaction
+const ReceiveBatchChunkSize = 100
+
+// ReceiveBatchMaxLines is
+const ReceiveBatchMaxLines = 5000
+
+// DefaultPackagingSeparator splits the packaging layers in a CSV manifest column
+const DefaultPackagingSeparator = "|"
+
+// ManifestExportPageSize is the number of orders read per page while exporting a manifest
+const ManifestExportPageSize = 100
+
+// DefaultPVZTimezone is the time zone of a PVZ that has none set
+const DefaultPVZTimezone = "UTC"
+
+// EarthRadiusKm is the mean radius of the Earth the distance to a PVZ is computed with
+const EarthRadiusKm = 6371.0
+
+// KmPerDegree is the length of a degree of latitude
+const KmPerDegree = EarthRadiusKm * math.Pi / 180
+
+// DefaultIdempotencyTTL is the time a response is replayed for a retried request with the same idempotency key
+const DefaultIdempotencyTTL = 24 * time.Hour
+
+// DefaultIdempotencyLease is the time a request keeps its key while it is handled,
+// a key that is still not completed after it can be taken over by a retry
+const DefaultIdempotencyLease = time.Minute
+
+// IdempotencyCompleteTimeout is the time the outcome of a request has to be stored in, even when its caller is gone
+const IdempotencyCompleteTimeout = 5 * time.Second
+
+// IdempotencyKeyMaxLength is
+const IdempotencyKeyMaxLength = 255
+
+// IdempotencyKeyHeader is the metadata header that carries the idempotency key
+const IdempotencyKeyHeader = "idempotency-key"
+
+// IdempotentReplayedHeader is set on a response that is replayed for a retried request
+const IdempotentReplayedHeader = "idempotent-replayed"
+
+// IfMatchHeader is the metadata header that carries the PVZ version expected by an update or a delete
+const IfMatchHeader = "if-match"
+
+// ETagHeader is the metadata header that carries the current PVZ version
+const ETagHeader = "etag"
+
 // KafkaTopic is
 const KafkaTopic = "log_pool"
 
@@ -7,18 +7,78 @@ var (
 	ErrPVZAlreadyExists = errors.New("PVZ already exists in PVZ storage")
 	// ErrPVZNotFound is
 	ErrPVZNotFound = errors.New("PVZ not found")
+	// ErrPVZHasLiveOrders is
+	ErrPVZHasLiveOrders = errors.New("PVZ still holds live orders")
+	// ErrPVZHasDependents is
+	ErrPVZHasDependents = errors.New("PVZ is still referenced by orders")
+	// ErrPVZVersionMismatch is
+	ErrPVZVersionMismatch = errors.New("PVZ was changed by another request, read it again")
+	// ErrPVZFull is
+	ErrPVZFull = errors.New("PVZ has no free cell for the order")
+	// ErrPVZCellNotFound is
+	ErrPVZCellNotFound = errors.New("PVZ cell not found")
+	// ErrPVZCellAlreadyExists is
+	ErrPVZCellAlreadyExists = errors.New("pq: duplicate key value violates unique constraint \"pvz_cell_pvz_id_code_key\"")
+	// ErrPVZCellInUse is
+	ErrPVZCellInUse = errors.New("PVZ cell still holds orders")
+	// ErrInvalidWorkingHours is
+	ErrInvalidWorkingHours = errors.New("PVZ has to close after it opens on a working day")
 	// ErrOrderAlreadyExists is
 	ErrOrderAlreadyExists = errors.New("pq: duplicate key value violates unique constraint \"orders_pkey\"")
 	// ErrOrderNotFound is
 	ErrOrderNotFound = errors.New("Order not found")
+	// ErrInvalidStatusTransition is
+	ErrInvalidStatusTransition = errors.New("Invalid order status transition")
+	// ErrOrderExpired is
+	ErrOrderExpired = errors.New("Order storage period has expired")
+	// ErrOrderNotIssued is
+	ErrOrderNotIssued = errors.New("Order has not been issued yet")
+	// ErrReturnWindowElapsed is
+	ErrReturnWindowElapsed = errors.New("Return window has elapsed")
+	// ErrWrongClient is
+	ErrWrongClient = errors.New("Order belongs to another client")
 	// ErrClientIDNotFound is
 	ErrClientIDNotFound = errors.New("Not all client ids are same")
 	// ErrBoxNotFound is
 	ErrBoxNotFound = errors.New("Box not found")
 	// ErrBoxAlreadyExists is
 	ErrBoxAlreadyExists = errors.New("pq: duplicate key value violates unique constraint \"box_name_key\"")
+	// ErrBoxHasLiveOrders is
+	ErrBoxHasLiveOrders = errors.New("Box is still used by live orders")
+	// ErrBoxHasDependents is
+	ErrBoxHasDependents = errors.New("Box is still referenced by orders or tariffs")
+	// ErrBoxArchived is
+	ErrBoxArchived = errors.New("Box is archived")
 	// ErrInvalidBoxLimit is
 	ErrInvalidBoxLimit = errors.New("Exceeding box_v1 limit")
+	// ErrOrderDoesNotFit is
+	ErrOrderDoesNotFit = errors.New("Order does not fit into the box")
+	// ErrManifestTooLarge is
+	ErrManifestTooLarge = errors.New("Manifest has too many lines")
+	// ErrPackagingNotCompatible is
+	ErrPackagingNotCompatible = errors.New("Packaging layer can not wrap the layer inside it")
+	// ErrWeightNotPriced is
+	ErrWeightNotPriced = errors.New("Order weight is outside of the tariff weight brackets")
+	// ErrTariffNotFound is
+	ErrTariffNotFound = errors.New("Tariff not found")
+	// ErrTariffAlreadyExists is
+	ErrTariffAlreadyExists = errors.New("pq: duplicate key value violates unique constraint \"tariff_name_key\"")
+	// ErrTariffActive is
+	ErrTariffActive = errors.New("Active tariff can not be deleted")
+	// ErrIdempotencyKeyReused is
+	ErrIdempotencyKeyReused = errors.New("Idempotency key is already used for another request")
+	// ErrIdempotencyKeyInProgress is
+	ErrIdempotencyKeyInProgress = errors.New("Request with the idempotency key is still in progress")
+	// ErrIdempotencyKeyTooLong is
+	ErrIdempotencyKeyTooLong = errors.New("Idempotency key is too long")
+	// ErrUserNotFound is
+	ErrUserNotFound = errors.New("User not found")
+	// ErrUserAlreadyExists is
+	ErrUserAlreadyExists = errors.New("pq: duplicate key value violates unique constraint \"users_username_key\"")
+	// ErrInvalidCredentials is
+	ErrInvalidCredentials = errors.New("Invalid username or password")
+	// ErrLastAdmin is
+	ErrLastAdmin = errors.New("The last admin can not be deleted or demoted")
 	// ErrNotFoundCache is
 	ErrNotFoundCache = errors.New("Not found cache with key")
 	// ErrInMemoryCacheNil is
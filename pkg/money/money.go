@@ -0,0 +1,154 @@
+// Package money keeps sums of money exact, they are stored as NUMERIC(12,2) and handled in minor units
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Currency is the only currency the service works with
+const Currency = "RUB"
+
+// minorUnits is the number of minor units in a major one
+const minorUnits = 100
+
+// ErrInvalidAmount is
+var ErrInvalidAmount = errors.New("invalid money amount")
+
+// Amount is a sum of money in minor units
+type Amount int64
+
+// FromFloat is the compatibility path for clients that still send money as a float,
+// the value is rounded to the nearest minor unit
+func FromFloat(value float64) Amount {
+	return Amount(math.Round(value * minorUnits))
+}
+
+// Float is the compatibility path for clients that still read money as a float
+func (a Amount) Float() float64 {
+	return float64(a) / minorUnits
+}
+
+// Percent is the given percent of the amount rounded to the nearest minor unit
+func (a Amount) Percent(percent float64) Amount {
+	return Amount(math.Round(float64(a) * percent / 100))
+}
+
+// Times is
+func (a Amount) Times(count int64) Amount {
+	return a * Amount(count)
+}
+
+// String is the amount in major units with exactly two decimals
+func (a Amount) String() string {
+	sign := ""
+	value := int64(a)
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, value/minorUnits, value%minorUnits)
+}
+
+// Parse reads a decimal amount in major units, digits beyond the minor unit are rejected
+func Parse(value string) (Amount, error) {
+	value = strings.TrimSpace(value)
+
+	negative := strings.HasPrefix(value, "-")
+	unsigned := value
+	if negative || strings.HasPrefix(value, "+") {
+		unsigned = value[1:]
+	}
+
+	whole, fraction, _ := strings.Cut(unsigned, ".")
+	if (whole == "" && fraction == "") || !isDigits(whole) || !isDigits(fraction) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > 2 {
+		return 0, fmt.Errorf("%w: %q has more than two decimals", ErrInvalidAmount, value)
+	}
+	fraction += strings.Repeat("0", 2-len(fraction))
+
+	if whole == "" {
+		whole = "0"
+	}
+
+	units, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidAmount, err)
+	}
+
+	if negative {
+		units = -units
+	}
+
+	return Amount(units), nil
+}
+
+// isDigits is, an empty part counts as digits, "5." and ".5" are valid amounts
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Scan is
+func (a *Amount) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*a = 0
+		return nil
+	case []byte:
+		return a.parseInto(string(value))
+	case string:
+		return a.parseInto(value)
+	case int64:
+		*a = Amount(value * minorUnits)
+		return nil
+	default:
+		return fmt.Errorf("%w: unsupported type %T", ErrInvalidAmount, src)
+	}
+}
+
+// Value is
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+// MarshalJSON is
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON accepts both a JSON number and a string in major units
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	var value json.Number
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return a.parseInto(value.String())
+}
+
+// parseInto is
+func (a *Amount) parseInto(value string) error {
+	amount, err := Parse(value)
+	if err != nil {
+		return err
+	}
+
+	*a = amount
+	return nil
+}
@@ -0,0 +1,95 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParse is
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []*struct {
+		description string
+		value       string
+		wantAmount  Amount
+		wantErr     bool
+	}{
+		{description: "Whole amount", value: "12", wantAmount: 1200},
+		{description: "Postgres numeric", value: "12.10", wantAmount: 1210},
+		{description: "Single decimal", value: "0.3", wantAmount: 30},
+		{description: "Negative amount", value: "-5.05", wantAmount: -505},
+		{description: "Trailing zeros", value: "1.2300", wantAmount: 123},
+		{description: "Fraction of a minor unit", value: "0.305", wantErr: true},
+		{description: "Positive sign", value: "+5", wantAmount: 500},
+		{description: "Double minus", value: "--5", wantErr: true},
+		{description: "Plus and minus", value: "+-5", wantErr: true},
+		{description: "Minus and plus", value: "-+5", wantErr: true},
+		{description: "Sign in the fraction", value: "5.-1", wantErr: true},
+		{description: "Only a sign", value: "-", wantErr: true},
+		{description: "Not a number", value: "abc", wantErr: true},
+		{description: "Empty", value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			amount, err := Parse(tt.value)
+
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantAmount, amount)
+		})
+	}
+}
+
+// TestAmount_String is
+func TestAmount_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "12.10", Amount(1210).String())
+	assert.Equal(t, "0.05", Amount(5).String())
+	assert.Equal(t, "-5.05", Amount(-505).String())
+}
+
+// TestAmount_Scan is
+func TestAmount_Scan(t *testing.T) {
+	t.Parallel()
+
+	var amount Amount
+
+	require.NoError(t, amount.Scan([]byte("20.00")))
+	assert.Equal(t, Amount(2000), amount)
+
+	require.NoError(t, amount.Scan(nil))
+	assert.Equal(t, Amount(0), amount)
+
+	assert.Error(t, amount.Scan(1.5))
+}
+
+// TestAmount_JSON is
+func TestAmount_JSON(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(Amount(1210))
+	require.NoError(t, err)
+	assert.Equal(t, "12.10", string(data))
+
+	var amount Amount
+	require.NoError(t, json.Unmarshal([]byte("0.3"), &amount))
+	assert.Equal(t, Amount(30), amount)
+
+	require.NoError(t, json.Unmarshal([]byte(`"5"`), &amount))
+	assert.Equal(t, Amount(500), amount)
+}
+
+// TestFromFloat is
+func TestFromFloat(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, Amount(30), FromFloat(0.1+0.2))
+	assert.Equal(t, Amount(1210), FromFloat(12.1))
+	assert.Equal(t, 12.1, Amount(1210).Float())
+}
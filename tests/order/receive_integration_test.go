@@ -0,0 +1,151 @@
+//go:build integration
+// +build integration
+
+package order
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Homework-1/internal/cache"
+	"Homework-1/internal/config"
+	"Homework-1/internal/connection"
+	"Homework-1/internal/database/postgres"
+	orderModel "Homework-1/internal/model/order"
+	pvzModel "Homework-1/internal/model/pvz"
+	orderRepository "Homework-1/internal/order/repository"
+	OrderUseCase "Homework-1/internal/order/usecase"
+	pvzRepository "Homework-1/internal/pvz/repository"
+	PVZUseCase "Homework-1/internal/pvz/usecase"
+	"Homework-1/pkg/errlst"
+)
+
+// lockWait is the time a call is given to show that it waits for a lock
+const lockWait = 300 * time.Millisecond
+
+// TestIntegrationReceiveOrder_DeletePVZ is, a receive and a delete of the same PVZ are serialized
+// whichever of them takes the PVZ row first
+func TestIntegrationReceiveOrder_DeletePVZ(t *testing.T) {
+	// get connection from database
+	ENV := ".env.prod"
+	if os.Getenv("ENV") == "testing" {
+		ENV = ".env.testing"
+	}
+
+	err := godotenv.Load("./../../" + ENV)
+	require.NoError(t, err)
+
+	configPath := os.Getenv("CONFIG_PATH")
+	if ENV == ".env.prod" {
+		configPath = "./../." + configPath
+	}
+
+	cfg, err := config.LoadConfig(configPath)
+	require.NoError(t, err)
+
+	tdb, err := connection.NewTDB(context.Background(), cfg.Postgres, t)
+	require.NoError(t, err)
+
+	rdb, err := connection.NewCache(context.Background(), cfg.Redis)
+	require.NoError(t, err)
+
+	defer tdb.Close()
+
+	dataStore := postgres.NewDataStore(tdb)
+	cacheStore := cache.NewClientRDRepository(rdb)
+
+	var boxID int64
+	err = tdb.Get(context.Background(), &boxID, "SELECT id FROM box WHERE name = 'package' AND deleted_at IS NULL")
+	require.NoError(t, err)
+
+	setup := func(t *testing.T, orderID int64) (int64, orderModel.Request) {
+		var pvzID int64
+		err := tdb.QueryRow(
+			context.Background(),
+			"INSERT INTO pvz(name, address, contact) VALUES ('Receive race', 'Address', '+79990000000') RETURNING id",
+		).Scan(&pvzID)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			for _, table := range []string{"order_status_history", "order_packaging", "orders"} {
+				_, err := tdb.Execute(context.Background(), "DELETE FROM "+table+" WHERE order_id = $1", orderID)
+				require.NoError(t, err)
+			}
+			require.NoError(t, tdb.DropRowByID(context.Background(), "pvz", pvzID))
+		})
+
+		return pvzID, orderModel.Request{
+			ExpireTimeDuration: 3,
+			OrderID:            orderID,
+			ClientID:           1,
+			Weight:             1,
+			BoxID:              boxID,
+			PVZID:              pvzID,
+			Packaging:          []int64{boxID},
+		}
+	}
+
+	t.Run("Receive waits for the delete and does not find the PVZ", func(t *testing.T) {
+		ctx := context.Background()
+		pvzID, request := setup(t, 990001)
+
+		tx, err := tdb.Begin(ctx, nil)
+		require.NoError(t, err)
+		defer func() { _ = tx.Rollback() }()
+
+		pvzRepo := pvzRepository.NewPVZPGRepository(tx)
+		require.NoError(t, pvzRepo.LockPVZ(ctx, pvzID))
+		require.NoError(t, pvzRepo.DeletePVZByID(ctx, pvzModel.DeleteRequest{ID: pvzID, Version: 1}))
+
+		received := make(chan error, 1)
+		go func() {
+			received <- OrderUseCase.NewOrderUseCase(dataStore, cacheStore, cfg.ReturnPolicy, cfg.Volumetric).
+				CreateReceiveOrder(ctx, request)
+		}()
+
+		select {
+		case err = <-received:
+			t.Fatalf("receive did not wait for the delete: %v", err)
+		case <-time.After(lockWait):
+		}
+
+		require.NoError(t, tx.Commit())
+		assert.ErrorIs(t, <-received, errlst.ErrPVZNotFound)
+	})
+
+	t.Run("Delete waits for the receive and counts the order", func(t *testing.T) {
+		ctx := context.Background()
+		pvzID, request := setup(t, 990002)
+
+		tx, err := tdb.Begin(ctx, nil)
+		require.NoError(t, err)
+		defer func() { _ = tx.Rollback() }()
+
+		require.NoError(t, pvzRepository.NewPVZPGRepository(tx).ShareLivePVZ(ctx, pvzID))
+
+		deleted := make(chan error, 1)
+		go func() {
+			deleted <- PVZUseCase.NewPVZUseCase(dataStore, cacheStore).
+				DeletePVZByID(ctx, pvzModel.DeleteRequest{ID: pvzID, Version: 1})
+		}()
+
+		select {
+		case err = <-deleted:
+			t.Fatalf("delete did not wait for the receive: %v", err)
+		case <-time.After(lockWait):
+		}
+
+		orderData := request.ToStorage()
+		orderData.ExpiresAt = time.Now().Add(72 * time.Hour)
+		require.NoError(t, orderRepository.NewOrdersPGRepository(tx).CreateReceiveOrder(ctx, orderData))
+		require.NoError(t, tx.Commit())
+
+		assert.ErrorIs(t, <-deleted, errlst.ErrPVZHasLiveOrders)
+	})
+}
@@ -0,0 +1,109 @@
+//go:build integration
+// +build integration
+
+package user
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/joho/godotenv"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"Homework-1/internal/cache"
+	"Homework-1/internal/config"
+	"Homework-1/internal/connection"
+	"Homework-1/internal/database/postgres"
+	userModel "Homework-1/internal/model/user"
+	UserUseCase "Homework-1/internal/user/usecase"
+	"Homework-1/pkg/errlst"
+)
+
+// TestIntegrationUserUseCase_LastAdmin is, of two admins deleted at the same time only one is deleted
+// and the last admin is refused
+func TestIntegrationUserUseCase_LastAdmin(t *testing.T) {
+	// get connection from database
+	ENV := ".env.prod"
+	if os.Getenv("ENV") == "testing" {
+		ENV = ".env.testing"
+	}
+
+	err := godotenv.Load("./../../" + ENV)
+	require.NoError(t, err)
+
+	configPath := os.Getenv("CONFIG_PATH")
+	if ENV == ".env.prod" {
+		configPath = "./../." + configPath
+	}
+
+	cfg, err := config.LoadConfig(configPath)
+	require.NoError(t, err)
+
+	tdb, err := connection.NewTDB(context.Background(), cfg.Postgres, t)
+	require.NoError(t, err)
+
+	rdb, err := connection.NewCache(context.Background(), cfg.Redis)
+	require.NoError(t, err)
+
+	defer tdb.Close()
+
+	ctx := context.Background()
+
+	var admins int64
+	err = tdb.Get(ctx, &admins, "SELECT COUNT(*) FROM users WHERE role = $1", userModel.RoleAdmin)
+	require.NoError(t, err)
+	if admins > 0 {
+		t.Skip("the database already has admins, the last admin can not be reached")
+	}
+
+	useCase := UserUseCase.NewUserUseCase(postgres.NewDataStore(tdb), cache.NewClientRDRepository(rdb), cfg.Auth.CacheSecret)
+
+	adminIDs := make([]int64, 0, 2)
+	for _, username := range []string{"race-admin-1", "race-admin-2"} {
+		id, err := useCase.CreateUser(ctx, userModel.Request{Username: username, Password: "race-password", Role: userModel.RoleAdmin})
+		require.NoError(t, err)
+
+		adminIDs = append(adminIDs, id)
+		t.Cleanup(func() {
+			require.NoError(t, tdb.DropRowByID(context.Background(), "users", id))
+		})
+	}
+
+	errs := make([]error, len(adminIDs))
+	var wg sync.WaitGroup
+	for index, id := range adminIDs {
+		wg.Add(1)
+		go func(index int, id int64) {
+			defer wg.Done()
+			errs[index] = useCase.DeleteUserByID(ctx, id)
+		}(index, id)
+	}
+	wg.Wait()
+
+	refused := 0
+	var leftID int64
+	for index, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		assert.ErrorIs(t, err, errlst.ErrLastAdmin)
+		refused++
+		leftID = adminIDs[index]
+	}
+	require.Equal(t, 1, refused)
+
+	err = tdb.Get(ctx, &admins, "SELECT COUNT(*) FROM users WHERE role = $1", userModel.RoleAdmin)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), admins)
+
+	err = useCase.UpdateUser(ctx, userModel.UpdateRequest{ID: leftID, Role: lo.ToPtr(userModel.RoleAuditor)})
+	assert.ErrorIs(t, err, errlst.ErrLastAdmin)
+
+	err = useCase.DeleteUserByID(ctx, leftID)
+	assert.ErrorIs(t, err, errlst.ErrLastAdmin)
+}